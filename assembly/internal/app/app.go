@@ -12,6 +12,7 @@ import (
 	"github.com/dexguitar/spacecraftory/platform/pkg/closer"
 	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
 	"github.com/dexguitar/spacecraftory/platform/pkg/metrics"
+	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
 )
 
 type App struct {
@@ -65,6 +66,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initMetrics,
+		a.initTracing,
 		a.initCloser,
 	}
 
@@ -116,8 +118,23 @@ func (a *App) initMetrics(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) initTracing(ctx context.Context) error {
+	cfg := config.AppConfig().Tracing
+	if cfg.CollectorEndpoint() == "" {
+		return nil // Tracing disabled
+	}
+
+	if err := tracing.InitTracer(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init tracer: %w", err)
+	}
+
+	logger.Info(ctx, "🔍 Tracing initialized")
+	return nil
+}
+
 func (a *App) initCloser(_ context.Context) error {
 	closer.SetLogger(logger.Logger())
+	closer.AddNamed("Tracing", tracing.ShutdownTracer)
 	closer.AddNamed("Metrics", func(ctx context.Context) error {
 		return metrics.Shutdown(ctx)
 	})
@@ -5,13 +5,17 @@ import (
 	"fmt"
 
 	"github.com/IBM/sarama"
+	redigo "github.com/gomodule/redigo/redis"
 
 	"github.com/dexguitar/spacecraftory/assembly/internal/config"
 	kafkaConverter "github.com/dexguitar/spacecraftory/assembly/internal/converter/kafka"
 	decoder "github.com/dexguitar/spacecraftory/assembly/internal/converter/kafka/decoder"
+	"github.com/dexguitar/spacecraftory/assembly/internal/model"
 	"github.com/dexguitar/spacecraftory/assembly/internal/service"
 	assemblyConsumer "github.com/dexguitar/spacecraftory/assembly/internal/service/consumer/assembly_consumer"
 	assemblyProducer "github.com/dexguitar/spacecraftory/assembly/internal/service/producer/assembly_producer"
+	"github.com/dexguitar/spacecraftory/platform/pkg/cache"
+	"github.com/dexguitar/spacecraftory/platform/pkg/cache/redis"
 	"github.com/dexguitar/spacecraftory/platform/pkg/closer"
 	wrappedKafka "github.com/dexguitar/spacecraftory/platform/pkg/kafka"
 	wrappedKafkaConsumer "github.com/dexguitar/spacecraftory/platform/pkg/kafka/consumer"
@@ -28,6 +32,10 @@ type diContainer struct {
 	orderPaidDecoder        kafkaConverter.OrderPaidDecoder
 	syncProducer            sarama.SyncProducer
 	shipAssembledProducer   wrappedKafka.Producer
+	assemblyFailedProducer  wrappedKafka.Producer
+	retryProducer           sarama.SyncProducer
+	redisPool               *redigo.Pool
+	redisClient             cache.RedisClient
 }
 
 func NewDiContainer() *diContainer {
@@ -36,7 +44,7 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) AssemblyProducerService() service.ProducerService {
 	if d.assemblyProducerService == nil {
-		d.assemblyProducerService = assemblyProducer.NewService(d.ShipAssembledProducer())
+		d.assemblyProducerService = assemblyProducer.NewService(d.ShipAssembledProducer(), d.AssemblyFailedProducer())
 	}
 
 	return d.assemblyProducerService
@@ -48,6 +56,7 @@ func (d *diContainer) AssemblyConsumerService() service.ConsumerService {
 			d.OrderPaidConsumer(),
 			d.OrderPaidDecoder(),
 			d.AssemblyProducerService(),
+			config.AppConfig().Assembly.FailureRate(),
 		)
 	}
 
@@ -76,13 +85,25 @@ func (d *diContainer) ConsumerGroup() sarama.ConsumerGroup {
 
 func (d *diContainer) OrderPaidConsumer() wrappedKafka.Consumer {
 	if d.orderPaidConsumer == nil {
+		retryPolicy := consumerRetryPolicy(
+			config.AppConfig().OrderPaidConsumer.Topic(),
+			config.AppConfig().OrderPaidConsumer.GroupID(),
+		)
 		d.orderPaidConsumer = wrappedKafkaConsumer.NewConsumer(
 			d.ConsumerGroup(),
 			[]string{
 				config.AppConfig().OrderPaidConsumer.Topic(),
+				retryPolicy.RetryTopic,
 			},
 			logger.Logger(),
+			kafkaMiddleware.Tracing(config.AppConfig().Tracing.ServiceName()),
 			kafkaMiddleware.Logging(logger.Logger()),
+			wrappedKafkaConsumer.Retry(retryPolicy, d.RetryProducer(), logger.Logger()),
+			wrappedKafkaConsumer.Dedup(
+				d.dedupStore(config.AppConfig().OrderPaidConsumer.GroupID()),
+				d.orderPaidEventUUID,
+				logger.Logger(),
+			),
 		)
 	}
 
@@ -97,6 +118,16 @@ func (d *diContainer) OrderPaidDecoder() kafkaConverter.OrderPaidDecoder {
 	return d.orderPaidDecoder
 }
 
+// orderPaidEventUUID extracts the dedup key of an OrderPaid message
+func (d *diContainer) orderPaidEventUUID(msg wrappedKafka.Message) (string, error) {
+	event, err := d.OrderPaidDecoder().Decode(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return event.EventUUID, nil
+}
+
 func (d *diContainer) SyncProducer() sarama.SyncProducer {
 	if d.syncProducer == nil {
 		p, err := sarama.NewSyncProducer(
@@ -122,8 +153,95 @@ func (d *diContainer) ShipAssembledProducer() wrappedKafka.Producer {
 			d.SyncProducer(),
 			config.AppConfig().OrderAssembledProducer.Topic(),
 			logger.Logger(),
+			wrappedKafkaProducer.WithEventType(model.EventTypeShipAssembled),
+			wrappedKafkaProducer.WithSchemaVersion(model.ShipAssembledSchemaVersion),
+			wrappedKafkaProducer.WithProducerService(config.AppConfig().Tracing.ServiceName()),
 		)
 	}
 
 	return d.shipAssembledProducer
 }
+
+func (d *diContainer) AssemblyFailedProducer() wrappedKafka.Producer {
+	if d.assemblyFailedProducer == nil {
+		d.assemblyFailedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().AssemblyFailedProducer.Topic(),
+			logger.Logger(),
+			wrappedKafkaProducer.WithEventType(model.EventTypeAssemblyFailed),
+			wrappedKafkaProducer.WithSchemaVersion(model.AssemblyFailedSchemaVersion),
+			wrappedKafkaProducer.WithProducerService(config.AppConfig().Tracing.ServiceName()),
+		)
+	}
+
+	return d.assemblyFailedProducer
+}
+
+func (d *diContainer) RetryProducer() sarama.SyncProducer {
+	if d.retryProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().ConsumerRetry.ProducerConfig(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create retry producer: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka retry producer", func(ctx context.Context) error {
+			return p.Close()
+		})
+
+		d.retryProducer = p
+	}
+
+	return d.retryProducer
+}
+
+// consumerRetryPolicy builds the retry policy of the consumer group reading the topic
+func consumerRetryPolicy(topic, groupID string) wrappedKafkaConsumer.RetryPolicy {
+	cfg := config.AppConfig().ConsumerRetry
+
+	return wrappedKafkaConsumer.RetryPolicy{
+		MaxAttempts:           cfg.MaxAttempts(),
+		InitialBackoff:        cfg.InitialBackoff(),
+		MaxBackoff:            cfg.MaxBackoff(),
+		RetryTopic:            wrappedKafkaConsumer.RetryTopicName(topic, groupID),
+		RetryDelay:            cfg.RetryTopicDelay(),
+		MaxRetryTopicAttempts: cfg.RetryTopicMaxAttempts(),
+		DeadLetterTopic:       wrappedKafkaConsumer.DeadLetterTopicName(topic, groupID),
+	}
+}
+
+func (d *diContainer) RedisPool() *redigo.Pool {
+	if d.redisPool == nil {
+		d.redisPool = &redigo.Pool{
+			MaxIdle:     config.AppConfig().Redis.MaxIdle(),
+			IdleTimeout: config.AppConfig().Redis.IdleTimeout(),
+			DialContext: func(ctx context.Context) (redigo.Conn, error) {
+				return redigo.DialContext(ctx, "tcp", config.AppConfig().Redis.Address())
+			},
+		}
+		closer.AddNamed("Redis pool", func(ctx context.Context) error {
+			return d.redisPool.Close()
+		})
+	}
+
+	return d.redisPool
+}
+
+func (d *diContainer) RedisClient() cache.RedisClient {
+	if d.redisClient == nil {
+		d.redisClient = redis.NewClient(d.RedisPool(), logger.Logger(), config.AppConfig().Redis.ConnectionTimeout())
+	}
+
+	return d.redisClient
+}
+
+// dedupStore keeps processed event UUIDs of the consumer group in Redis
+func (d *diContainer) dedupStore(groupID string) wrappedKafkaConsumer.DedupStore {
+	return wrappedKafkaConsumer.NewRedisDedupStore(
+		d.RedisClient(),
+		wrappedKafkaConsumer.DedupKeyPrefix(groupID),
+		config.AppConfig().Redis.DedupTTL(),
+		config.AppConfig().Redis.DedupClaimTTL(),
+	)
+}
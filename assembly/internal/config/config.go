@@ -13,9 +13,14 @@ var appConfig *config
 type config struct {
 	Logger                 LoggerConfig
 	Metrics                MetricsConfig
+	Tracing                TracingConfig
 	Kafka                  KafkaConfig
 	OrderAssembledProducer OrderAssembledProducerConfig
+	AssemblyFailedProducer AssemblyFailedProducerConfig
+	Assembly               AssemblyConfig
 	OrderPaidConsumer      OrderPaidConsumerConfig
+	ConsumerRetry          ConsumerRetryConfig
+	Redis                  RedisConfig
 }
 
 func Load(path ...string) error {
@@ -34,6 +39,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	tracingCfg, err := env.NewTracingConfig()
+	if err != nil {
+		return err
+	}
+
 	kafkaCfg, err := env.NewKafkaConfig()
 	if err != nil {
 		return err
@@ -44,17 +54,42 @@ func Load(path ...string) error {
 		return err
 	}
 
+	assemblyFailedProducerCfg, err := env.NewAssemblyFailedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	assemblyCfg, err := env.NewAssemblyConfig()
+	if err != nil {
+		return err
+	}
+
 	orderPaidConsumerCfg, err := env.NewOrderPaidConsumerConfig()
 	if err != nil {
 		return err
 	}
 
+	consumerRetryCfg, err := env.NewConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
+	redisCfg, err := env.NewRedisConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:                 loggerCfg,
 		Metrics:                metricsCfg,
+		Tracing:                tracingCfg,
 		Kafka:                  kafkaCfg,
 		OrderAssembledProducer: orderAssembledProducerCfg,
+		AssemblyFailedProducer: assemblyFailedProducerCfg,
+		Assembly:               assemblyCfg,
 		OrderPaidConsumer:      orderPaidConsumerCfg,
+		ConsumerRetry:          consumerRetryCfg,
+		Redis:                  redisCfg,
 	}
 
 	return nil
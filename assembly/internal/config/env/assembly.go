@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyEnvConfig struct {
+	FailureRate float64 `env:"ASSEMBLY_FAILURE_RATE" envDefault:"0"`
+}
+
+type assemblyConfig struct {
+	raw assemblyEnvConfig
+}
+
+func NewAssemblyConfig() (*assemblyConfig, error) {
+	var raw assemblyEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &assemblyConfig{raw: raw}, nil
+}
+
+// FailureRate — доля сборок, которые завершаются неудачей (от 0 до 1)
+func (cfg *assemblyConfig) FailureRate() float64 {
+	return cfg.raw.FailureRate
+}
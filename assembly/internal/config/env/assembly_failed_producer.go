@@ -0,0 +1,36 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyFailedProducerEnvConfig struct {
+	TopicName string `env:"ASSEMBLY_FAILED_TOPIC_NAME,required"`
+}
+
+type assemblyFailedProducerConfig struct {
+	raw assemblyFailedProducerEnvConfig
+}
+
+func NewAssemblyFailedProducerConfig() (*assemblyFailedProducerConfig, error) {
+	var raw assemblyFailedProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &assemblyFailedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *assemblyFailedProducerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+// Config возвращает конфигурацию для sarama consumer
+func (cfg *assemblyFailedProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
@@ -20,6 +20,13 @@ type MetricsConfig interface {
 	Environment() string
 }
 
+type TracingConfig interface {
+	CollectorEndpoint() string
+	ServiceName() string
+	Environment() string
+	ServiceVersion() string
+}
+
 type KafkaConfig interface {
 	Brokers() []string
 }
@@ -29,8 +36,35 @@ type OrderAssembledProducerConfig interface {
 	Config() *sarama.Config
 }
 
+type AssemblyFailedProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
+}
+
+type AssemblyConfig interface {
+	FailureRate() float64
+}
+
 type OrderPaidConsumerConfig interface {
 	Topic() string
 	GroupID() string
 	Config() *sarama.Config
 }
+
+type ConsumerRetryConfig interface {
+	MaxAttempts() int
+	InitialBackoff() time.Duration
+	MaxBackoff() time.Duration
+	RetryTopicDelay() time.Duration
+	RetryTopicMaxAttempts() int
+	ProducerConfig() *sarama.Config
+}
+
+type RedisConfig interface {
+	Address() string
+	ConnectionTimeout() time.Duration
+	MaxIdle() int
+	IdleTimeout() time.Duration
+	DedupTTL() time.Duration
+	DedupClaimTTL() time.Duration
+}
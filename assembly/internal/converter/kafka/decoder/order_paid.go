@@ -6,19 +6,40 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/dexguitar/spacecraftory/assembly/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
 	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
 )
 
-type decoder struct{}
+type decoder struct {
+	registry *kafka.DecoderRegistry
+}
 
 func NewOrderPaidDecoder() *decoder {
-	return &decoder{}
+	return &decoder{
+		registry: kafka.NewDecoderRegistry().
+			Register(model.EventTypeOrderPaid, model.OrderPaidSchemaVersion, decodeOrderPaidV1).
+			WithLegacyEventType(model.EventTypeOrderPaid),
+	}
+}
+
+func (d *decoder) Decode(msg kafka.Message) (model.OrderPaidEvent, error) {
+	event, err := d.registry.Decode(msg)
+	if err != nil {
+		return model.OrderPaidEvent{}, err
+	}
+
+	payload, ok := event.Payload.(model.OrderPaidEvent)
+	if !ok {
+		return model.OrderPaidEvent{}, fmt.Errorf("%w: %s", kafka.ErrUnknownEventType, event.Envelope.EventType)
+	}
+
+	return payload, nil
 }
 
-func (d *decoder) Decode(data []byte) (model.OrderPaidEvent, error) {
+func decodeOrderPaidV1(data []byte) (any, error) {
 	var pb eventsV1.OrderPaid
 	if err := proto.Unmarshal(data, &pb); err != nil {
-		return model.OrderPaidEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal protobuf: %w", err)
 	}
 
 	return model.OrderPaidEvent{
@@ -1,7 +1,10 @@
 package kafka
 
-import "github.com/dexguitar/spacecraftory/assembly/internal/model"
+import (
+	"github.com/dexguitar/spacecraftory/assembly/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
 
 type OrderPaidDecoder interface {
-	Decode(data []byte) (model.OrderPaidEvent, error)
+	Decode(msg kafka.Message) (model.OrderPaidEvent, error)
 }
@@ -1,5 +1,19 @@
 package model
 
+// Event types and schema versions carried in the Kafka event envelope
+const (
+	EventTypeOrderPaid      = "OrderPaid"
+	EventTypeShipAssembled  = "ShipAssembled"
+	EventTypeAssemblyFailed = "AssemblyFailed"
+
+	OrderPaidSchemaVersion      = 1
+	ShipAssembledSchemaVersion  = 1
+	AssemblyFailedSchemaVersion = 1
+)
+
+// AssemblyFailureReason is sent with AssemblyFailed when the simulated assembly breaks down
+const AssemblyFailureReason = "assembly line malfunction"
+
 type OrderPaidEvent struct {
 	EventUUID       string
 	OrderUUID       string
@@ -14,3 +28,10 @@ type ShipAssembledEvent struct {
 	UserUUID     string
 	BuildTimeSec int64
 }
+
+type AssemblyFailedEvent struct {
+	EventUUID string
+	OrderUUID string
+	UserUUID  string
+	Reason    string
+}
@@ -15,17 +15,20 @@ type orderPaidConsumerService struct {
 	orderPaidConsumer kafka.Consumer
 	orderPaidDecoder  kafkaConverter.OrderPaidDecoder
 	producerService   service.ProducerService
+	failureRate       float64
 }
 
 func NewService(
 	orderPaidConsumer kafka.Consumer,
 	orderPaidDecoder kafkaConverter.OrderPaidDecoder,
 	producerService service.ProducerService,
+	failureRate float64,
 ) *orderPaidConsumerService {
 	return &orderPaidConsumerService{
 		orderPaidConsumer: orderPaidConsumer,
 		orderPaidDecoder:  orderPaidDecoder,
 		producerService:   producerService,
+		failureRate:       failureRate,
 	}
 }
 
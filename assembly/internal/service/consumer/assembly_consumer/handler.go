@@ -15,7 +15,7 @@ import (
 )
 
 func (s *orderPaidConsumerService) OrderPaidHandler(ctx context.Context, msg kafka.Message) error {
-	event, err := s.orderPaidDecoder.Decode(msg.Value)
+	event, err := s.orderPaidDecoder.Decode(msg)
 	if err != nil {
 		logger.Error(ctx, "Failed to decode OrderPaid", zap.Error(err))
 		return err
@@ -49,6 +49,12 @@ func (s *orderPaidConsumerService) OrderPaidHandler(ctx context.Context, msg kaf
 		metrics.AssemblyDuration.Record(ctx, assemblyTime.Seconds())
 	}
 
+	// Simulate a breakdown of the assembly line
+	//nolint:gosec
+	if rand.Float64() < s.failureRate {
+		return s.assemblyFailed(ctx, event)
+	}
+
 	logger.Info(ctx, "Assembly completed",
 		zap.String("order_uuid", event.OrderUUID),
 		zap.Duration("assembly_time", assemblyTime))
@@ -73,3 +79,27 @@ func (s *orderPaidConsumerService) OrderPaidHandler(ctx context.Context, msg kaf
 
 	return nil
 }
+
+func (s *orderPaidConsumerService) assemblyFailed(ctx context.Context, event model.OrderPaidEvent) error {
+	logger.Error(ctx, "Assembly failed", zap.String("order_uuid", event.OrderUUID))
+
+	assemblyFailedEvent := model.AssemblyFailedEvent{
+		EventUUID: uuid.New().String(),
+		OrderUUID: event.OrderUUID,
+		UserUUID:  event.UserUUID,
+		Reason:    model.AssemblyFailureReason,
+	}
+
+	if err := s.producerService.ProduceAssemblyFailed(ctx, assemblyFailedEvent); err != nil {
+		logger.Error(ctx, "Failed to produce AssemblyFailed event",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "AssemblyFailed event produced successfully",
+		zap.String("event_uuid", assemblyFailedEvent.EventUUID),
+		zap.String("order_uuid", assemblyFailedEvent.OrderUUID))
+
+	return nil
+}
@@ -14,11 +14,13 @@ import (
 
 type shipAssembledProducerService struct {
 	orderAssembledProducer kafka.Producer
+	assemblyFailedProducer kafka.Producer
 }
 
-func NewService(orderAssembledProducer kafka.Producer) *shipAssembledProducerService {
+func NewService(orderAssembledProducer, assemblyFailedProducer kafka.Producer) *shipAssembledProducerService {
 	return &shipAssembledProducerService{
 		orderAssembledProducer: orderAssembledProducer,
+		assemblyFailedProducer: assemblyFailedProducer,
 	}
 }
 
@@ -44,3 +46,26 @@ func (p *shipAssembledProducerService) ProduceShipAssembled(ctx context.Context,
 
 	return nil
 }
+
+func (p *shipAssembledProducerService) ProduceAssemblyFailed(ctx context.Context, event model.AssemblyFailedEvent) error {
+	msg := &eventsV1.AssemblyFailed{
+		EventUuid: event.EventUUID,
+		OrderUuid: event.OrderUUID,
+		UserUuid:  event.UserUUID,
+		Reason:    event.Reason,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal AssemblyFailed", zap.Error(err))
+		return err
+	}
+
+	err = p.assemblyFailedProducer.Send(ctx, []byte(event.EventUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "failed to publish AssemblyFailed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
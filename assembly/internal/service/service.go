@@ -8,6 +8,7 @@ import (
 
 type ProducerService interface {
 	ProduceShipAssembled(ctx context.Context, event model.ShipAssembledEvent) error
+	ProduceAssemblyFailed(ctx context.Context, event model.AssemblyFailedEvent) error
 }
 
 type ConsumerService interface {
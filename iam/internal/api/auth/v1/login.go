@@ -24,6 +24,9 @@ func (a *api) Login(ctx context.Context, req *authV1.LoginRequest) (*authV1.Logi
 		if errors.Is(err, model.ErrInvalidLoginData) {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid login data")
 		}
+		if errors.Is(err, model.ErrInvalidCredentials) {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid login or password")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to login")
 	}
 
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	authV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/auth/v1"
+)
+
+func (s *APISuite) TestLoginSuccess() {
+	s.authService.On("Login", s.ctx, "pilot", "correct horse").
+		Return("123e4567-e89b-12d3-a456-426614174100", nil).Once()
+
+	resp, err := s.api.Login(s.ctx, &authV1.LoginRequest{Login: "pilot", Password: "correct horse"})
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "123e4567-e89b-12d3-a456-426614174100", resp.GetSessionUuid())
+}
+
+func (s *APISuite) TestLoginError() {
+	testCases := []struct {
+		name         string
+		serviceErr   error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "Invalid credentials",
+			serviceErr:   model.ErrInvalidCredentials,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Invalid login data",
+			serviceErr:   model.ErrInvalidLoginData,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Internal error",
+			serviceErr:   errors.New("redis is down"),
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.authService.On("Login", s.ctx, "pilot", "wrong horse").
+				Return("", tc.serviceErr).Once()
+
+			resp, err := s.api.Login(s.ctx, &authV1.LoginRequest{Login: "pilot", Password: "wrong horse"})
+
+			assert.Nil(s.T(), resp)
+			assert.Equal(s.T(), tc.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func (s *APISuite) TestLoginMissingFields() {
+	resp, err := s.api.Login(s.ctx, &authV1.LoginRequest{Login: "pilot"})
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	authV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/auth/v1"
+)
+
+func (a *api) Logout(ctx context.Context, req *authV1.LogoutRequest) (*authV1.LogoutResponse, error) {
+	err := a.authService.Logout(ctx, req.GetSessionUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrSessionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to logout")
+	}
+
+	return &authV1.LogoutResponse{}, nil
+}
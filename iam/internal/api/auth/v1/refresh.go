@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/converter"
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	authV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/auth/v1"
+)
+
+func (a *api) RefreshSession(ctx context.Context, req *authV1.RefreshSessionRequest) (*authV1.RefreshSessionResponse, error) {
+	session, err := a.authService.RefreshSession(ctx, req.GetSessionUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrSessionNotFound) {
+			return nil, status.Errorf(codes.Unauthenticated, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to refresh session")
+	}
+
+	return &authV1.RefreshSessionResponse{
+		SessionUuid: session.UUID,
+		Session:     converter.ToProtoSession(session),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/converter"
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	authGrpc "github.com/dexguitar/spacecraftory/platform/pkg/middleware/grpc"
+	authV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/auth/v1"
+)
+
+func (a *api) ListSessions(ctx context.Context, req *authV1.ListSessionsRequest) (*authV1.ListSessionsResponse, error) {
+	sessions, err := a.authService.ListSessions(ctx, authGrpc.SessionUUIDFromIncomingMetadata(ctx), req.GetUserUuid())
+	if err != nil {
+		if accessErr := sessionsAccessError(err); accessErr != nil {
+			return nil, accessErr
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list sessions")
+	}
+
+	return &authV1.ListSessionsResponse{
+		Sessions: converter.ToProtoSessions(sessions),
+	}, nil
+}
+
+func (a *api) RevokeAllSessions(ctx context.Context, req *authV1.RevokeAllSessionsRequest) (*authV1.RevokeAllSessionsResponse, error) {
+	revoked, err := a.authService.RevokeAllSessions(ctx, authGrpc.SessionUUIDFromIncomingMetadata(ctx), req.GetUserUuid())
+	if err != nil {
+		if accessErr := sessionsAccessError(err); accessErr != nil {
+			return nil, accessErr
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions")
+	}
+
+	return &authV1.RevokeAllSessionsResponse{
+		RevokedCount: int64(revoked),
+	}, nil
+}
+
+// sessionsAccessError maps errors of acting on the sessions of a user to gRPC statuses,
+// other errors are left to the caller
+func sessionsAccessError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrUnauthenticated):
+		return status.Errorf(codes.Unauthenticated, "valid session-uuid metadata is required")
+	case errors.Is(err, model.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "only the user or an admin may access their sessions")
+	case errors.Is(err, model.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	return nil
+}
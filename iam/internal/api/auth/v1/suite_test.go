@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/service/mocks"
+)
+
+type APISuite struct {
+	suite.Suite
+	ctx         context.Context
+	authService *mocks.AuthService
+	api         *api
+}
+
+func (s *APISuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.authService = mocks.NewAuthService(s.T())
+	s.api = NewAPI(s.authService)
+}
+
+func TestAPIIntegration(t *testing.T) {
+	suite.Run(t, new(APISuite))
+}
@@ -1,6 +1,12 @@
 package v1
 
 import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
 	"github.com/dexguitar/spacecraftory/iam/internal/service"
 	userV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/user/v1"
 )
@@ -16,3 +22,15 @@ func NewAPI(userService service.UserService) *api {
 		userService: userService,
 	}
 }
+
+// accessError maps errors of acting on behalf of a user to gRPC statuses,
+// other errors are left to the caller
+func accessError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrUnauthenticated):
+		return status.Errorf(codes.Unauthenticated, "valid session-uuid metadata is required")
+	case errors.Is(err, model.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "only the user or an admin may change the user")
+	}
+	return nil
+}
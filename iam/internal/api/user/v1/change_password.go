@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	authGrpc "github.com/dexguitar/spacecraftory/platform/pkg/middleware/grpc"
+	userV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) ChangePassword(ctx context.Context, req *userV1.ChangePasswordRequest) (*userV1.ChangePasswordResponse, error) {
+	revoked, err := a.userService.ChangePassword(
+		ctx,
+		authGrpc.SessionUUIDFromIncomingMetadata(ctx),
+		req.GetUserUuid(),
+		req.GetOldPassword(),
+		req.GetNewPassword(),
+		req.GetSessionUuid(),
+	)
+	if err != nil {
+		if accessErr := accessError(err); accessErr != nil {
+			return nil, accessErr
+		}
+		switch {
+		case errors.Is(err, model.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		case errors.Is(err, model.ErrInvalidCredentials):
+			return nil, status.Errorf(codes.Unauthenticated, "invalid old password")
+		case errors.Is(err, model.ErrInvalidPassword):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid new password")
+		case errors.Is(err, model.ErrForeignSession):
+			return nil, status.Errorf(codes.InvalidArgument, "session_uuid is not a session of the user")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to change password")
+	}
+
+	return &userV1.ChangePasswordResponse{
+		RevokedSessions: int64(revoked),
+	}, nil
+}
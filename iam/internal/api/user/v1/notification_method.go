@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/converter"
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	authGrpc "github.com/dexguitar/spacecraftory/platform/pkg/middleware/grpc"
+	userV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) AddNotificationMethod(ctx context.Context, req *userV1.AddNotificationMethodRequest) (*userV1.AddNotificationMethodResponse, error) {
+	user, err := a.userService.AddNotificationMethod(
+		ctx,
+		authGrpc.SessionUUIDFromIncomingMetadata(ctx),
+		req.GetUserUuid(),
+		converter.ToModelNotificationMethod(req.GetNotificationMethod()),
+	)
+	if err != nil {
+		if accessErr := accessError(err); accessErr != nil {
+			return nil, accessErr
+		}
+		switch {
+		case errors.Is(err, model.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		case errors.Is(err, model.ErrNotificationMethodAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "notification method already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to add notification method")
+	}
+
+	return &userV1.AddNotificationMethodResponse{
+		User: converter.ToProtoUser(user),
+	}, nil
+}
+
+func (a *api) RemoveNotificationMethod(ctx context.Context, req *userV1.RemoveNotificationMethodRequest) (*userV1.RemoveNotificationMethodResponse, error) {
+	user, err := a.userService.RemoveNotificationMethod(
+		ctx,
+		authGrpc.SessionUUIDFromIncomingMetadata(ctx),
+		req.GetUserUuid(),
+		converter.ToModelNotificationMethod(req.GetNotificationMethod()),
+	)
+	if err != nil {
+		if accessErr := accessError(err); accessErr != nil {
+			return nil, accessErr
+		}
+		switch {
+		case errors.Is(err, model.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		case errors.Is(err, model.ErrNotificationMethodNotFound):
+			return nil, status.Errorf(codes.NotFound, "notification method not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to remove notification method")
+	}
+
+	return &userV1.RemoveNotificationMethodResponse{
+		User: converter.ToProtoUser(user),
+	}, nil
+}
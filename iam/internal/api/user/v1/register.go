@@ -2,8 +2,13 @@ package v1
 
 import (
 	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/dexguitar/spacecraftory/iam/internal/converter"
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
 	userV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/user/v1"
 )
 
@@ -11,6 +16,12 @@ func (a *api) Register(ctx context.Context, req *userV1.RegisterRequest) (*userV
 	user := converter.ToModelUser(req.Info)
 	userUUID, err := a.userService.Register(ctx, user)
 	if err != nil {
+		if errors.Is(err, model.ErrInvalidPassword) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid password")
+		}
+		if errors.Is(err, model.ErrUserAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "user already exists")
+		}
 		return nil, err
 	}
 	return &userV1.RegisterResponse{
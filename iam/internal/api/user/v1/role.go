@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/converter"
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	authGrpc "github.com/dexguitar/spacecraftory/platform/pkg/middleware/grpc"
+	userV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) GrantRole(ctx context.Context, req *userV1.GrantRoleRequest) (*userV1.GrantRoleResponse, error) {
+	user, err := a.userService.GrantRole(ctx, authGrpc.SessionUUIDFromIncomingMetadata(ctx), req.GetUserUuid(), req.GetRole())
+	if err != nil {
+		if roleErr := roleError(err); roleErr != nil {
+			return nil, roleErr
+		}
+		return nil, status.Errorf(codes.Internal, "failed to grant role")
+	}
+
+	return &userV1.GrantRoleResponse{
+		User: converter.ToProtoUser(user),
+	}, nil
+}
+
+func (a *api) RevokeRole(ctx context.Context, req *userV1.RevokeRoleRequest) (*userV1.RevokeRoleResponse, error) {
+	user, err := a.userService.RevokeRole(ctx, authGrpc.SessionUUIDFromIncomingMetadata(ctx), req.GetUserUuid(), req.GetRole())
+	if err != nil {
+		if roleErr := roleError(err); roleErr != nil {
+			return nil, roleErr
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke role")
+	}
+
+	return &userV1.RevokeRoleResponse{
+		User: converter.ToProtoUser(user),
+	}, nil
+}
+
+// roleError maps errors of changing roles to gRPC statuses, other errors are left to the caller
+func roleError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrUnauthenticated):
+		return status.Errorf(codes.Unauthenticated, "valid session-uuid metadata is required")
+	case errors.Is(err, model.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "only admins may change roles")
+	case errors.Is(err, model.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "user not found")
+	case errors.Is(err, model.ErrRoleNotFound):
+		return status.Errorf(codes.NotFound, "role not found")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/converter"
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	authGrpc "github.com/dexguitar/spacecraftory/platform/pkg/middleware/grpc"
+	userV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) UpdateUser(ctx context.Context, req *userV1.UpdateUserRequest) (*userV1.UpdateUserResponse, error) {
+	user, err := a.userService.UpdateUser(ctx, authGrpc.SessionUUIDFromIncomingMetadata(ctx), req.GetUserUuid(), &model.UserUpdate{
+		Login: req.Login,
+		Email: req.Email,
+	})
+	if err != nil {
+		if accessErr := accessError(err); accessErr != nil {
+			return nil, accessErr
+		}
+		switch {
+		case errors.Is(err, model.ErrEmptyUserUpdate):
+			return nil, status.Errorf(codes.InvalidArgument, "login or email is required")
+		case errors.Is(err, model.ErrInvalidUserUpdate):
+			return nil, status.Errorf(codes.InvalidArgument, "login must not be empty and email must be valid")
+		case errors.Is(err, model.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		case errors.Is(err, model.ErrUserAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "login or email is already taken")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update user")
+	}
+
+	return &userV1.UpdateUserResponse{
+		User: converter.ToProtoUser(user),
+	}, nil
+}
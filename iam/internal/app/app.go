@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/stdlib"
@@ -14,6 +15,8 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/dexguitar/spacecraftory/iam/internal/config"
+	"github.com/dexguitar/spacecraftory/iam/internal/interceptor"
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
 	"github.com/dexguitar/spacecraftory/platform/pkg/closer"
 	"github.com/dexguitar/spacecraftory/platform/pkg/grpc/health"
 	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
@@ -53,6 +56,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initListener,
 		a.initGRPCServer,
 		a.initMigrator,
+		a.initRoleGrants,
 	}
 
 	for _, f := range inits {
@@ -108,6 +112,34 @@ func (a *App) initMigrator(ctx context.Context) error {
 	return nil
 }
 
+// initRoleGrants grants the roles configured in IAM_ROLE_GRANTS to existing users.
+// Users that have not registered yet are skipped until the next start.
+func (a *App) initRoleGrants(ctx context.Context) error {
+	userRepository := a.diContainer.UserRepository(ctx)
+
+	for _, grant := range config.AppConfig().Roles.Grants() {
+		login, role, ok := strings.Cut(grant, ":")
+		if !ok || login == "" || role == "" {
+			return fmt.Errorf("invalid role grant %q, expected login:role", grant)
+		}
+
+		user, err := userRepository.GetUserByLogin(ctx, login)
+		if errors.Is(err, model.ErrUserNotFound) {
+			logger.Warn(ctx, "⚠️ skipping role grant for unknown user", zap.String("login", login), zap.String("role", role))
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to grant role %s to %s: %w", role, login, err)
+		}
+
+		if err = userRepository.AddRole(ctx, user.UUID, role); err != nil {
+			return fmt.Errorf("failed to grant role %s to %s: %w", role, login, err)
+		}
+	}
+
+	return nil
+}
+
 func (a *App) initDI(_ context.Context) error {
 	a.diContainer = NewDiContainer()
 	return nil
@@ -155,7 +187,10 @@ func (a *App) initListener(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		grpc.UnaryInterceptor(interceptor.ValidationInterceptor()),
+	)
 	closer.AddNamed("gRPC server", func(ctx context.Context) error {
 		a.grpcServer.GracefulStop()
 		return nil
@@ -10,6 +10,7 @@ import (
 	authAPI "github.com/dexguitar/spacecraftory/iam/internal/api/auth/v1"
 	userApi "github.com/dexguitar/spacecraftory/iam/internal/api/user/v1"
 	"github.com/dexguitar/spacecraftory/iam/internal/config"
+	"github.com/dexguitar/spacecraftory/iam/internal/password"
 	"github.com/dexguitar/spacecraftory/iam/internal/repository"
 	cacheRepository "github.com/dexguitar/spacecraftory/iam/internal/repository/session"
 	userRepository "github.com/dexguitar/spacecraftory/iam/internal/repository/user"
@@ -35,6 +36,7 @@ type diContainer struct {
 	userService    service.UserService
 	userRepository repository.UserRepository
 	pgPool         *pgxpool.Pool
+	passwordHasher password.Hasher
 }
 
 func NewDiContainer() *diContainer {
@@ -51,7 +53,13 @@ func (d *diContainer) AuthV1API(ctx context.Context) authV1.AuthServiceServer {
 
 func (d *diContainer) AuthService(ctx context.Context) service.AuthService {
 	if d.authService == nil {
-		d.authService = authService.NewService(d.CacheRepository(ctx), d.UserService(ctx), config.AppConfig().Redis.CacheTTL())
+		d.authService = authService.NewService(
+			d.CacheRepository(ctx),
+			d.UserService(ctx),
+			config.AppConfig().Redis.CacheTTL(),
+			config.AppConfig().Session.SlidingExpiration(),
+			config.AppConfig().Session.AbsoluteTTL(),
+		)
 	}
 
 	return d.authService
@@ -97,12 +105,25 @@ func (d *diContainer) UserV1API(ctx context.Context) userV1.UserServiceServer {
 
 func (d *diContainer) UserService(ctx context.Context) service.UserService {
 	if d.userService == nil {
-		d.userService = userService.NewUserService(d.UserRepository(ctx))
+		d.userService = userService.NewUserService(d.UserRepository(ctx), d.CacheRepository(ctx), d.PasswordHasher(ctx))
 	}
 
 	return d.userService
 }
 
+func (d *diContainer) PasswordHasher(_ context.Context) password.Hasher {
+	if d.passwordHasher == nil {
+		hasher, err := password.NewBcryptHasher(config.AppConfig().Password.BcryptCost())
+		if err != nil {
+			panic(fmt.Sprintf("failed to create password hasher: %s", err.Error()))
+		}
+
+		d.passwordHasher = hasher
+	}
+
+	return d.passwordHasher
+}
+
 func (d *diContainer) UserRepository(ctx context.Context) repository.UserRepository {
 	if d.userRepository == nil {
 		d.userRepository = userRepository.NewUserRepository(d.PgPool(ctx))
@@ -15,6 +15,9 @@ type config struct {
 	IAMGRPC  IAMGRPCConfig
 	Postgres PostgresConfig
 	Redis    RedisConfig
+	Password PasswordConfig
+	Session  SessionConfig
+	Roles    RolesConfig
 }
 
 func Load(path ...string) error {
@@ -43,11 +46,29 @@ func Load(path ...string) error {
 		return err
 	}
 
+	passwordCfg, err := env.NewPasswordConfig()
+	if err != nil {
+		return err
+	}
+
+	sessionCfg, err := env.NewSessionConfig()
+	if err != nil {
+		return err
+	}
+
+	rolesCfg, err := env.NewRolesConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:   loggerCfg,
 		IAMGRPC:  iamGRPCCfg,
 		Postgres: postgresCfg,
 		Redis:    redisCfg,
+		Password: passwordCfg,
+		Session:  sessionCfg,
+		Roles:    rolesCfg,
 	}
 
 	return nil
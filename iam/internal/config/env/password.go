@@ -0,0 +1,26 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type passwordEnvConfig struct {
+	BcryptCost int `env:"IAM_PASSWORD_BCRYPT_COST" envDefault:"12"`
+}
+
+type passwordConfig struct {
+	raw passwordEnvConfig
+}
+
+func NewPasswordConfig() (*passwordConfig, error) {
+	var raw passwordEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &passwordConfig{raw: raw}, nil
+}
+
+func (cfg *passwordConfig) BcryptCost() int {
+	return cfg.raw.BcryptCost
+}
@@ -0,0 +1,26 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type rolesEnvConfig struct {
+	Grants []string `env:"IAM_ROLE_GRANTS"`
+}
+
+type rolesConfig struct {
+	raw rolesEnvConfig
+}
+
+func NewRolesConfig() (*rolesConfig, error) {
+	var raw rolesEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &rolesConfig{raw: raw}, nil
+}
+
+func (cfg *rolesConfig) Grants() []string {
+	return cfg.raw.Grants
+}
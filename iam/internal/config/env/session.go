@@ -0,0 +1,33 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type sessionEnvConfig struct {
+	SlidingExpiration bool          `env:"IAM_SESSION_SLIDING_EXPIRATION" envDefault:"false"`
+	AbsoluteTTL       time.Duration `env:"IAM_SESSION_ABSOLUTE_TTL" envDefault:"720h"`
+}
+
+type sessionConfig struct {
+	raw sessionEnvConfig
+}
+
+func NewSessionConfig() (*sessionConfig, error) {
+	var raw sessionEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &sessionConfig{raw: raw}, nil
+}
+
+func (cfg *sessionConfig) SlidingExpiration() bool {
+	return cfg.raw.SlidingExpiration
+}
+
+func (cfg *sessionConfig) AbsoluteTTL() time.Duration {
+	return cfg.raw.AbsoluteTTL
+}
@@ -25,3 +25,18 @@ type RedisConfig interface {
 	IdleTimeout() time.Duration
 	CacheTTL() time.Duration
 }
+
+type PasswordConfig interface {
+	BcryptCost() int
+}
+
+type SessionConfig interface {
+	SlidingExpiration() bool
+	AbsoluteTTL() time.Duration
+}
+
+// RolesConfig holds roles granted on startup as "login:role" pairs, so the first
+// admin can be appointed before anyone may call GrantRole.
+type RolesConfig interface {
+	Grants() []string
+}
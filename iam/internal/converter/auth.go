@@ -24,3 +24,11 @@ func ToProtoSession(session *model.Session) *commonV1.Session {
 		ExpiresAt: timestamppb.New(session.ExpiresAt),
 	}
 }
+
+func ToProtoSessions(sessions []*model.Session) []*commonV1.Session {
+	result := make([]*commonV1.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, ToProtoSession(session))
+	}
+	return result
+}
@@ -21,21 +21,26 @@ func ToModelUserInfo(userInfo *commonV1.UserInfo) *model.UserInfo {
 	}
 }
 
+func ToModelNotificationMethod(method *commonV1.NotificationMethod) model.NotificationMethod {
+	return model.NotificationMethod{
+		ProviderName: method.GetProviderName(),
+		Target:       method.GetTarget(),
+	}
+}
+
 func toModelNotificationMethods(methods []*commonV1.NotificationMethod) []model.NotificationMethod {
 	result := make([]model.NotificationMethod, 0, len(methods))
 	for _, m := range methods {
-		result = append(result, model.NotificationMethod{
-			ProviderName: m.GetProviderName(),
-			Target:       m.GetTarget(),
-		})
+		result = append(result, ToModelNotificationMethod(m))
 	}
 	return result
 }
 
 func ToProtoUser(user *model.User) *commonV1.User {
 	return &commonV1.User{
-		Uuid: user.UUID,
-		Info: ToProtoUserInfo(&user.Info),
+		Uuid:  user.UUID,
+		Info:  ToProtoUserInfo(&user.Info),
+		Roles: user.Roles,
 	}
 }
 
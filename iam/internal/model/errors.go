@@ -5,7 +5,19 @@ import "errors"
 var (
 	ErrUserNotFound      = errors.New("user not found")
 	ErrSessionNotFound   = errors.New("session not found")
+	ErrForeignSession    = errors.New("session does not belong to the user")
+	ErrUnauthenticated   = errors.New("unauthenticated")
+	ErrForbidden         = errors.New("forbidden")
 	ErrInvalidLoginData  = errors.New("invalid login data")
 	ErrInvalidFilter     = errors.New("invalid filter")
 	ErrUserAlreadyExists = errors.New("user already exists")
+
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidPassword    = errors.New("invalid password")
+	ErrRoleNotFound       = errors.New("role not found")
+	ErrEmptyUserUpdate    = errors.New("nothing to update")
+	ErrInvalidUserUpdate  = errors.New("invalid login or email")
+
+	ErrNotificationMethodNotFound      = errors.New("notification method not found")
+	ErrNotificationMethodAlreadyExists = errors.New("notification method already exists")
 )
@@ -1,9 +1,20 @@
 package model
 
+import "slices"
+
 type User struct {
 	UUID     string
 	Info     UserInfo
 	Password string
+	Roles    []string
+}
+
+// RoleAdmin may act on behalf of any user
+const RoleAdmin = "admin"
+
+// HasRole reports whether the user was granted the role
+func (u *User) HasRole(role string) bool {
+	return slices.Contains(u.Roles, role)
 }
 
 type UserInfo struct {
@@ -22,6 +33,12 @@ type UserFilter struct {
 	LoginData *LoginData
 }
 
+// UserUpdate holds the fields to change; nil fields are left untouched.
+type UserUpdate struct {
+	Login *string
+	Email *string
+}
+
 type LoginData struct {
 	Login    string
 	Password string
@@ -0,0 +1,67 @@
+package password
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcryptPrefix is shared by every hash produced by golang.org/x/crypto/bcrypt
+// ($2a$, $2b$, $2y$). Stored values without it are legacy plaintext passwords.
+const bcryptPrefix = "$2"
+
+// Hasher hashes and verifies user passwords.
+type Hasher interface {
+	// Hash returns the encoded hash of the given password.
+	Hash(password string) (string, error)
+	// Verify reports whether password matches the stored value and whether
+	// the stored value should be replaced with a fresh hash.
+	Verify(stored, password string) (ok, needsRehash bool, err error)
+}
+
+type bcryptHasher struct {
+	cost int
+}
+
+func NewBcryptHasher(cost int) (*bcryptHasher, error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("bcrypt cost must be between %d and %d, got %d", bcrypt.MinCost, bcrypt.MaxCost, cost)
+	}
+
+	return &bcryptHasher{cost: cost}, nil
+}
+
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+func (h *bcryptHasher) Verify(stored, password string) (bool, bool, error) {
+	if !strings.HasPrefix(stored, bcryptPrefix) {
+		// legacy row written before hashing was introduced
+		ok := subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+		return ok, ok, nil
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(stored), []byte(password))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, false, nil
+		}
+		return false, false, err
+	}
+
+	cost, err := bcrypt.Cost([]byte(stored))
+	if err != nil {
+		return false, false, err
+	}
+
+	return true, cost != h.cost, nil
+}
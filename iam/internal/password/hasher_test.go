@@ -0,0 +1,75 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestNewBcryptHasherInvalidCost(t *testing.T) {
+	_, err := NewBcryptHasher(bcrypt.MinCost - 1)
+	assert.Error(t, err)
+
+	_, err = NewBcryptHasher(bcrypt.MaxCost + 1)
+	assert.Error(t, err)
+}
+
+func TestHashAndVerify(t *testing.T) {
+	h, err := NewBcryptHasher(bcrypt.MinCost)
+	require.NoError(t, err)
+
+	hash, err := h.Hash("correct horse")
+	require.NoError(t, err)
+	assert.NotEqual(t, "correct horse", hash)
+
+	ok, needsRehash, err := h.Verify(hash, "correct horse")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.False(t, needsRehash)
+
+	ok, needsRehash, err = h.Verify(hash, "wrong horse")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.False(t, needsRehash)
+}
+
+func TestVerifyOutdatedCost(t *testing.T) {
+	old, err := NewBcryptHasher(bcrypt.MinCost)
+	require.NoError(t, err)
+	hash, err := old.Hash("correct horse")
+	require.NoError(t, err)
+
+	h, err := NewBcryptHasher(bcrypt.MinCost + 1)
+	require.NoError(t, err)
+
+	ok, needsRehash, err := h.Verify(hash, "correct horse")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, needsRehash)
+}
+
+func TestVerifyLegacyPlaintext(t *testing.T) {
+	h, err := NewBcryptHasher(bcrypt.MinCost)
+	require.NoError(t, err)
+
+	ok, needsRehash, err := h.Verify("correct horse", "correct horse")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, needsRehash)
+
+	// a wrong password never upgrades the legacy row
+	ok, needsRehash, err = h.Verify("correct horse", "wrong horse")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.False(t, needsRehash)
+}
+
+func TestHashTooLong(t *testing.T) {
+	h, err := NewBcryptHasher(bcrypt.MinCost)
+	require.NoError(t, err)
+
+	_, err = h.Hash(string(make([]byte, 73)))
+	assert.ErrorIs(t, err, bcrypt.ErrPasswordTooLong)
+}
@@ -5,10 +5,11 @@ import (
 	repoModel "github.com/dexguitar/spacecraftory/iam/internal/repository/model"
 )
 
-func ToModelUserFromRow(row *repoModel.UserRow, methods []repoModel.NotificationMethodRow) *model.User {
+func ToModelUserFromRow(row *repoModel.UserRow, methods []repoModel.NotificationMethodRow, roles []string) *model.User {
 	return &model.User{
 		UUID:     row.ID,
 		Password: row.Password,
+		Roles:    roles,
 		Info: model.UserInfo{
 			Login:               row.Login,
 			Email:               row.Email,
@@ -11,10 +11,23 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *model.User) (string, error)
 	GetUserByUUID(ctx context.Context, userUUID string) (*model.User, error)
 	GetUserByLogin(ctx context.Context, login string) (*model.User, error)
+	UpdateUser(ctx context.Context, userUUID string, update *model.UserUpdate) error
+	UpdatePassword(ctx context.Context, userUUID, passwordHash string) error
+	AddNotificationMethod(ctx context.Context, userUUID string, method model.NotificationMethod) error
+	RemoveNotificationMethod(ctx context.Context, userUUID string, method model.NotificationMethod) error
+	// AddRole grants an existing role to the user, granting a role twice is a no-op
+	AddRole(ctx context.Context, userUUID, role string) error
+	RemoveRole(ctx context.Context, userUUID, role string) error
 }
 
 type CacheRepository interface {
 	Get(ctx context.Context, uuid string) (*model.Session, error)
 	Set(ctx context.Context, session *model.Session, ttl time.Duration) error
+	// Extend moves the deadline of an existing session, it never recreates a deleted one
+	Extend(ctx context.Context, session *model.Session, ttl time.Duration) error
 	Delete(ctx context.Context, uuid string) error
+	ListByUser(ctx context.Context, userUUID string) ([]*model.Session, error)
+	DeleteByUser(ctx context.Context, userUUID, exceptUUID string) (int, error)
+	// Rotate replaces the session only if it still exists, otherwise returns ErrSessionNotFound
+	Rotate(ctx context.Context, oldSession, newSession *model.Session, ttl time.Duration) error
 }
@@ -2,9 +2,56 @@ package session
 
 import (
 	"context"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/cache"
 )
 
 func (r *repository) Delete(ctx context.Context, uuid string) error {
+	session, err := r.Get(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
 	cacheKey := r.getCacheKey(uuid)
-	return r.cache.Del(ctx, cacheKey)
+	userSessionSetKey := r.getUserSessionSetKey(session.UserUUID)
+
+	return r.cache.TxPipeline(ctx, func(tx cache.TxPipeliner) error {
+		if err := tx.Del(cacheKey); err != nil {
+			return err
+		}
+		return tx.SRem(userSessionSetKey, uuid)
+	})
+}
+
+// DeleteByUser removes every session of the user except exceptUUID (if set)
+// and returns the number of removed sessions.
+func (r *repository) DeleteByUser(ctx context.Context, userUUID, exceptUUID string) (int, error) {
+	sessions, err := r.ListByUser(ctx, userUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	userSessionSetKey := r.getUserSessionSetKey(userUUID)
+	deleted := 0
+
+	err = r.cache.TxPipeline(ctx, func(tx cache.TxPipeliner) error {
+		for _, session := range sessions {
+			if session.UUID == exceptUUID {
+				continue
+			}
+			if err := tx.Del(r.getCacheKey(session.UUID)); err != nil {
+				return err
+			}
+			if err := tx.SRem(userSessionSetKey, session.UUID); err != nil {
+				return err
+			}
+			deleted++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
 }
@@ -0,0 +1,38 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+// extendScript moves the deadline of a session only while its hash still exists,
+// so a session revoked after it was read is not written back.
+const extendScript = `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'updated_at', ARGV[1], 'expires_at', ARGV[2])
+redis.call('EXPIRE', KEYS[1], ARGV[3])
+return 1
+`
+
+// Extend stores the new UpdatedAt and ExpiresAt of the session and resets its ttl.
+// It returns ErrSessionNotFound if the session was deleted or has expired.
+func (r *repository) Extend(ctx context.Context, session *model.Session, ttl time.Duration) error {
+	extended, err := redigo.Int(r.cache.Eval(ctx, extendScript,
+		[]string{r.getCacheKey(session.UUID)},
+		session.UpdatedAt.Unix(), session.ExpiresAt.Unix(), int(ttl.Seconds()),
+	))
+	if err != nil {
+		return err
+	}
+	if extended == 0 {
+		return model.ErrSessionNotFound
+	}
+
+	return nil
+}
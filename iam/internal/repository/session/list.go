@@ -0,0 +1,38 @@
+package session
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+// ListByUser returns live sessions of the user. Set members whose session hash
+// has already expired in redis are removed from the set along the way.
+func (r *repository) ListByUser(ctx context.Context, userUUID string) ([]*model.Session, error) {
+	userSessionSetKey := r.getUserSessionSetKey(userUUID)
+
+	uuids, err := r.cache.SMembers(ctx, userSessionSetKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*model.Session, 0, len(uuids))
+	for _, uuid := range uuids {
+		session, err := r.Get(ctx, uuid)
+		if err != nil {
+			if errors.Is(err, model.ErrSessionNotFound) {
+				if err = r.cache.SRem(ctx, userSessionSetKey, uuid); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
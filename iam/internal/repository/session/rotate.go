@@ -0,0 +1,51 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+// rotateScript deletes the old session and stores the new one only if the old one
+// still existed, so two concurrent refreshes or a refresh racing a revoke leave at
+// most one live session.
+const rotateScript = `
+if redis.call('DEL', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('SREM', KEYS[3], ARGV[1])
+redis.call('HSET', KEYS[2], 'uuid', ARGV[2], 'user_uuid', ARGV[3], 'created_at', ARGV[4], 'updated_at', ARGV[5], 'expires_at', ARGV[6])
+redis.call('EXPIRE', KEYS[2], ARGV[7])
+redis.call('SADD', KEYS[3], ARGV[2])
+return 1
+`
+
+// Rotate atomically replaces oldSession with newSession. It returns ErrSessionNotFound
+// if oldSession was already deleted, rotated or has expired.
+func (r *repository) Rotate(ctx context.Context, oldSession, newSession *model.Session, ttl time.Duration) error {
+	rotated, err := redigo.Int(r.cache.Eval(ctx, rotateScript,
+		[]string{
+			r.getCacheKey(oldSession.UUID),
+			r.getCacheKey(newSession.UUID),
+			r.getUserSessionSetKey(newSession.UserUUID),
+		},
+		oldSession.UUID,
+		newSession.UUID,
+		newSession.UserUUID,
+		newSession.CreatedAt.Unix(),
+		newSession.UpdatedAt.Unix(),
+		newSession.ExpiresAt.Unix(),
+		int(ttl.Seconds()),
+	))
+	if err != nil {
+		return err
+	}
+	if rotated == 0 {
+		return model.ErrSessionNotFound
+	}
+
+	return nil
+}
@@ -42,7 +42,12 @@ func (r *userRepository) GetUserByUUID(ctx context.Context, userUUID string) (*m
 		return nil, err
 	}
 
-	return converter.ToModelUserFromRow(&row, methods), nil
+	roles, err := r.getRoles(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToModelUserFromRow(&row, methods, roles), nil
 }
 
 func (r *userRepository) GetUserByLogin(ctx context.Context, login string) (*model.User, error) {
@@ -75,7 +80,12 @@ func (r *userRepository) GetUserByLogin(ctx context.Context, login string) (*mod
 		return nil, err
 	}
 
-	return converter.ToModelUserFromRow(&row, methods), nil
+	roles, err := r.getRoles(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToModelUserFromRow(&row, methods, roles), nil
 }
 
 func (r *userRepository) getNotificationMethods(ctx context.Context, userUUID string) ([]repoModel.NotificationMethodRow, error) {
@@ -97,3 +107,25 @@ func (r *userRepository) getNotificationMethods(ctx context.Context, userUUID st
 
 	return pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.NotificationMethodRow])
 }
+
+func (r *userRepository) getRoles(ctx context.Context, userUUID string) ([]string, error) {
+	query := sq.Select("r.name").
+		From("user_roles ur").
+		Join("roles r ON r.id = ur.role_id").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"ur.user_uuid": userUUID}).
+		OrderBy("r.name")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
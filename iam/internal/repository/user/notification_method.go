@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (r *userRepository) AddNotificationMethod(ctx context.Context, userUUID string, method model.NotificationMethod) error {
+	insert := sq.Insert("notification_methods").
+		PlaceholderFormat(sq.Dollar).
+		Columns("user_uuid", "provider_name", "target").
+		Values(userUUID, method.ProviderName, method.Target)
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return model.ErrNotificationMethodAlreadyExists
+			case pgerrcode.ForeignKeyViolation:
+				return model.ErrUserNotFound
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepository) RemoveNotificationMethod(ctx context.Context, userUUID string, method model.NotificationMethod) error {
+	del := sq.Delete("notification_methods").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{
+			"user_uuid":     userUUID,
+			"provider_name": method.ProviderName,
+			"target":        method.Target,
+		})
+
+	query, args, err := del.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return model.ErrNotificationMethodNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (r *userRepository) AddRole(ctx context.Context, userUUID, role string) error {
+	roleID, err := r.getRoleID(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := sq.Insert("user_roles").
+		PlaceholderFormat(sq.Dollar).
+		Columns("user_uuid", "role_id").
+		Values(userUUID, roleID).
+		Suffix("on conflict do nothing").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepository) RemoveRole(ctx context.Context, userUUID, role string) error {
+	roleID, err := r.getRoleID(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := sq.Delete("user_roles").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{
+			"user_uuid": userUUID,
+			"role_id":   roleID,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *userRepository) getRoleID(ctx context.Context, role string) (string, error) {
+	query, args, err := sq.Select("id").
+		From("roles").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"name": role}).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var roleID string
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&roleID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", model.ErrRoleNotFound
+		}
+		return "", err
+	}
+
+	return roleID, nil
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (r *userRepository) UpdateUser(ctx context.Context, userUUID string, update *model.UserUpdate) error {
+	userUpdate := sq.Update("users").
+		PlaceholderFormat(sq.Dollar).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": userUUID})
+
+	if update.Login != nil {
+		userUpdate = userUpdate.Set("login", *update.Login)
+	}
+	if update.Email != nil {
+		userUpdate = userUpdate.Set("email", *update.Email)
+	}
+
+	return r.execUpdate(ctx, userUpdate)
+}
+
+func (r *userRepository) UpdatePassword(ctx context.Context, userUUID, passwordHash string) error {
+	userUpdate := sq.Update("users").
+		PlaceholderFormat(sq.Dollar).
+		Set("password", passwordHash).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": userUUID})
+
+	return r.execUpdate(ctx, userUpdate)
+}
+
+func (r *userRepository) execUpdate(ctx context.Context, userUpdate sq.UpdateBuilder) error {
+	query, args, err := userUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return model.ErrUserAlreadyExists
+		}
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return model.ErrUserNotFound
+	}
+
+	return nil
+}
@@ -10,26 +10,21 @@ import (
 )
 
 func (s *service) Login(ctx context.Context, login, password string) (string, error) {
-	filter := &model.UserFilter{
-		LoginData: &model.LoginData{
-			Login:    login,
-			Password: password,
-		},
-	}
-	user, err := s.userService.GetUser(ctx, filter)
+	user, err := s.userService.VerifyCredentials(ctx, login, password)
 	if err != nil {
 		return "", err
 	}
 
+	now := time.Now()
 	session := &model.Session{
 		UUID:      uuid.New().String(),
 		UserUUID:  user.UUID,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(s.cacheTTL),
+		CreatedAt: now,
+		UpdatedAt: now,
+		ExpiresAt: s.expiresAt(now, now),
 	}
 
-	if err = s.cacheRepository.Set(ctx, session, s.cacheTTL); err != nil {
+	if err = s.cacheRepository.Set(ctx, session, session.ExpiresAt.Sub(now)); err != nil {
 		return "", err
 	}
 
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestLoginCreatesSession() {
+	s.userService.On("VerifyCredentials", s.ctx, "pilot", "correct horse").
+		Return(&model.User{UUID: userUUID}, nil).Once()
+	s.cacheRepository.On("Set", s.ctx, mock.MatchedBy(func(session *model.Session) bool {
+		return session.UUID != "" &&
+			session.UserUUID == userUUID &&
+			session.ExpiresAt.Sub(session.CreatedAt) == testCacheTTL
+	}), testCacheTTL).Return(nil).Once()
+
+	sessionUUID, err := s.service.Login(s.ctx, "pilot", "correct horse")
+
+	s.Require().NoError(err)
+	assert.NotEmpty(s.T(), sessionUUID)
+}
+
+func (s *ServiceSuite) TestLoginCappedByAbsoluteLifetime() {
+	s.service = NewService(s.cacheRepository, s.userService, testCacheTTL, true, 30*time.Minute)
+
+	s.userService.On("VerifyCredentials", s.ctx, "pilot", "correct horse").
+		Return(&model.User{UUID: userUUID}, nil).Once()
+	s.cacheRepository.On("Set", s.ctx, mock.Anything, 30*time.Minute).Return(nil).Once()
+
+	_, err := s.service.Login(s.ctx, "pilot", "correct horse")
+
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestLoginInvalidCredentials() {
+	s.userService.On("VerifyCredentials", s.ctx, "pilot", "wrong horse").
+		Return(nil, model.ErrInvalidCredentials).Once()
+
+	sessionUUID, err := s.service.Login(s.ctx, "pilot", "wrong horse")
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidCredentials)
+	assert.Empty(s.T(), sessionUUID)
+}
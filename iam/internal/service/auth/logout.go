@@ -0,0 +1,9 @@
+package auth
+
+import (
+	"context"
+)
+
+func (s *service) Logout(ctx context.Context, sessionUUID string) error {
+	return s.cacheRepository.Delete(ctx, sessionUUID)
+}
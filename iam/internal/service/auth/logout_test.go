@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestLogout() {
+	s.cacheRepository.On("Delete", s.ctx, userSessionUUID).Return(nil).Once()
+
+	err := s.service.Logout(s.ctx, userSessionUUID)
+
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestLogoutUnknownSession() {
+	s.cacheRepository.On("Delete", s.ctx, userSessionUUID).Return(model.ErrSessionNotFound).Once()
+
+	err := s.service.Logout(s.ctx, userSessionUUID)
+
+	assert.ErrorIs(s.T(), err, model.ErrSessionNotFound)
+}
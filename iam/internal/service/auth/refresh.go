@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+// RefreshSession replaces the session with a new one under a fresh UUID.
+// CreatedAt is carried over so rotation cannot outlive the absolute lifetime.
+// Only one of concurrent refreshes of a session succeeds, the others and a refresh
+// of a session revoked meanwhile get ErrSessionNotFound.
+func (s *service) RefreshSession(ctx context.Context, sessionUUID string) (*model.Session, error) {
+	session, err := s.cacheRepository.Get(ctx, sessionUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := s.expiresAt(session.CreatedAt, now)
+	if !expiresAt.After(now) {
+		return nil, model.ErrSessionNotFound
+	}
+
+	rotated := &model.Session{
+		UUID:      uuid.New().String(),
+		UserUUID:  session.UserUUID,
+		CreatedAt: session.CreatedAt,
+		UpdatedAt: now,
+		ExpiresAt: expiresAt,
+	}
+
+	if err = s.cacheRepository.Rotate(ctx, session, rotated, expiresAt.Sub(now)); err != nil {
+		return nil, err
+	}
+
+	return rotated, nil
+}
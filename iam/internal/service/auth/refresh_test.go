@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestRefreshSessionRotates() {
+	current := newSession(userSessionUUID, 30*time.Minute)
+
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(current, nil).Once()
+	s.cacheRepository.On("Rotate", s.ctx, current, mock.MatchedBy(func(rotated *model.Session) bool {
+		return rotated.UUID != userSessionUUID &&
+			rotated.UserUUID == userUUID &&
+			rotated.CreatedAt.Equal(current.CreatedAt)
+	}), testCacheTTL).Return(nil).Once()
+
+	rotated, err := s.service.RefreshSession(s.ctx, userSessionUUID)
+
+	s.Require().NoError(err)
+	assert.NotEqual(s.T(), userSessionUUID, rotated.UUID)
+	assert.True(s.T(), rotated.ExpiresAt.After(current.ExpiresAt))
+}
+
+func (s *ServiceSuite) TestRefreshSessionPastAbsoluteLifetime() {
+	current := newSession(userSessionUUID, testAbsoluteTTL+time.Minute)
+
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(current, nil).Once()
+
+	_, err := s.service.RefreshSession(s.ctx, userSessionUUID)
+
+	assert.ErrorIs(s.T(), err, model.ErrSessionNotFound)
+}
+
+func (s *ServiceSuite) TestRefreshSessionConcurrentlyRevoked() {
+	current := newSession(userSessionUUID, 0)
+
+	// another refresh or a revoke removed the session after it was read
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(current, nil).Once()
+	s.cacheRepository.On("Rotate", s.ctx, current, mock.Anything, mock.Anything).
+		Return(model.ErrSessionNotFound).Once()
+
+	rotated, err := s.service.RefreshSession(s.ctx, userSessionUUID)
+
+	assert.ErrorIs(s.T(), err, model.ErrSessionNotFound)
+	assert.Nil(s.T(), rotated)
+}
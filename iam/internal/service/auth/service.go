@@ -8,19 +8,37 @@ import (
 )
 
 type service struct {
-	cacheRepository repository.CacheRepository
-	cacheTTL        time.Duration
-	userService     userService.UserService
+	cacheRepository   repository.CacheRepository
+	cacheTTL          time.Duration
+	slidingExpiration bool
+	absoluteTTL       time.Duration
+	userService       userService.UserService
 }
 
 func NewService(
 	cacheRepository repository.CacheRepository,
 	userService userService.UserService,
 	cacheTTL time.Duration,
+	slidingExpiration bool,
+	absoluteTTL time.Duration,
 ) *service {
 	return &service{
-		cacheRepository: cacheRepository,
-		cacheTTL:        cacheTTL,
-		userService:     userService,
+		cacheRepository:   cacheRepository,
+		cacheTTL:          cacheTTL,
+		slidingExpiration: slidingExpiration,
+		absoluteTTL:       absoluteTTL,
+		userService:       userService,
 	}
 }
+
+// expiresAt returns the idle deadline of a session created at createdAt,
+// capped by the absolute session lifetime.
+func (s *service) expiresAt(createdAt, now time.Time) time.Time {
+	expiresAt := now.Add(s.cacheTTL)
+	if s.absoluteTTL > 0 {
+		if limit := createdAt.Add(s.absoluteTTL); expiresAt.After(limit) {
+			return limit
+		}
+	}
+	return expiresAt
+}
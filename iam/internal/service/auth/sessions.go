@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *service) ListSessions(ctx context.Context, callerSessionUUID, userUUID string) ([]*model.Session, error) {
+	if err := s.authorizeFor(ctx, callerSessionUUID, userUUID); err != nil {
+		return nil, err
+	}
+
+	return s.cacheRepository.ListByUser(ctx, userUUID)
+}
+
+func (s *service) RevokeAllSessions(ctx context.Context, callerSessionUUID, userUUID string) (int, error) {
+	if err := s.authorizeFor(ctx, callerSessionUUID, userUUID); err != nil {
+		return 0, err
+	}
+
+	return s.cacheRepository.DeleteByUser(ctx, userUUID, "")
+}
+
+// authorizeFor checks that the caller session may act on behalf of userUUID:
+// it belongs to that user or to an admin. The target user has to exist.
+func (s *service) authorizeFor(ctx context.Context, callerSessionUUID, userUUID string) error {
+	if callerSessionUUID == "" {
+		return model.ErrUnauthenticated
+	}
+
+	session, err := s.cacheRepository.Get(ctx, callerSessionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrSessionNotFound) {
+			return model.ErrUnauthenticated
+		}
+		return err
+	}
+
+	if session.UserUUID != userUUID {
+		caller, err := s.userService.GetUser(ctx, &model.UserFilter{UUID: &session.UserUUID})
+		if err != nil {
+			return err
+		}
+		if !caller.HasRole(model.RoleAdmin) {
+			return model.ErrForbidden
+		}
+	}
+
+	_, err = s.userService.GetUser(ctx, &model.UserFilter{UUID: &userUUID})
+	return err
+}
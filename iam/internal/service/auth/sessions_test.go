@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestListSessionsByOwner() {
+	sessions := []*model.Session{newSession(userSessionUUID, 0)}
+
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(sessions[0], nil).Once()
+	s.expectUser(&model.User{UUID: userUUID}, nil)
+	s.cacheRepository.On("ListByUser", s.ctx, userUUID).Return(sessions, nil).Once()
+
+	listed, err := s.service.ListSessions(s.ctx, userSessionUUID, userUUID)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), sessions, listed)
+}
+
+func (s *ServiceSuite) TestListSessionsByAdmin() {
+	adminSession := &model.Session{UUID: adminSessionUUID, UserUUID: adminUUID}
+
+	s.cacheRepository.On("Get", s.ctx, adminSessionUUID).Return(adminSession, nil).Once()
+	s.expectUser(&model.User{UUID: adminUUID, Roles: []string{model.RoleAdmin}}, nil)
+	s.expectUser(&model.User{UUID: userUUID}, nil)
+	s.cacheRepository.On("ListByUser", s.ctx, userUUID).Return([]*model.Session{}, nil).Once()
+
+	listed, err := s.service.ListSessions(s.ctx, adminSessionUUID, userUUID)
+
+	s.Require().NoError(err)
+	assert.Empty(s.T(), listed)
+}
+
+func (s *ServiceSuite) TestListSessionsAccessDenied() {
+	s.Run("No caller session", func() {
+		_, err := s.service.ListSessions(s.ctx, "", userUUID)
+
+		assert.ErrorIs(s.T(), err, model.ErrUnauthenticated)
+	})
+
+	s.Run("Expired caller session", func() {
+		s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(nil, model.ErrSessionNotFound).Once()
+
+		_, err := s.service.ListSessions(s.ctx, userSessionUUID, userUUID)
+
+		assert.ErrorIs(s.T(), err, model.ErrUnauthenticated)
+	})
+
+	s.Run("Another user", func() {
+		callerSession := &model.Session{UUID: userSessionUUID, UserUUID: otherUserUUID}
+
+		s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(callerSession, nil).Once()
+		s.expectUser(&model.User{UUID: otherUserUUID}, nil)
+
+		_, err := s.service.ListSessions(s.ctx, userSessionUUID, userUUID)
+
+		assert.ErrorIs(s.T(), err, model.ErrForbidden)
+	})
+}
+
+func (s *ServiceSuite) TestRevokeAllSessions() {
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(newSession(userSessionUUID, 0), nil).Once()
+	s.expectUser(&model.User{UUID: userUUID}, nil)
+	// the caller session is revoked as well
+	s.cacheRepository.On("DeleteByUser", s.ctx, userUUID, "").Return(3, nil).Once()
+
+	revoked, err := s.service.RevokeAllSessions(s.ctx, userSessionUUID, userUUID)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 3, revoked)
+}
+
+func (s *ServiceSuite) TestRevokeAllSessionsUnknownUser() {
+	adminSession := &model.Session{UUID: adminSessionUUID, UserUUID: adminUUID}
+
+	s.cacheRepository.On("Get", s.ctx, adminSessionUUID).Return(adminSession, nil).Once()
+	s.expectUser(&model.User{UUID: adminUUID, Roles: []string{model.RoleAdmin}}, nil)
+	s.expectUser(&model.User{UUID: otherUserUUID}, model.ErrUserNotFound)
+
+	_, err := s.service.RevokeAllSessions(s.ctx, adminSessionUUID, otherUserUUID)
+
+	assert.ErrorIs(s.T(), err, model.ErrUserNotFound)
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	"github.com/dexguitar/spacecraftory/iam/internal/repository/mocks"
+	serviceMocks "github.com/dexguitar/spacecraftory/iam/internal/service/mocks"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+const (
+	testCacheTTL    = time.Hour
+	testAbsoluteTTL = 24 * time.Hour
+
+	userUUID         = "123e4567-e89b-12d3-a456-426614174000"
+	otherUserUUID    = "123e4567-e89b-12d3-a456-426614174001"
+	adminUUID        = "123e4567-e89b-12d3-a456-426614174002"
+	userSessionUUID  = "123e4567-e89b-12d3-a456-426614174100"
+	adminSessionUUID = "123e4567-e89b-12d3-a456-426614174101"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx             context.Context
+	cacheRepository *mocks.CacheRepository
+	userService     *serviceMocks.UserService
+	service         *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	logger.SetNopLogger()
+
+	s.ctx = context.Background()
+
+	s.cacheRepository = mocks.NewCacheRepository(s.T())
+	s.userService = serviceMocks.NewUserService(s.T())
+
+	s.service = NewService(s.cacheRepository, s.userService, testCacheTTL, true, testAbsoluteTTL)
+}
+
+// newSession returns a session of userUUID created createdAgo and idle until its ttl runs out
+func newSession(uuid string, createdAgo time.Duration) *model.Session {
+	createdAt := time.Now().Add(-createdAgo).Truncate(time.Second)
+	return &model.Session{
+		UUID:      uuid,
+		UserUUID:  userUUID,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+		ExpiresAt: createdAt.Add(testCacheTTL),
+	}
+}
+
+// expectUser expects the user to be looked up by UUID
+func (s *ServiceSuite) expectUser(user *model.User, err error) {
+	s.userService.On("GetUser", s.ctx, &model.UserFilter{UUID: &user.UUID}).
+		Return(user, err).Once()
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
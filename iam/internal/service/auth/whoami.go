@@ -2,8 +2,13 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
 )
 
 func (s *service) WhoAmI(ctx context.Context, sessionUUID string) (*model.Session, *model.User, error) {
@@ -19,5 +24,38 @@ func (s *service) WhoAmI(ctx context.Context, sessionUUID string) (*model.Sessio
 		return nil, nil, err
 	}
 
+	if s.slidingExpiration {
+		err = s.extend(ctx, session)
+		if errors.Is(err, model.ErrSessionNotFound) {
+			// revoked after it was read
+			return nil, nil, err
+		}
+		if err != nil {
+			// the session is still valid until its current deadline
+			logger.Error(ctx, "failed to extend session", zap.String("session_uuid", session.UUID), zap.Error(err))
+		}
+	}
+
 	return session, user, nil
 }
+
+// extend moves the session deadline forward on activity. Once the absolute
+// lifetime is reached the session is left to expire on its own.
+func (s *service) extend(ctx context.Context, session *model.Session) error {
+	now := time.Now()
+	expiresAt := s.expiresAt(session.CreatedAt, now)
+	if !expiresAt.After(session.ExpiresAt) {
+		return nil
+	}
+
+	extended := *session
+	extended.UpdatedAt = now
+	extended.ExpiresAt = expiresAt
+
+	if err := s.cacheRepository.Extend(ctx, &extended, expiresAt.Sub(now)); err != nil {
+		return err
+	}
+
+	*session = extended
+	return nil
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestWhoAmIExtendsSession() {
+	current := newSession(userSessionUUID, 30*time.Minute)
+	createdAt := current.CreatedAt
+
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(current, nil).Once()
+	s.expectUser(&model.User{UUID: userUUID}, nil)
+	s.cacheRepository.On("Extend", s.ctx, mock.MatchedBy(func(extended *model.Session) bool {
+		return extended.UUID == userSessionUUID && extended.CreatedAt.Equal(createdAt)
+	}), testCacheTTL).Return(nil).Once()
+
+	session, user, err := s.service.WhoAmI(s.ctx, userSessionUUID)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), userUUID, user.UUID)
+	assert.True(s.T(), session.ExpiresAt.After(createdAt.Add(testCacheTTL)))
+}
+
+func (s *ServiceSuite) TestWhoAmIWithoutSlidingExpiration() {
+	s.service = NewService(s.cacheRepository, s.userService, testCacheTTL, false, testAbsoluteTTL)
+	current := newSession(userSessionUUID, 30*time.Minute)
+	expiresAt := current.ExpiresAt
+
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(current, nil).Once()
+	s.expectUser(&model.User{UUID: userUUID}, nil)
+
+	session, _, err := s.service.WhoAmI(s.ctx, userSessionUUID)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), expiresAt, session.ExpiresAt)
+}
+
+func (s *ServiceSuite) TestWhoAmIAtAbsoluteLifetime() {
+	// the deadline is already capped by the absolute lifetime, nothing to extend
+	current := newSession(userSessionUUID, testAbsoluteTTL-testCacheTTL/2)
+	current.ExpiresAt = current.CreatedAt.Add(testAbsoluteTTL)
+
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(current, nil).Once()
+	s.expectUser(&model.User{UUID: userUUID}, nil)
+
+	_, _, err := s.service.WhoAmI(s.ctx, userSessionUUID)
+
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestWhoAmIRevokedWhileExtending() {
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(newSession(userSessionUUID, 0), nil).Once()
+	s.expectUser(&model.User{UUID: userUUID}, nil)
+	s.cacheRepository.On("Extend", s.ctx, mock.Anything, mock.Anything).Return(model.ErrSessionNotFound).Once()
+
+	_, _, err := s.service.WhoAmI(s.ctx, userSessionUUID)
+
+	assert.ErrorIs(s.T(), err, model.ErrSessionNotFound)
+}
+
+func (s *ServiceSuite) TestWhoAmIExtendFailure() {
+	current := newSession(userSessionUUID, 0)
+	expiresAt := current.ExpiresAt
+
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(current, nil).Once()
+	s.expectUser(&model.User{UUID: userUUID}, nil)
+	s.cacheRepository.On("Extend", s.ctx, mock.Anything, mock.Anything).Return(errors.New("redis is down")).Once()
+
+	// the session stays valid until its current deadline
+	session, _, err := s.service.WhoAmI(s.ctx, userSessionUUID)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), expiresAt, session.ExpiresAt)
+}
+
+func (s *ServiceSuite) TestWhoAmIUnknownSession() {
+	s.cacheRepository.On("Get", s.ctx, userSessionUUID).Return(nil, model.ErrSessionNotFound).Once()
+
+	_, _, err := s.service.WhoAmI(s.ctx, userSessionUUID)
+
+	assert.ErrorIs(s.T(), err, model.ErrSessionNotFound)
+}
@@ -9,9 +9,26 @@ import (
 type UserService interface {
 	Register(ctx context.Context, user *model.User) (string, error)
 	GetUser(ctx context.Context, filter *model.UserFilter) (*model.User, error)
+	VerifyCredentials(ctx context.Context, login, password string) (*model.User, error)
+	// UpdateUser, ChangePassword, AddNotificationMethod and RemoveNotificationMethod act on
+	// userUUID on behalf of the caller session, only the user themselves or an admin may do so.
+	UpdateUser(ctx context.Context, callerSessionUUID, userUUID string, update *model.UserUpdate) (*model.User, error)
+	ChangePassword(ctx context.Context, callerSessionUUID, userUUID, oldPassword, newPassword, keepSessionUUID string) (int, error)
+	AddNotificationMethod(ctx context.Context, callerSessionUUID, userUUID string, method model.NotificationMethod) (*model.User, error)
+	RemoveNotificationMethod(ctx context.Context, callerSessionUUID, userUUID string, method model.NotificationMethod) (*model.User, error)
+	// GrantRole and RevokeRole change the roles of userUUID on behalf of the caller session,
+	// only admins may do so.
+	GrantRole(ctx context.Context, callerSessionUUID, userUUID, role string) (*model.User, error)
+	RevokeRole(ctx context.Context, callerSessionUUID, userUUID, role string) (*model.User, error)
 }
 
 type AuthService interface {
 	Login(ctx context.Context, login, password string) (string, error)
 	WhoAmI(ctx context.Context, sessionUUID string) (*model.Session, *model.User, error)
+	Logout(ctx context.Context, sessionUUID string) error
+	// ListSessions and RevokeAllSessions act on the sessions of userUUID on behalf of the caller
+	// session, only the user themselves or an admin may do so.
+	ListSessions(ctx context.Context, callerSessionUUID, userUUID string) ([]*model.Session, error)
+	RevokeAllSessions(ctx context.Context, callerSessionUUID, userUUID string) (int, error)
+	RefreshSession(ctx context.Context, sessionUUID string) (*model.Session, error)
 }
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+// authorizeFor checks that the caller session may act on behalf of userUUID:
+// it belongs to that user or to an admin.
+func (s *UserService) authorizeFor(ctx context.Context, callerSessionUUID, userUUID string) error {
+	session, err := s.callerSession(ctx, callerSessionUUID)
+	if err != nil {
+		return err
+	}
+
+	if session.UserUUID == userUUID {
+		return nil
+	}
+
+	return s.requireAdminUser(ctx, session.UserUUID)
+}
+
+// requireAdmin checks that the caller session belongs to an admin
+func (s *UserService) requireAdmin(ctx context.Context, callerSessionUUID string) error {
+	session, err := s.callerSession(ctx, callerSessionUUID)
+	if err != nil {
+		return err
+	}
+
+	return s.requireAdminUser(ctx, session.UserUUID)
+}
+
+// callerSession returns the session the caller passed, a missing or expired one
+// fails with ErrUnauthenticated
+func (s *UserService) callerSession(ctx context.Context, callerSessionUUID string) (*model.Session, error) {
+	if callerSessionUUID == "" {
+		return nil, model.ErrUnauthenticated
+	}
+
+	session, err := s.cacheRepository.Get(ctx, callerSessionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrSessionNotFound) {
+			return nil, model.ErrUnauthenticated
+		}
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *UserService) requireAdminUser(ctx context.Context, userUUID string) error {
+	caller, err := s.userRepository.GetUserByUUID(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+	if !caller.HasRole(model.RoleAdmin) {
+		return model.ErrForbidden
+	}
+
+	return nil
+}
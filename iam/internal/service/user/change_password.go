@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+// ChangePassword replaces the password of the user and revokes every session
+// except keepSessionUUID, which has to be a session of the user. It returns the
+// number of revoked sessions.
+func (s *UserService) ChangePassword(ctx context.Context, callerSessionUUID, userUUID, oldPassword, newPassword, keepSessionUUID string) (int, error) {
+	if err := s.authorizeFor(ctx, callerSessionUUID, userUUID); err != nil {
+		return 0, err
+	}
+
+	if len(newPassword) < minPasswordLength {
+		return 0, model.ErrInvalidPassword
+	}
+
+	if keepSessionUUID != "" {
+		session, err := s.cacheRepository.Get(ctx, keepSessionUUID)
+		if errors.Is(err, model.ErrSessionNotFound) || (err == nil && session.UserUUID != userUUID) {
+			return 0, model.ErrForeignSession
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	user, err := s.userRepository.GetUserByUUID(ctx, userUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	ok, _, err := s.hasher.Verify(user.Password, oldPassword)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, model.ErrInvalidCredentials
+	}
+
+	hash, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return 0, model.ErrInvalidPassword
+		}
+		return 0, err
+	}
+
+	if err = s.userRepository.UpdatePassword(ctx, userUUID, hash); err != nil {
+		return 0, err
+	}
+
+	return s.cacheRepository.DeleteByUser(ctx, userUUID, keepSessionUUID)
+}
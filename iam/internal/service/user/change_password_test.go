@@ -0,0 +1,76 @@
+package user
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestChangePasswordRevokesOtherSessions() {
+	user := &model.User{UUID: userUUID, Password: "$2a$10$old"}
+
+	s.callerIs(userSessionUUID, userUUID)
+	// the caller keeps their own session
+	s.callerIs(userSessionUUID, userUUID)
+	s.userRepository.On("GetUserByUUID", s.ctx, userUUID).Return(user, nil).Once()
+	s.hasher.On("Verify", "$2a$10$old", "old password").Return(true, false, nil).Once()
+	s.hasher.On("Hash", "new password").Return("$2a$10$new", nil).Once()
+	s.userRepository.On("UpdatePassword", s.ctx, userUUID, "$2a$10$new").Return(nil).Once()
+	s.cacheRepository.On("DeleteByUser", s.ctx, userUUID, userSessionUUID).Return(2, nil).Once()
+
+	revoked, err := s.service.ChangePassword(s.ctx, userSessionUUID, userUUID, "old password", "new password", userSessionUUID)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 2, revoked)
+}
+
+func (s *ServiceSuite) TestChangePasswordWrongOldPassword() {
+	user := &model.User{UUID: userUUID, Password: "$2a$10$old"}
+
+	s.callerIs(userSessionUUID, userUUID)
+	s.userRepository.On("GetUserByUUID", s.ctx, userUUID).Return(user, nil).Once()
+	s.hasher.On("Verify", "$2a$10$old", "wrong password").Return(false, false, nil).Once()
+
+	_, err := s.service.ChangePassword(s.ctx, userSessionUUID, userUUID, "wrong password", "new password", "")
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidCredentials)
+}
+
+func (s *ServiceSuite) TestChangePasswordTooShort() {
+	s.callerIs(userSessionUUID, userUUID)
+
+	_, err := s.service.ChangePassword(s.ctx, userSessionUUID, userUUID, "old password", "short", "")
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPassword)
+}
+
+func (s *ServiceSuite) TestChangePasswordForeignSession() {
+	s.Run("Session of another user", func() {
+		s.callerIs(userSessionUUID, userUUID)
+		s.callerIs(adminSessionUUID, adminUUID)
+
+		_, err := s.service.ChangePassword(s.ctx, userSessionUUID, userUUID, "old password", "new password", adminSessionUUID)
+
+		assert.ErrorIs(s.T(), err, model.ErrForeignSession)
+	})
+
+	s.Run("Unknown session", func() {
+		s.callerIs(userSessionUUID, userUUID)
+		s.cacheRepository.On("Get", s.ctx, adminSessionUUID).
+			Return(nil, model.ErrSessionNotFound).Once()
+
+		_, err := s.service.ChangePassword(s.ctx, userSessionUUID, userUUID, "old password", "new password", adminSessionUUID)
+
+		assert.ErrorIs(s.T(), err, model.ErrForeignSession)
+	})
+}
+
+func (s *ServiceSuite) TestChangePasswordOfAnotherUser() {
+	s.callerIs(userSessionUUID, otherUserUUID)
+	s.userRepository.On("GetUserByUUID", s.ctx, otherUserUUID).
+		Return(&model.User{UUID: otherUserUUID}, nil).Once()
+
+	_, err := s.service.ChangePassword(s.ctx, userSessionUUID, userUUID, "old password", "new password", "")
+
+	assert.ErrorIs(s.T(), err, model.ErrForbidden)
+}
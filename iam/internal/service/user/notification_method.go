@@ -0,0 +1,31 @@
+package user
+
+import (
+	"context"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *UserService) AddNotificationMethod(ctx context.Context, callerSessionUUID, userUUID string, method model.NotificationMethod) (*model.User, error) {
+	if err := s.authorizeFor(ctx, callerSessionUUID, userUUID); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepository.AddNotificationMethod(ctx, userUUID, method); err != nil {
+		return nil, err
+	}
+
+	return s.userRepository.GetUserByUUID(ctx, userUUID)
+}
+
+func (s *UserService) RemoveNotificationMethod(ctx context.Context, callerSessionUUID, userUUID string, method model.NotificationMethod) (*model.User, error) {
+	if err := s.authorizeFor(ctx, callerSessionUUID, userUUID); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepository.RemoveNotificationMethod(ctx, userUUID, method); err != nil {
+		return nil, err
+	}
+
+	return s.userRepository.GetUserByUUID(ctx, userUUID)
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestAddNotificationMethod() {
+	method := model.NotificationMethod{ProviderName: "telegram", Target: "@pilot"}
+	updated := &model.User{UUID: userUUID, Info: model.UserInfo{NotificationMethods: []model.NotificationMethod{method}}}
+
+	s.callerIs(userSessionUUID, userUUID)
+	s.userRepository.On("AddNotificationMethod", s.ctx, userUUID, method).Return(nil).Once()
+	s.userRepository.On("GetUserByUUID", s.ctx, userUUID).Return(updated, nil).Once()
+
+	user, err := s.service.AddNotificationMethod(s.ctx, userSessionUUID, userUUID, method)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), []model.NotificationMethod{method}, user.Info.NotificationMethods)
+}
+
+func (s *ServiceSuite) TestAddNotificationMethodOfAnotherUser() {
+	method := model.NotificationMethod{ProviderName: "telegram", Target: "@intruder"}
+
+	s.callerIs(userSessionUUID, otherUserUUID)
+	s.userRepository.On("GetUserByUUID", s.ctx, otherUserUUID).
+		Return(&model.User{UUID: otherUserUUID}, nil).Once()
+
+	_, err := s.service.AddNotificationMethod(s.ctx, userSessionUUID, userUUID, method)
+
+	assert.ErrorIs(s.T(), err, model.ErrForbidden)
+}
+
+func (s *ServiceSuite) TestRemoveNotificationMethod() {
+	method := model.NotificationMethod{ProviderName: "telegram", Target: "@pilot"}
+
+	s.Run("By admin", func() {
+		s.callerIsAdmin()
+		s.userRepository.On("RemoveNotificationMethod", s.ctx, userUUID, method).Return(nil).Once()
+		s.userRepository.On("GetUserByUUID", s.ctx, userUUID).Return(&model.User{UUID: userUUID}, nil).Once()
+
+		user, err := s.service.RemoveNotificationMethod(s.ctx, adminSessionUUID, userUUID, method)
+
+		s.Require().NoError(err)
+		assert.Empty(s.T(), user.Info.NotificationMethods)
+	})
+
+	s.Run("Not found", func() {
+		s.callerIs(userSessionUUID, userUUID)
+		s.userRepository.On("RemoveNotificationMethod", s.ctx, userUUID, method).
+			Return(model.ErrNotificationMethodNotFound).Once()
+
+		_, err := s.service.RemoveNotificationMethod(s.ctx, userSessionUUID, userUUID, method)
+
+		assert.ErrorIs(s.T(), err, model.ErrNotificationMethodNotFound)
+	})
+}
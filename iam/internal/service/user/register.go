@@ -2,11 +2,30 @@ package user
 
 import (
 	"context"
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/dexguitar/spacecraftory/iam/internal/model"
 )
 
+// minPasswordLength is the shortest password a user may register with or change to
+const minPasswordLength = 8
+
 func (s *UserService) Register(ctx context.Context, user *model.User) (string, error) {
+	if len(user.Password) < minPasswordLength {
+		return "", model.ErrInvalidPassword
+	}
+
+	hash, err := s.hasher.Hash(user.Password)
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return "", model.ErrInvalidPassword
+		}
+		return "", err
+	}
+	user.Password = hash
+
 	userUUID, err := s.userRepository.CreateUser(ctx, user)
 	if err != nil {
 		return "", err
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestRegisterStoresHash() {
+	user := &model.User{
+		Info:     model.UserInfo{Login: "pilot", Email: "pilot@example.com"},
+		Password: "correct horse",
+	}
+
+	s.hasher.On("Hash", "correct horse").Return("$2a$10$hash", nil).Once()
+	s.userRepository.On("CreateUser", s.ctx, mock.MatchedBy(func(user *model.User) bool {
+		return user.Password == "$2a$10$hash"
+	})).Return(userUUID, nil).Once()
+
+	uuid, err := s.service.Register(s.ctx, user)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), userUUID, uuid)
+}
+
+func (s *ServiceSuite) TestRegisterInvalidPassword() {
+	testCases := []struct {
+		name     string
+		password string
+		hashErr  error
+	}{
+		{
+			name:     "Shorter than the minimum",
+			password: "short",
+		},
+		{
+			name:     "Longer than bcrypt accepts",
+			password: "a very long password",
+			hashErr:  bcrypt.ErrPasswordTooLong,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.hashErr != nil {
+				s.hasher.On("Hash", tc.password).Return("", tc.hashErr).Once()
+			}
+
+			_, err := s.service.Register(s.ctx, &model.User{Password: tc.password})
+
+			assert.ErrorIs(s.T(), err, model.ErrInvalidPassword)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestRegisterRepositoryError() {
+	s.hasher.On("Hash", "correct horse").Return("$2a$10$hash", nil).Once()
+	s.userRepository.On("CreateUser", s.ctx, mock.Anything).
+		Return("", model.ErrUserAlreadyExists).Once()
+
+	_, err := s.service.Register(s.ctx, &model.User{Password: "correct horse"})
+
+	assert.ErrorIs(s.T(), err, model.ErrUserAlreadyExists)
+}
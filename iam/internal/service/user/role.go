@@ -0,0 +1,36 @@
+package user
+
+import (
+	"context"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *UserService) GrantRole(ctx context.Context, callerSessionUUID, userUUID, role string) (*model.User, error) {
+	if err := s.requireAdmin(ctx, callerSessionUUID); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepository.AddRole(ctx, userUUID, role); err != nil {
+		return nil, err
+	}
+
+	return s.userRepository.GetUserByUUID(ctx, userUUID)
+}
+
+func (s *UserService) RevokeRole(ctx context.Context, callerSessionUUID, userUUID, role string) (*model.User, error) {
+	if err := s.requireAdmin(ctx, callerSessionUUID); err != nil {
+		return nil, err
+	}
+
+	// the user has to exist even if the role was never granted
+	if _, err := s.userRepository.GetUserByUUID(ctx, userUUID); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepository.RemoveRole(ctx, userUUID, role); err != nil {
+		return nil, err
+	}
+
+	return s.userRepository.GetUserByUUID(ctx, userUUID)
+}
@@ -0,0 +1,60 @@
+package user
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestGrantRole() {
+	s.callerIsAdmin()
+	s.userRepository.On("AddRole", s.ctx, userUUID, model.RoleAdmin).Return(nil).Once()
+	s.userRepository.On("GetUserByUUID", s.ctx, userUUID).
+		Return(&model.User{UUID: userUUID, Roles: []string{model.RoleAdmin}}, nil).Once()
+
+	user, err := s.service.GrantRole(s.ctx, adminSessionUUID, userUUID, model.RoleAdmin)
+
+	s.Require().NoError(err)
+	assert.True(s.T(), user.HasRole(model.RoleAdmin))
+}
+
+func (s *ServiceSuite) TestGrantRoleUnknownRole() {
+	s.callerIsAdmin()
+	s.userRepository.On("AddRole", s.ctx, userUUID, "pirate").Return(model.ErrRoleNotFound).Once()
+
+	_, err := s.service.GrantRole(s.ctx, adminSessionUUID, userUUID, "pirate")
+
+	assert.ErrorIs(s.T(), err, model.ErrRoleNotFound)
+}
+
+func (s *ServiceSuite) TestGrantRoleByNonAdmin() {
+	// a user may not promote themselves either
+	s.callerIs(userSessionUUID, userUUID)
+	s.userRepository.On("GetUserByUUID", s.ctx, userUUID).Return(&model.User{UUID: userUUID}, nil).Once()
+
+	_, err := s.service.GrantRole(s.ctx, userSessionUUID, userUUID, model.RoleAdmin)
+
+	assert.ErrorIs(s.T(), err, model.ErrForbidden)
+}
+
+func (s *ServiceSuite) TestRevokeRole() {
+	s.callerIsAdmin()
+	s.userRepository.On("GetUserByUUID", s.ctx, userUUID).
+		Return(&model.User{UUID: userUUID, Roles: []string{model.RoleAdmin}}, nil).Once()
+	s.userRepository.On("RemoveRole", s.ctx, userUUID, model.RoleAdmin).Return(nil).Once()
+	s.userRepository.On("GetUserByUUID", s.ctx, userUUID).Return(&model.User{UUID: userUUID}, nil).Once()
+
+	user, err := s.service.RevokeRole(s.ctx, adminSessionUUID, userUUID, model.RoleAdmin)
+
+	s.Require().NoError(err)
+	assert.False(s.T(), user.HasRole(model.RoleAdmin))
+}
+
+func (s *ServiceSuite) TestRevokeRoleUnknownUser() {
+	s.callerIsAdmin()
+	s.userRepository.On("GetUserByUUID", s.ctx, userUUID).Return(nil, model.ErrUserNotFound).Once()
+
+	_, err := s.service.RevokeRole(s.ctx, adminSessionUUID, userUUID, model.RoleAdmin)
+
+	assert.ErrorIs(s.T(), err, model.ErrUserNotFound)
+}
@@ -1,13 +1,24 @@
 package user
 
-import "github.com/dexguitar/spacecraftory/iam/internal/repository"
+import (
+	"github.com/dexguitar/spacecraftory/iam/internal/password"
+	"github.com/dexguitar/spacecraftory/iam/internal/repository"
+)
 
 type UserService struct {
-	userRepository repository.UserRepository
+	userRepository  repository.UserRepository
+	cacheRepository repository.CacheRepository
+	hasher          password.Hasher
 }
 
-func NewUserService(userRepository repository.UserRepository) *UserService {
+func NewUserService(
+	userRepository repository.UserRepository,
+	cacheRepository repository.CacheRepository,
+	hasher password.Hasher,
+) *UserService {
 	return &UserService{
-		userRepository: userRepository,
+		userRepository:  userRepository,
+		cacheRepository: cacheRepository,
+		hasher:          hasher,
 	}
 }
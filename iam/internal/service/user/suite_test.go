@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	passwordMocks "github.com/dexguitar/spacecraftory/iam/internal/password/mocks"
+	"github.com/dexguitar/spacecraftory/iam/internal/repository/mocks"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+const (
+	userUUID         = "123e4567-e89b-12d3-a456-426614174000"
+	otherUserUUID    = "123e4567-e89b-12d3-a456-426614174001"
+	adminUUID        = "123e4567-e89b-12d3-a456-426614174002"
+	userSessionUUID  = "123e4567-e89b-12d3-a456-426614174100"
+	adminSessionUUID = "123e4567-e89b-12d3-a456-426614174101"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx             context.Context
+	userRepository  *mocks.UserRepository
+	cacheRepository *mocks.CacheRepository
+	hasher          *passwordMocks.Hasher
+	service         *UserService
+}
+
+func (s *ServiceSuite) SetupTest() {
+	logger.SetNopLogger()
+
+	s.ctx = context.Background()
+
+	s.userRepository = mocks.NewUserRepository(s.T())
+	s.cacheRepository = mocks.NewCacheRepository(s.T())
+	s.hasher = passwordMocks.NewHasher(s.T())
+
+	s.service = NewUserService(s.userRepository, s.cacheRepository, s.hasher)
+}
+
+// callerIs expects the caller session to be looked up and to belong to callerUUID
+func (s *ServiceSuite) callerIs(sessionUUID, callerUUID string) {
+	s.cacheRepository.On("Get", s.ctx, sessionUUID).
+		Return(&model.Session{UUID: sessionUUID, UserUUID: callerUUID}, nil).Once()
+}
+
+// callerIsAdmin expects an admin caller acting on behalf of another user
+func (s *ServiceSuite) callerIsAdmin() {
+	s.callerIs(adminSessionUUID, adminUUID)
+	s.userRepository.On("GetUserByUUID", s.ctx, adminUUID).
+		Return(&model.User{UUID: adminUUID, Roles: []string{model.RoleAdmin}}, nil).Once()
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *UserService) UpdateUser(ctx context.Context, callerSessionUUID, userUUID string, update *model.UserUpdate) (*model.User, error) {
+	if err := s.authorizeFor(ctx, callerSessionUUID, userUUID); err != nil {
+		return nil, err
+	}
+
+	if update == nil || (update.Login == nil && update.Email == nil) {
+		return nil, model.ErrEmptyUserUpdate
+	}
+	if update.Login != nil && strings.TrimSpace(*update.Login) == "" {
+		return nil, model.ErrInvalidUserUpdate
+	}
+	if update.Email != nil {
+		// a bare address only, "Name <address>" forms are rejected
+		addr, err := mail.ParseAddress(*update.Email)
+		if err != nil || addr.Address != *update.Email {
+			return nil, model.ErrInvalidUserUpdate
+		}
+	}
+
+	if err := s.userRepository.UpdateUser(ctx, userUUID, update); err != nil {
+		return nil, err
+	}
+
+	return s.userRepository.GetUserByUUID(ctx, userUUID)
+}
@@ -0,0 +1,104 @@
+package user
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestUpdateUserByOwner() {
+	login := "captain"
+	update := &model.UserUpdate{Login: &login}
+	updated := &model.User{UUID: userUUID, Info: model.UserInfo{Login: login}}
+
+	s.callerIs(userSessionUUID, userUUID)
+	s.userRepository.On("UpdateUser", s.ctx, userUUID, update).Return(nil).Once()
+	s.userRepository.On("GetUserByUUID", s.ctx, userUUID).Return(updated, nil).Once()
+
+	user, err := s.service.UpdateUser(s.ctx, userSessionUUID, userUUID, update)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), login, user.Info.Login)
+}
+
+func (s *ServiceSuite) TestUpdateUserByAdmin() {
+	email := "captain@example.com"
+	update := &model.UserUpdate{Email: &email}
+
+	s.callerIsAdmin()
+	s.userRepository.On("UpdateUser", s.ctx, userUUID, update).Return(nil).Once()
+	s.userRepository.On("GetUserByUUID", s.ctx, userUUID).
+		Return(&model.User{UUID: userUUID, Info: model.UserInfo{Email: email}}, nil).Once()
+
+	user, err := s.service.UpdateUser(s.ctx, adminSessionUUID, userUUID, update)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), email, user.Info.Email)
+}
+
+func (s *ServiceSuite) TestUpdateUserAccessDenied() {
+	login := "captain"
+	update := &model.UserUpdate{Login: &login}
+
+	s.Run("No caller session", func() {
+		_, err := s.service.UpdateUser(s.ctx, "", userUUID, update)
+
+		assert.ErrorIs(s.T(), err, model.ErrUnauthenticated)
+	})
+
+	s.Run("Expired caller session", func() {
+		s.cacheRepository.On("Get", s.ctx, userSessionUUID).
+			Return(nil, model.ErrSessionNotFound).Once()
+
+		_, err := s.service.UpdateUser(s.ctx, userSessionUUID, userUUID, update)
+
+		assert.ErrorIs(s.T(), err, model.ErrUnauthenticated)
+	})
+
+	s.Run("Another user", func() {
+		s.callerIs(userSessionUUID, otherUserUUID)
+		s.userRepository.On("GetUserByUUID", s.ctx, otherUserUUID).
+			Return(&model.User{UUID: otherUserUUID}, nil).Once()
+
+		_, err := s.service.UpdateUser(s.ctx, userSessionUUID, userUUID, update)
+
+		assert.ErrorIs(s.T(), err, model.ErrForbidden)
+	})
+}
+
+func (s *ServiceSuite) TestUpdateUserInvalid() {
+	blank := " "
+	badEmail := "Pilot <pilot@example.com>"
+
+	testCases := []struct {
+		name        string
+		update      *model.UserUpdate
+		expectedErr error
+	}{
+		{
+			name:        "Nothing to update",
+			update:      &model.UserUpdate{},
+			expectedErr: model.ErrEmptyUserUpdate,
+		},
+		{
+			name:        "Blank login",
+			update:      &model.UserUpdate{Login: &blank},
+			expectedErr: model.ErrInvalidUserUpdate,
+		},
+		{
+			name:        "Email with a display name",
+			update:      &model.UserUpdate{Email: &badEmail},
+			expectedErr: model.ErrInvalidUserUpdate,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.callerIs(userSessionUUID, userUUID)
+
+			_, err := s.service.UpdateUser(s.ctx, userSessionUUID, userUUID, tc.update)
+
+			assert.ErrorIs(s.T(), err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+func (s *UserService) VerifyCredentials(ctx context.Context, login, password string) (*model.User, error) {
+	user, err := s.userRepository.GetUserByLogin(ctx, login)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return nil, model.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	ok, needsRehash, err := s.hasher.Verify(user.Password, password)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, model.ErrInvalidCredentials
+	}
+
+	if needsRehash {
+		// legacy plaintext row or outdated cost: upgrade the stored hash,
+		// but never fail the login because of it
+		hash, err := s.hasher.Hash(password)
+		if err == nil {
+			err = s.userRepository.UpdatePassword(ctx, user.UUID, hash)
+		}
+		if err != nil {
+			logger.Error(ctx, "failed to rehash user password", zap.String("user_uuid", user.UUID), zap.Error(err))
+		} else {
+			user.Password = hash
+		}
+	}
+
+	return user, nil
+}
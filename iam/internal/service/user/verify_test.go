@@ -0,0 +1,72 @@
+package user
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestVerifyCredentialsSuccess() {
+	user := &model.User{UUID: userUUID, Password: "$2a$10$hash"}
+
+	s.userRepository.On("GetUserByLogin", s.ctx, "pilot").Return(user, nil).Once()
+	s.hasher.On("Verify", "$2a$10$hash", "correct horse").Return(true, false, nil).Once()
+
+	verified, err := s.service.VerifyCredentials(s.ctx, "pilot", "correct horse")
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), userUUID, verified.UUID)
+}
+
+func (s *ServiceSuite) TestVerifyCredentialsRehashesLegacyPassword() {
+	user := &model.User{UUID: userUUID, Password: "correct horse"}
+
+	s.userRepository.On("GetUserByLogin", s.ctx, "pilot").Return(user, nil).Once()
+	s.hasher.On("Verify", "correct horse", "correct horse").Return(true, true, nil).Once()
+	s.hasher.On("Hash", "correct horse").Return("$2a$10$hash", nil).Once()
+	s.userRepository.On("UpdatePassword", s.ctx, userUUID, "$2a$10$hash").Return(nil).Once()
+
+	verified, err := s.service.VerifyCredentials(s.ctx, "pilot", "correct horse")
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "$2a$10$hash", verified.Password)
+}
+
+func (s *ServiceSuite) TestVerifyCredentialsRehashFailureKeepsLogin() {
+	user := &model.User{UUID: userUUID, Password: "correct horse"}
+
+	s.userRepository.On("GetUserByLogin", s.ctx, "pilot").Return(user, nil).Once()
+	s.hasher.On("Verify", "correct horse", "correct horse").Return(true, true, nil).Once()
+	s.hasher.On("Hash", "correct horse").Return("$2a$10$hash", nil).Once()
+	s.userRepository.On("UpdatePassword", s.ctx, userUUID, "$2a$10$hash").
+		Return(errors.New("database is down")).Once()
+
+	verified, err := s.service.VerifyCredentials(s.ctx, "pilot", "correct horse")
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "correct horse", verified.Password)
+}
+
+func (s *ServiceSuite) TestVerifyCredentialsInvalid() {
+	s.Run("Unknown login", func() {
+		s.userRepository.On("GetUserByLogin", s.ctx, "nobody").
+			Return(nil, model.ErrUserNotFound).Once()
+
+		_, err := s.service.VerifyCredentials(s.ctx, "nobody", "correct horse")
+
+		assert.ErrorIs(s.T(), err, model.ErrInvalidCredentials)
+	})
+
+	s.Run("Wrong password", func() {
+		user := &model.User{UUID: userUUID, Password: "$2a$10$hash"}
+
+		s.userRepository.On("GetUserByLogin", s.ctx, "pilot").Return(user, nil).Once()
+		s.hasher.On("Verify", "$2a$10$hash", "wrong horse").Return(false, false, nil).Once()
+
+		_, err := s.service.VerifyCredentials(s.ctx, "pilot", "wrong horse")
+
+		assert.ErrorIs(s.T(), err, model.ErrInvalidCredentials)
+	})
+}
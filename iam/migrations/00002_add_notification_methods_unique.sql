@@ -0,0 +1,14 @@
+-- +goose Up
+delete from notification_methods a
+    using notification_methods b
+    where a.user_uuid = b.user_uuid
+      and a.provider_name = b.provider_name
+      and a.target = b.target
+      and a.id > b.id;
+
+alter table notification_methods
+    add constraint notification_methods_user_provider_target_key unique (user_uuid, provider_name, target);
+
+-- +goose Down
+alter table notification_methods
+    drop constraint if exists notification_methods_user_provider_target_key;
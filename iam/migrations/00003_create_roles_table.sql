@@ -0,0 +1,21 @@
+-- +goose Up
+create table if not exists roles (
+    id uuid primary key default gen_random_uuid(),
+    name text unique not null,
+    created_at timestamp not null default now()
+);
+
+create table if not exists user_roles (
+    user_uuid uuid not null,
+    role_id uuid not null,
+    primary key (user_uuid, role_id),
+    foreign key (user_uuid) references users(id) on delete cascade,
+    foreign key (role_id) references roles(id) on delete cascade
+);
+
+insert into roles (name) values ('admin'), ('inventory_admin')
+on conflict (name) do nothing;
+
+-- +goose Down
+drop table if exists user_roles;
+drop table if exists roles;
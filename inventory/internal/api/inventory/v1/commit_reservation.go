@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/inventory/internal/model"
+	inventoryV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CommitReservation(ctx context.Context, req *inventoryV1.CommitReservationRequest) (*inventoryV1.CommitReservationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := a.inventoryService.CommitReservation(ctx, req.GetOrderUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrReservationNotFound) {
+			return nil, status.Errorf(codes.NotFound, "active reservation for order %s not found", req.GetOrderUuid())
+		}
+
+		return nil, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return &inventoryV1.CommitReservationResponse{}, nil
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/inventory/internal/model"
+	inventoryV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReleaseReservation(ctx context.Context, req *inventoryV1.ReleaseReservationRequest) (*inventoryV1.ReleaseReservationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := a.inventoryService.ReleaseReservation(ctx, req.GetOrderUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrReservationNotFound) {
+			return nil, status.Errorf(codes.NotFound, "reservation for order %s not found", req.GetOrderUuid())
+		}
+
+		return nil, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return &inventoryV1.ReleaseReservationResponse{}, nil
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/inventory/internal/model"
+	inventoryV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/inventory/v1"
+)
+
+const testOrderUUID = "523e4567-e89b-12d3-a456-426614174000"
+
+func (s *APISuite) TestReservePartsSuccess() {
+	expiresAt := time.Now().Add(15 * time.Minute)
+	items := []model.ReservationItem{{PartUUID: "123e4567-e89b-12d3-a456-426614174000", Quantity: 2}}
+
+	s.inventoryService.On("ReserveParts", s.ctx, testOrderUUID, items).
+		Return(&model.Reservation{OrderUUID: testOrderUUID, Items: items, ExpiresAt: expiresAt}, nil).Once()
+
+	resp, err := s.api.ReserveParts(s.ctx, &inventoryV1.ReservePartsRequest{
+		OrderUuid: testOrderUUID,
+		Items:     []*inventoryV1.ReservationItem{{PartUuid: items[0].PartUUID, Quantity: 2}},
+	})
+
+	s.Require().NoError(err)
+	assert.True(s.T(), expiresAt.Equal(resp.GetExpiresAt().AsTime()))
+}
+
+func (s *APISuite) TestReservePartsError() {
+	validItems := []*inventoryV1.ReservationItem{{PartUuid: "123e4567-e89b-12d3-a456-426614174000", Quantity: 1}}
+
+	testCases := []struct {
+		name         string
+		items        []*inventoryV1.ReservationItem
+		serviceError error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "Invalid quantity",
+			items:        []*inventoryV1.ReservationItem{{PartUuid: "123e4567-e89b-12d3-a456-426614174000", Quantity: 0}},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Insufficient stock",
+			items:        validItems,
+			serviceError: model.ErrInsufficientStock,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "Part not found",
+			items:        validItems,
+			serviceError: model.ErrPartNotFound,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "Reservation already exists",
+			items:        validItems,
+			serviceError: model.ErrReservationAlreadyExists,
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:         "Internal error",
+			items:        validItems,
+			serviceError: errors.New("database connection failed"),
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.serviceError != nil {
+				s.inventoryService.On("ReserveParts", s.ctx, testOrderUUID, []model.ReservationItem{{PartUUID: tc.items[0].PartUuid, Quantity: tc.items[0].Quantity}}).
+					Return(nil, tc.serviceError).Once()
+			}
+
+			resp, err := s.api.ReserveParts(s.ctx, &inventoryV1.ReservePartsRequest{
+				OrderUuid: testOrderUUID,
+				Items:     tc.items,
+			})
+
+			assert.Nil(s.T(), resp)
+			st, ok := status.FromError(err)
+			s.Require().True(ok)
+			assert.Equal(s.T(), tc.expectedCode, st.Code())
+		})
+	}
+}
+
+func (s *APISuite) TestReleaseReservation() {
+	s.Run("Success", func() {
+		s.inventoryService.On("ReleaseReservation", s.ctx, testOrderUUID).Return(nil).Once()
+
+		_, err := s.api.ReleaseReservation(s.ctx, &inventoryV1.ReleaseReservationRequest{OrderUuid: testOrderUUID})
+
+		s.Require().NoError(err)
+	})
+
+	s.Run("Not found", func() {
+		s.inventoryService.On("ReleaseReservation", s.ctx, testOrderUUID).Return(model.ErrReservationNotFound).Once()
+
+		_, err := s.api.ReleaseReservation(s.ctx, &inventoryV1.ReleaseReservationRequest{OrderUuid: testOrderUUID})
+
+		assert.Equal(s.T(), codes.NotFound, status.Code(err))
+	})
+}
+
+func (s *APISuite) TestCommitReservation() {
+	s.Run("Success", func() {
+		s.inventoryService.On("CommitReservation", s.ctx, testOrderUUID).Return(nil).Once()
+
+		_, err := s.api.CommitReservation(s.ctx, &inventoryV1.CommitReservationRequest{OrderUuid: testOrderUUID})
+
+		s.Require().NoError(err)
+	})
+
+	s.Run("Not found", func() {
+		s.inventoryService.On("CommitReservation", s.ctx, testOrderUUID).Return(model.ErrReservationNotFound).Once()
+
+		_, err := s.api.CommitReservation(s.ctx, &inventoryV1.CommitReservationRequest{OrderUuid: testOrderUUID})
+
+		assert.Equal(s.T(), codes.NotFound, status.Code(err))
+	})
+}
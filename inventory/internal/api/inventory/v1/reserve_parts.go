@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dexguitar/spacecraftory/inventory/internal/converter"
+	"github.com/dexguitar/spacecraftory/inventory/internal/model"
+	inventoryV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReserveParts(ctx context.Context, req *inventoryV1.ReservePartsRequest) (*inventoryV1.ReservePartsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	reservation, err := a.inventoryService.ReserveParts(ctx, req.GetOrderUuid(), converter.ToModelReservationItems(req.GetItems()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrBadRequest):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, model.ErrInsufficientStock):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, model.ErrReservationAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "reservation for order %s already exists", req.GetOrderUuid())
+		}
+
+		return nil, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return &inventoryV1.ReservePartsResponse{
+		ExpiresAt: timestamppb.New(reservation.ExpiresAt),
+	}, nil
+}
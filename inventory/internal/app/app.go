@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"time"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -36,6 +38,8 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
+	go a.runReservationReclaimer(ctx)
+
 	return a.runGRPCServer(ctx)
 }
 
@@ -106,7 +110,17 @@ func (a *App) initListener(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()), grpc.UnaryInterceptor(authGrpc.NewAuthInterceptor(a.diContainer.IAMGRPCClient(ctx)).Unary()))
+	// Reservations change stock and are made by the order service on behalf of its
+	// users or by administrators, reading the catalog is open to any authenticated user
+	policy := authGrpc.NewPolicy().
+		Require("InventoryService/ReserveParts", authGrpc.RoleService, "admin").
+		Require("InventoryService/ReleaseReservation", authGrpc.RoleService, "admin").
+		Require("InventoryService/CommitReservation", authGrpc.RoleService, "admin")
+
+	authInterceptor := authGrpc.NewAuthInterceptor(a.diContainer.IAMGRPCClient(ctx), policy).
+		WithServiceToken(config.AppConfig().ServiceAuth.Token())
+
+	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()), grpc.UnaryInterceptor(authInterceptor.Unary()))
 	closer.AddNamed("gRPC server", func(ctx context.Context) error {
 		a.grpcServer.GracefulStop()
 		return nil
@@ -122,6 +136,27 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	return nil
 }
 
+// runReservationReclaimer periodically returns stock of expired uncommitted reservations
+func (a *App) runReservationReclaimer(ctx context.Context) {
+	ticker := time.NewTicker(config.AppConfig().Reservation.ReclaimInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			released, err := a.diContainer.InventoryService(ctx).ReclaimExpiredReservations(ctx)
+			if err != nil {
+				logger.Error(ctx, "failed to reclaim expired reservations", zap.Error(err))
+			}
+			if released > 0 {
+				logger.Info(ctx, "reclaimed expired reservations", zap.Int("count", released))
+			}
+		}
+	}
+}
+
 func (a *App) runGRPCServer(ctx context.Context) error {
 	logger.Info(ctx, fmt.Sprintf("🚀 gRPC InventoryService server listening on %s", config.AppConfig().InventoryGRPC.Address()))
 
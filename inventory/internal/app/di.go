@@ -86,7 +86,7 @@ func (d *diContainer) IAMGRPCConn(ctx context.Context) *grpc.ClientConn {
 
 func (d *diContainer) InventoryService(ctx context.Context) service.InventoryService {
 	if d.inventoryService == nil {
-		d.inventoryService = inventoryService.NewService(d.InventoryRepository(ctx))
+		d.inventoryService = inventoryService.NewService(d.InventoryRepository(ctx), config.AppConfig().Reservation.TTL())
 	}
 
 	return d.inventoryService
@@ -94,7 +94,12 @@ func (d *diContainer) InventoryService(ctx context.Context) service.InventorySer
 
 func (d *diContainer) InventoryRepository(ctx context.Context) repository.InventoryRepository {
 	if d.inventoryRepository == nil {
-		d.inventoryRepository = inventoryRepository.NewInventoryRepository(ctx, d.MongoDBHandle(ctx))
+		repo, err := inventoryRepository.NewInventoryRepository(ctx, d.MongoDBHandle(ctx))
+		if err != nil {
+			panic(fmt.Sprintf("failed to create inventory repository: %s\n", err.Error()))
+		}
+
+		d.inventoryRepository = repo
 	}
 
 	return d.inventoryRepository
@@ -15,6 +15,8 @@ type config struct {
 	InventoryGRPC InventoryGRPCConfig
 	IAMClientGRPC IAMClientGRPCConfig
 	Mongo         MongoConfig
+	Reservation   ReservationConfig
+	ServiceAuth   ServiceAuthConfig
 }
 
 func Load(path ...string) error {
@@ -43,11 +45,23 @@ func Load(path ...string) error {
 		return err
 	}
 
+	reservationCfg, err := env.NewReservationConfig()
+	if err != nil {
+		return err
+	}
+
+	serviceAuthCfg, err := env.NewServiceAuthConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:        loggerCfg,
 		InventoryGRPC: inventoryGRPCCfg,
 		IAMClientGRPC: iamClientGRPCCfg,
 		Mongo:         mongoCfg,
+		Reservation:   reservationCfg,
+		ServiceAuth:   serviceAuthCfg,
 	}
 
 	return nil
@@ -0,0 +1,35 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type reservationEnvConfig struct {
+	TTL             time.Duration `env:"INVENTORY_RESERVATION_TTL" envDefault:"15m"`
+	ReclaimInterval time.Duration `env:"INVENTORY_RESERVATION_RECLAIM_INTERVAL" envDefault:"1m"`
+}
+
+type reservationConfig struct {
+	raw reservationEnvConfig
+}
+
+func NewReservationConfig() (*reservationConfig, error) {
+	var raw reservationEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &reservationConfig{raw: raw}, nil
+}
+
+// TTL is how long stock stays reserved for an order unless the reservation is committed
+func (cfg *reservationConfig) TTL() time.Duration {
+	return cfg.raw.TTL
+}
+
+// ReclaimInterval is how often expired reservations are released
+func (cfg *reservationConfig) ReclaimInterval() time.Duration {
+	return cfg.raw.ReclaimInterval
+}
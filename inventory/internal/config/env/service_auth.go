@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type serviceAuthEnvConfig struct {
+	Token string `env:"INVENTORY_SERVICE_TOKEN,required"`
+}
+
+type serviceAuthConfig struct {
+	raw serviceAuthEnvConfig
+}
+
+func NewServiceAuthConfig() (*serviceAuthConfig, error) {
+	var raw serviceAuthEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &serviceAuthConfig{raw: raw}, nil
+}
+
+// Token is the shared secret other services present to call reservation methods
+func (cfg *serviceAuthConfig) Token() string {
+	return cfg.raw.Token
+}
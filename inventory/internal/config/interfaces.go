@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
@@ -11,6 +13,11 @@ type InventoryGRPCConfig interface {
 	Address() string
 }
 
+type ReservationConfig interface {
+	TTL() time.Duration
+	ReclaimInterval() time.Duration
+}
+
 type MongoConfig interface {
 	URI() string
 	DatabaseName() string
@@ -19,3 +26,7 @@ type MongoConfig interface {
 type IAMClientGRPCConfig interface {
 	Address() string
 }
+
+type ServiceAuthConfig interface {
+	Token() string
+}
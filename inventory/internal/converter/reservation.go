@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"github.com/dexguitar/spacecraftory/inventory/internal/model"
+	inventoryV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/inventory/v1"
+)
+
+func ToModelReservationItems(protoItems []*inventoryV1.ReservationItem) []model.ReservationItem {
+	items := make([]model.ReservationItem, 0, len(protoItems))
+	for _, protoItem := range protoItems {
+		items = append(items, model.ReservationItem{
+			PartUUID: protoItem.GetPartUuid(),
+			Quantity: protoItem.GetQuantity(),
+		})
+	}
+
+	return items
+}
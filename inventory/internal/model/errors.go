@@ -3,6 +3,9 @@ package model
 import "errors"
 
 var (
-	ErrPartNotFound = errors.New("part not found")
-	ErrBadRequest   = errors.New("bad request")
+	ErrPartNotFound             = errors.New("part not found")
+	ErrBadRequest               = errors.New("bad request")
+	ErrInsufficientStock        = errors.New("insufficient stock")
+	ErrReservationNotFound      = errors.New("reservation not found")
+	ErrReservationAlreadyExists = errors.New("reservation already exists")
 )
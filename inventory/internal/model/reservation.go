@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+type ReservationStatus string
+
+const (
+	ReservationStatusActive    ReservationStatus = "ACTIVE"
+	ReservationStatusCommitted ReservationStatus = "COMMITTED"
+	ReservationStatusReleased  ReservationStatus = "RELEASED"
+)
+
+type ReservationItem struct {
+	PartUUID string
+	Quantity int64
+}
+
+// Reservation holds stock of parts for an order. An active reservation is
+// released automatically once ExpiresAt passes unless it is committed.
+type Reservation struct {
+	OrderUUID string
+	Items     []ReservationItem
+	Status    ReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
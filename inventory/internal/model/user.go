@@ -4,6 +4,7 @@ type User struct {
 	UUID     string
 	Info     UserInfo
 	Password string
+	Roles    []string
 }
 
 type UserInfo struct {
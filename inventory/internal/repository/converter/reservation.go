@@ -0,0 +1,29 @@
+package converter
+
+import (
+	serviceModel "github.com/dexguitar/spacecraftory/inventory/internal/model"
+	repoModel "github.com/dexguitar/spacecraftory/inventory/internal/repository/model"
+)
+
+func ToRepoReservation(reservation *serviceModel.Reservation) *repoModel.Reservation {
+	if reservation == nil {
+		return nil
+	}
+
+	items := make([]repoModel.ReservationItem, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		items = append(items, repoModel.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return &repoModel.Reservation{
+		OrderUUID: reservation.OrderUUID,
+		Items:     items,
+		Status:    reservation.Status,
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.CreatedAt,
+	}
+}
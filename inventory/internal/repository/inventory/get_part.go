@@ -14,7 +14,7 @@ import (
 
 func (r *inventoryRepository) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
 	var part repoModel.Part
-	err := r.db.Collection("parts").FindOne(ctx, bson.M{"uuid": uuid}).Decode(&part)
+	err := r.db.Collection(partsCollection).FindOne(ctx, bson.M{"uuid": uuid}).Decode(&part)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, model.ErrPartNotFound
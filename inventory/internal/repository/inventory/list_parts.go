@@ -28,7 +28,7 @@ func (r *inventoryRepository) ListParts(ctx context.Context, filter *model.Parts
 
 func (r *inventoryRepository) getAllParts(ctx context.Context) ([]*model.Part, error) {
 	serviceParts := make([]*model.Part, 0)
-	cursor, err := r.db.Collection("parts").Find(ctx, bson.M{})
+	cursor, err := r.db.Collection(partsCollection).Find(ctx, bson.M{})
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +58,7 @@ func (r *inventoryRepository) getPartsByUUIDs(ctx context.Context, uuids []strin
 
 	// Use MongoDB $in operator for efficient batch query
 	filter := bson.M{"uuid": bson.M{"$in": uuids}}
-	cursor, err := r.db.Collection("parts").Find(ctx, filter)
+	cursor, err := r.db.Collection(partsCollection).Find(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -2,11 +2,13 @@ package inventory
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/dexguitar/spacecraftory/inventory/internal/model"
 	repoModel "github.com/dexguitar/spacecraftory/inventory/internal/repository/model"
@@ -16,20 +18,25 @@ type inventoryRepository struct {
 	db *mongo.Database
 }
 
-func NewInventoryRepository(ctx context.Context, db *mongo.Database) *inventoryRepository {
+// NewInventoryRepository fails when the reservation indexes cannot be created:
+// ReserveParts relies on the unique order index to never take stock twice for an order.
+func NewInventoryRepository(ctx context.Context, db *mongo.Database) (*inventoryRepository, error) {
 	repo := &inventoryRepository{
 		db: db,
 	}
 
 	repo.initParts(ctx)
+	if err := repo.initReservations(ctx); err != nil {
+		return nil, err
+	}
 
-	return repo
+	return repo, nil
 }
 
 func (r *inventoryRepository) initParts(ctx context.Context) {
 	now := time.Now()
 
-	count, err := r.db.Collection("parts").CountDocuments(ctx, bson.M{})
+	count, err := r.db.Collection(partsCollection).CountDocuments(ctx, bson.M{})
 	if err != nil {
 		log.Printf("failed to count parts: %v\n", err)
 		return
@@ -130,7 +137,7 @@ func (r *inventoryRepository) initParts(ctx context.Context) {
 		},
 	}
 
-	_, err = r.db.Collection("parts").InsertMany(ctx, []any{mockParts["123e4567-e89b-12d3-a456-426614174000"], mockParts["123e4567-e89b-12d3-a456-426614174001"], mockParts["123e4567-e89b-12d3-a456-426614174002"], mockParts["123e4567-e89b-12d3-a456-426614174003"]})
+	_, err = r.db.Collection(partsCollection).InsertMany(ctx, []any{mockParts["123e4567-e89b-12d3-a456-426614174000"], mockParts["123e4567-e89b-12d3-a456-426614174001"], mockParts["123e4567-e89b-12d3-a456-426614174002"], mockParts["123e4567-e89b-12d3-a456-426614174003"]})
 	if err != nil {
 		log.Printf("failed to insert parts: %v\n", err)
 		return
@@ -138,3 +145,24 @@ func (r *inventoryRepository) initParts(ctx context.Context) {
 
 	log.Printf("✅ initialized 4 mock parts")
 }
+
+func (r *inventoryRepository) initReservations(ctx context.Context) error {
+	_, err := r.db.Collection(reservationsCollection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			// one reservation per order
+			Keys:    bson.D{{Key: "order_uuid", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// lookup of expired active reservations
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "expires_at", Value: 1}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create reservation indexes: %w", err)
+	}
+
+	log.Printf("✅ reservation indexes initialized")
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/inventory/internal/model"
+	repoConverter "github.com/dexguitar/spacecraftory/inventory/internal/repository/converter"
+	repoModel "github.com/dexguitar/spacecraftory/inventory/internal/repository/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+const (
+	partsCollection        = "parts"
+	reservationsCollection = "reservations"
+
+	// parts are stored without bson tags, so keys are lowercased field names
+	stockQuantityKey = "stockquantity"
+	updatedAtKey     = "updatedat"
+)
+
+// ReserveParts decrements stock of every item with a conditional update, so stock
+// never goes negative. If any item cannot be reserved, already taken stock is
+// returned and nothing is reserved.
+//
+// Stock is taken before the reservation document is written: a crash in between
+// may leak stock but never lets a reservation give back stock it did not take.
+//
+// A released reservation of the order is taken over, so an order whose stock went
+// back after a declined payment or expiry can be reserved again before paying.
+func (r *inventoryRepository) ReserveParts(ctx context.Context, reservation *model.Reservation) error {
+	reserved := make([]model.ReservationItem, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		err := r.decrementStock(ctx, item)
+		if err != nil {
+			r.restoreStock(ctx, reserved)
+			return err
+		}
+		reserved = append(reserved, item)
+	}
+
+	collection := r.db.Collection(reservationsCollection)
+	repoReservation := repoConverter.ToRepoReservation(reservation)
+
+	// the status switch is atomic, of concurrent reservations only one takes the document over
+	res, err := collection.UpdateOne(ctx,
+		bson.M{
+			"order_uuid": reservation.OrderUUID,
+			"status":     model.ReservationStatusReleased,
+		},
+		bson.M{"$set": bson.M{
+			"items":      repoReservation.Items,
+			"status":     repoReservation.Status,
+			"expires_at": repoReservation.ExpiresAt,
+			"updated_at": repoReservation.UpdatedAt,
+		}},
+	)
+	if err != nil {
+		r.restoreStock(ctx, reserved)
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+
+	_, err = collection.InsertOne(ctx, repoReservation)
+	if err != nil {
+		r.restoreStock(ctx, reserved)
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrReservationAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseReservation returns stock of an active or committed reservation
+func (r *inventoryRepository) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	return r.release(ctx, bson.M{
+		"order_uuid": orderUUID,
+		"status": bson.M{"$in": []model.ReservationStatus{
+			model.ReservationStatusActive,
+			model.ReservationStatusCommitted,
+		}},
+	})
+}
+
+// ReleaseExpiredReservations releases up to limit active reservations expired before now
+func (r *inventoryRepository) ReleaseExpiredReservations(ctx context.Context, now time.Time, limit int64) (int, error) {
+	filter := bson.M{
+		"status":     model.ReservationStatusActive,
+		"expires_at": bson.M{"$lte": now},
+	}
+
+	cursor, err := r.db.Collection(reservationsCollection).Find(ctx, filter, options.Find().SetLimit(limit))
+	if err != nil {
+		return 0, err
+	}
+
+	var expired []repoModel.Reservation
+	if err = cursor.All(ctx, &expired); err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, reservation := range expired {
+		// the filter is repeated so a reservation committed meanwhile is left alone
+		err = r.release(ctx, bson.M{
+			"order_uuid": reservation.OrderUUID,
+			"status":     model.ReservationStatusActive,
+			"expires_at": bson.M{"$lte": now},
+		})
+		if errors.Is(err, model.ErrReservationNotFound) {
+			continue
+		}
+		if err != nil {
+			return released, err
+		}
+		released++
+	}
+
+	return released, nil
+}
+
+// CommitReservation marks an active reservation as committed; committing twice is a no-op
+func (r *inventoryRepository) CommitReservation(ctx context.Context, orderUUID string) error {
+	collection := r.db.Collection(reservationsCollection)
+
+	res, err := collection.UpdateOne(ctx,
+		bson.M{
+			"order_uuid": orderUUID,
+			"status":     model.ReservationStatusActive,
+		},
+		bson.M{"$set": bson.M{
+			"status":     model.ReservationStatusCommitted,
+			"updated_at": time.Now(),
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+
+	count, err := collection.CountDocuments(ctx, bson.M{
+		"order_uuid": orderUUID,
+		"status":     model.ReservationStatusCommitted,
+	})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return model.ErrReservationNotFound
+	}
+
+	return nil
+}
+
+// release moves the matching reservation to RELEASED and gives its stock back.
+// The status switch is atomic, so stock is returned at most once.
+func (r *inventoryRepository) release(ctx context.Context, filter bson.M) error {
+	var reservation repoModel.Reservation
+	err := r.db.Collection(reservationsCollection).FindOneAndUpdate(ctx,
+		filter,
+		bson.M{"$set": bson.M{
+			"status":     model.ReservationStatusReleased,
+			"updated_at": time.Now(),
+		}},
+	).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.ErrReservationNotFound
+		}
+		return err
+	}
+
+	items := make([]model.ReservationItem, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		items = append(items, model.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+	r.restoreStock(ctx, items)
+
+	return nil
+}
+
+func (r *inventoryRepository) decrementStock(ctx context.Context, item model.ReservationItem) error {
+	collection := r.db.Collection(partsCollection)
+
+	res, err := collection.UpdateOne(ctx,
+		bson.M{
+			"uuid":           item.PartUUID,
+			stockQuantityKey: bson.M{"$gte": item.Quantity},
+		},
+		bson.M{
+			"$inc": bson.M{stockQuantityKey: -item.Quantity},
+			"$set": bson.M{updatedAtKey: time.Now()},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+
+	count, err := collection.CountDocuments(ctx, bson.M{"uuid": item.PartUUID})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return model.ErrPartNotFound
+	}
+
+	return model.ErrInsufficientStock
+}
+
+// restoreStock gives stock back; failures are only logged as the stock is then
+// leaked rather than oversold
+func (r *inventoryRepository) restoreStock(ctx context.Context, items []model.ReservationItem) {
+	for _, item := range items {
+		_, err := r.db.Collection(partsCollection).UpdateOne(ctx,
+			bson.M{"uuid": item.PartUUID},
+			bson.M{
+				"$inc": bson.M{stockQuantityKey: item.Quantity},
+				"$set": bson.M{updatedAtKey: time.Now()},
+			},
+		)
+		if err != nil {
+			logger.Error(ctx, "failed to restore part stock",
+				zap.String("part_uuid", item.PartUUID),
+				zap.Int64("quantity", item.Quantity),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 	model "github.com/dexguitar/spacecraftory/inventory/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // InventoryRepository is an autogenerated mock type for the InventoryRepository type
@@ -22,6 +24,53 @@ func (_m *InventoryRepository) EXPECT() *InventoryRepository_Expecter {
 	return &InventoryRepository_Expecter{mock: &_m.Mock}
 }
 
+// CommitReservation provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryRepository) CommitReservation(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type InventoryRepository_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryRepository_Expecter) CommitReservation(ctx interface{}, orderUUID interface{}) *InventoryRepository_CommitReservation_Call {
+	return &InventoryRepository_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, orderUUID)}
+}
+
+func (_c *InventoryRepository_CommitReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryRepository_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_CommitReservation_Call) Return(_a0 error) *InventoryRepository_CommitReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_CommitReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryRepository_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *InventoryRepository) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -140,6 +189,158 @@ func (_c *InventoryRepository_ListParts_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ReleaseExpiredReservations provides a mock function with given fields: ctx, now, limit
+func (_m *InventoryRepository) ReleaseExpiredReservations(ctx context.Context, now time.Time, limit int64) (int, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseExpiredReservations")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int64) (int, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int64) int); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int64) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ReleaseExpiredReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseExpiredReservations'
+type InventoryRepository_ReleaseExpiredReservations_Call struct {
+	*mock.Call
+}
+
+// ReleaseExpiredReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int64
+func (_e *InventoryRepository_Expecter) ReleaseExpiredReservations(ctx interface{}, now interface{}, limit interface{}) *InventoryRepository_ReleaseExpiredReservations_Call {
+	return &InventoryRepository_ReleaseExpiredReservations_Call{Call: _e.mock.On("ReleaseExpiredReservations", ctx, now, limit)}
+}
+
+func (_c *InventoryRepository_ReleaseExpiredReservations_Call) Run(run func(ctx context.Context, now time.Time, limit int64)) *InventoryRepository_ReleaseExpiredReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseExpiredReservations_Call) Return(_a0 int, _a1 error) *InventoryRepository_ReleaseExpiredReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseExpiredReservations_Call) RunAndReturn(run func(context.Context, time.Time, int64) (int, error)) *InventoryRepository_ReleaseExpiredReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryRepository) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type InventoryRepository_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryRepository_Expecter) ReleaseReservation(ctx interface{}, orderUUID interface{}) *InventoryRepository_ReleaseReservation_Call {
+	return &InventoryRepository_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, orderUUID)}
+}
+
+func (_c *InventoryRepository_ReleaseReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryRepository_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseReservation_Call) Return(_a0 error) *InventoryRepository_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryRepository_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, reservation
+func (_m *InventoryRepository) ReserveParts(ctx context.Context, reservation *model.Reservation) error {
+	ret := _m.Called(ctx, reservation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Reservation) error); ok {
+		r0 = rf(ctx, reservation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type InventoryRepository_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservation *model.Reservation
+func (_e *InventoryRepository_Expecter) ReserveParts(ctx interface{}, reservation interface{}) *InventoryRepository_ReserveParts_Call {
+	return &InventoryRepository_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, reservation)}
+}
+
+func (_c *InventoryRepository_ReserveParts_Call) Run(run func(ctx context.Context, reservation *model.Reservation)) *InventoryRepository_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Reservation))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ReserveParts_Call) Return(_a0 error) *InventoryRepository_ReserveParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_ReserveParts_Call) RunAndReturn(run func(context.Context, *model.Reservation) error) *InventoryRepository_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryRepository creates a new instance of InventoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryRepository(t interface {
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/dexguitar/spacecraftory/inventory/internal/model"
+)
+
+type ReservationItem struct {
+	PartUUID string `bson:"part_uuid"`
+	Quantity int64  `bson:"quantity"`
+}
+
+type Reservation struct {
+	OrderUUID string                  `bson:"order_uuid"`
+	Items     []ReservationItem       `bson:"items"`
+	Status    model.ReservationStatus `bson:"status"`
+	ExpiresAt time.Time               `bson:"expires_at"`
+	CreatedAt time.Time               `bson:"created_at"`
+	UpdatedAt time.Time               `bson:"updated_at"`
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/dexguitar/spacecraftory/inventory/internal/model"
 )
@@ -9,4 +10,8 @@ import (
 type InventoryRepository interface {
 	GetPart(ctx context.Context, uuid string) (*model.Part, error)
 	ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error)
+	ReserveParts(ctx context.Context, reservation *model.Reservation) error
+	ReleaseReservation(ctx context.Context, orderUUID string) error
+	ReleaseExpiredReservations(ctx context.Context, now time.Time, limit int64) (int, error)
+	CommitReservation(ctx context.Context, orderUUID string) error
 }
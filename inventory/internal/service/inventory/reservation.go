@@ -0,0 +1,72 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/dexguitar/spacecraftory/inventory/internal/model"
+)
+
+// reclaimBatchSize limits how many expired reservations are released per pass
+const reclaimBatchSize = 100
+
+func (s *service) ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) (*model.Reservation, error) {
+	if orderUUID == "" || len(items) == 0 {
+		return nil, model.ErrBadRequest
+	}
+
+	// repeated parts are reserved as one item
+	merged := make([]model.ReservationItem, 0, len(items))
+	positions := make(map[string]int, len(items))
+	for _, item := range items {
+		if item.PartUUID == "" || item.Quantity <= 0 {
+			return nil, model.ErrBadRequest
+		}
+		if i, ok := positions[item.PartUUID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		positions[item.PartUUID] = len(merged)
+		merged = append(merged, item)
+	}
+
+	now := time.Now()
+	reservation := &model.Reservation{
+		OrderUUID: orderUUID,
+		Items:     merged,
+		Status:    model.ReservationStatusActive,
+		ExpiresAt: now.Add(s.reservationTTL),
+		CreatedAt: now,
+	}
+
+	if err := s.inventoryRepository.ReserveParts(ctx, reservation); err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func (s *service) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	return s.inventoryRepository.ReleaseReservation(ctx, orderUUID)
+}
+
+func (s *service) CommitReservation(ctx context.Context, orderUUID string) error {
+	return s.inventoryRepository.CommitReservation(ctx, orderUUID)
+}
+
+// ReclaimExpiredReservations returns stock of all reservations that expired uncommitted
+func (s *service) ReclaimExpiredReservations(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	total := 0
+	for {
+		released, err := s.inventoryRepository.ReleaseExpiredReservations(ctx, now, reclaimBatchSize)
+		total += released
+		if err != nil {
+			return total, err
+		}
+		if released < reclaimBatchSize {
+			return total, nil
+		}
+	}
+}
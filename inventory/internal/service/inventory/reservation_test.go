@@ -0,0 +1,104 @@
+package inventory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/inventory/internal/model"
+)
+
+const testOrderUUID = "523e4567-e89b-12d3-a456-426614174000"
+
+func (s *ServiceSuite) TestReservePartsSuccess() {
+	items := []model.ReservationItem{
+		{PartUUID: "123e4567-e89b-12d3-a456-426614174000", Quantity: 2},
+		{PartUUID: "123e4567-e89b-12d3-a456-426614174001", Quantity: 1},
+		{PartUUID: "123e4567-e89b-12d3-a456-426614174000", Quantity: 1},
+	}
+
+	s.inventoryRepo.On("ReserveParts", s.ctx, mock.MatchedBy(func(reservation *model.Reservation) bool {
+		return reservation.OrderUUID == testOrderUUID &&
+			reservation.Status == model.ReservationStatusActive &&
+			reservation.ExpiresAt.Sub(reservation.CreatedAt) == testReservationTTL
+	})).Return(nil).Once()
+
+	reservation, err := s.service.ReserveParts(s.ctx, testOrderUUID, items)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), []model.ReservationItem{
+		{PartUUID: "123e4567-e89b-12d3-a456-426614174000", Quantity: 3},
+		{PartUUID: "123e4567-e89b-12d3-a456-426614174001", Quantity: 1},
+	}, reservation.Items)
+}
+
+func (s *ServiceSuite) TestReservePartsError() {
+	testCases := []struct {
+		name          string
+		items         []model.ReservationItem
+		repoError     error
+		expectedError error
+	}{
+		{
+			name:          "No items",
+			items:         []model.ReservationItem{},
+			expectedError: model.ErrBadRequest,
+		},
+		{
+			name:          "Non-positive quantity",
+			items:         []model.ReservationItem{{PartUUID: "123e4567-e89b-12d3-a456-426614174000", Quantity: 0}},
+			expectedError: model.ErrBadRequest,
+		},
+		{
+			name:          "Insufficient stock",
+			items:         []model.ReservationItem{{PartUUID: "123e4567-e89b-12d3-a456-426614174000", Quantity: 6}},
+			repoError:     model.ErrInsufficientStock,
+			expectedError: model.ErrInsufficientStock,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.repoError != nil {
+				s.inventoryRepo.On("ReserveParts", s.ctx, mock.Anything).
+					Return(tc.repoError).Once()
+			}
+
+			reservation, err := s.service.ReserveParts(s.ctx, testOrderUUID, tc.items)
+
+			assert.ErrorIs(s.T(), err, tc.expectedError)
+			assert.Nil(s.T(), reservation)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestReleaseAndCommitReservation() {
+	s.Run("Release", func() {
+		s.inventoryRepo.On("ReleaseReservation", s.ctx, testOrderUUID).
+			Return(model.ErrReservationNotFound).Once()
+
+		err := s.service.ReleaseReservation(s.ctx, testOrderUUID)
+
+		assert.ErrorIs(s.T(), err, model.ErrReservationNotFound)
+	})
+
+	s.Run("Commit", func() {
+		s.inventoryRepo.On("CommitReservation", s.ctx, testOrderUUID).
+			Return(nil).Once()
+
+		err := s.service.CommitReservation(s.ctx, testOrderUUID)
+
+		s.Require().NoError(err)
+	})
+}
+
+func (s *ServiceSuite) TestReclaimExpiredReservations() {
+	s.inventoryRepo.On("ReleaseExpiredReservations", s.ctx, mock.AnythingOfType("time.Time"), int64(reclaimBatchSize)).
+		Return(reclaimBatchSize, nil).Once()
+	s.inventoryRepo.On("ReleaseExpiredReservations", s.ctx, mock.AnythingOfType("time.Time"), int64(reclaimBatchSize)).
+		Return(3, nil).Once()
+
+	released, err := s.service.ReclaimExpiredReservations(s.ctx)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), reclaimBatchSize+3, released)
+}
@@ -1,15 +1,19 @@
 package inventory
 
 import (
+	"time"
+
 	"github.com/dexguitar/spacecraftory/inventory/internal/repository"
 )
 
 type service struct {
 	inventoryRepository repository.InventoryRepository
+	reservationTTL      time.Duration
 }
 
-func NewService(inventoryRepository repository.InventoryRepository) *service {
+func NewService(inventoryRepository repository.InventoryRepository, reservationTTL time.Duration) *service {
 	return &service{
 		inventoryRepository: inventoryRepository,
+		reservationTTL:      reservationTTL,
 	}
 }
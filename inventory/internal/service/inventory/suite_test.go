@@ -3,6 +3,7 @@ package inventory
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -11,6 +12,8 @@ import (
 	repoModel "github.com/dexguitar/spacecraftory/inventory/internal/repository/model"
 )
 
+const testReservationTTL = 15 * time.Minute
+
 type ServiceSuite struct {
 	suite.Suite
 
@@ -30,6 +33,7 @@ func (s *ServiceSuite) SetupTest() {
 
 	s.service = NewService(
 		s.inventoryRepo,
+		testReservationTTL,
 	)
 
 	s.repoMockData = generateRepoMockData()
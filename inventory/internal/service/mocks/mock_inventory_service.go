@@ -22,6 +22,53 @@ func (_m *InventoryService) EXPECT() *InventoryService_Expecter {
 	return &InventoryService_Expecter{mock: &_m.Mock}
 }
 
+// CommitReservation provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryService) CommitReservation(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type InventoryService_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryService_Expecter) CommitReservation(ctx interface{}, orderUUID interface{}) *InventoryService_CommitReservation_Call {
+	return &InventoryService_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, orderUUID)}
+}
+
+func (_c *InventoryService_CommitReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryService_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_CommitReservation_Call) Return(_a0 error) *InventoryService_CommitReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_CommitReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryService_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *InventoryService) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -140,6 +187,169 @@ func (_c *InventoryService_ListParts_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ReclaimExpiredReservations provides a mock function with given fields: ctx
+func (_m *InventoryService) ReclaimExpiredReservations(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReclaimExpiredReservations")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ReclaimExpiredReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReclaimExpiredReservations'
+type InventoryService_ReclaimExpiredReservations_Call struct {
+	*mock.Call
+}
+
+// ReclaimExpiredReservations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryService_Expecter) ReclaimExpiredReservations(ctx interface{}) *InventoryService_ReclaimExpiredReservations_Call {
+	return &InventoryService_ReclaimExpiredReservations_Call{Call: _e.mock.On("ReclaimExpiredReservations", ctx)}
+}
+
+func (_c *InventoryService_ReclaimExpiredReservations_Call) Run(run func(ctx context.Context)) *InventoryService_ReclaimExpiredReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ReclaimExpiredReservations_Call) Return(_a0 int, _a1 error) *InventoryService_ReclaimExpiredReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ReclaimExpiredReservations_Call) RunAndReturn(run func(context.Context) (int, error)) *InventoryService_ReclaimExpiredReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryService) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type InventoryService_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryService_Expecter) ReleaseReservation(ctx interface{}, orderUUID interface{}) *InventoryService_ReleaseReservation_Call {
+	return &InventoryService_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, orderUUID)}
+}
+
+func (_c *InventoryService_ReleaseReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryService_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ReleaseReservation_Call) Return(_a0 error) *InventoryService_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryService_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, orderUUID, items
+func (_m *InventoryService) ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) (*model.Reservation, error) {
+	ret := _m.Called(ctx, orderUUID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.ReservationItem) (*model.Reservation, error)); ok {
+		return rf(ctx, orderUUID, items)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.ReservationItem) *model.Reservation); ok {
+		r0 = rf(ctx, orderUUID, items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.ReservationItem) error); ok {
+		r1 = rf(ctx, orderUUID, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type InventoryService_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - items []model.ReservationItem
+func (_e *InventoryService_Expecter) ReserveParts(ctx interface{}, orderUUID interface{}, items interface{}) *InventoryService_ReserveParts_Call {
+	return &InventoryService_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, orderUUID, items)}
+}
+
+func (_c *InventoryService_ReserveParts_Call) Run(run func(ctx context.Context, orderUUID string, items []model.ReservationItem)) *InventoryService_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.ReservationItem))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ReserveParts_Call) Return(_a0 *model.Reservation, _a1 error) *InventoryService_ReserveParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ReserveParts_Call) RunAndReturn(run func(context.Context, string, []model.ReservationItem) (*model.Reservation, error)) *InventoryService_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryService creates a new instance of InventoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryService(t interface {
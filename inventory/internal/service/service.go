@@ -9,4 +9,8 @@ import (
 type InventoryService interface {
 	GetPart(ctx context.Context, uuid string) (*model.Part, error)
 	ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error)
+	ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) (*model.Reservation, error)
+	ReleaseReservation(ctx context.Context, orderUUID string) error
+	CommitReservation(ctx context.Context, orderUUID string) error
+	ReclaimExpiredReservations(ctx context.Context) (int, error)
 }
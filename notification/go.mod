@@ -12,6 +12,7 @@ require (
 	github.com/dexguitar/spacecraftory/platform v0.0.0-00010101000000-000000000000
 	github.com/dexguitar/spacecraftory/shared v0.0.0
 	github.com/go-telegram/bot v1.17.0
+	github.com/gomodule/redigo v1.9.3
 	github.com/joho/godotenv v1.5.1
 	go.uber.org/zap v1.27.1
 	google.golang.org/protobuf v1.36.10
@@ -11,6 +11,7 @@ import (
 	"github.com/dexguitar/spacecraftory/notification/internal/config"
 	"github.com/dexguitar/spacecraftory/platform/pkg/closer"
 	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
 )
 
 type App struct {
@@ -30,7 +31,7 @@ func New(ctx context.Context) (*App, error) {
 
 func (a *App) Run(ctx context.Context) error {
 	// Канал для ошибок от компонентов
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 5)
 
 	// Контекст для остановки всех горутин
 	ctx, cancel := context.WithCancel(ctx)
@@ -48,13 +49,31 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	go func() {
+		if err := a.runOrderCreatedConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("order created consumer crashed: %w", err)
+		}
+	}()
+
+	go func() {
+		if err := a.runOrderCancelledConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("order cancelled consumer crashed: %w", err)
+		}
+	}()
+
+	go func() {
+		if err := a.runAssemblyFailedConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("assembly failed consumer crashed: %w", err)
+		}
+	}()
+
 	// Ожидание либо ошибки, либо завершения контекста (например, сигнал SIGINT/SIGTERM)
 	select {
 	case <-ctx.Done():
 		logger.Info(ctx, "Shutdown signal received")
 	case err := <-errCh:
 		logger.Error(ctx, "Component crashed, shutting down", zap.Error(err))
-		// Триггерим cancel, чтобы остановить второй компонент
+		// Триггерим cancel, чтобы остановить остальные компоненты
 		cancel()
 		// Дождись завершения всех задач (если есть graceful shutdown внутри)
 		<-ctx.Done()
@@ -68,6 +87,7 @@ func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		a.initDI,
 		a.initLogger,
+		a.initTracing,
 		a.initCloser,
 		a.initTelegramBot,
 	}
@@ -102,8 +122,23 @@ func (a *App) initLogger(_ context.Context) error {
 	return logger.Init(cfg.Level(), cfg.AsJson())
 }
 
+func (a *App) initTracing(ctx context.Context) error {
+	cfg := config.AppConfig().Tracing
+	if cfg.CollectorEndpoint() == "" {
+		return nil // Tracing disabled
+	}
+
+	if err := tracing.InitTracer(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init tracer: %w", err)
+	}
+
+	logger.Info(ctx, "🔍 Tracing initialized")
+	return nil
+}
+
 func (a *App) initCloser(_ context.Context) error {
 	closer.SetLogger(logger.Logger())
+	closer.AddNamed("Tracing", tracing.ShutdownTracer)
 	closer.AddNamed("Logger", func(ctx context.Context) error {
 		return logger.Close()
 	})
@@ -153,3 +188,30 @@ func (a *App) runOrderAssembledConsumer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runOrderCreatedConsumer(ctx context.Context) error {
+	err := a.diContainer.OrderCreatedConsumerService(ctx).RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runOrderCancelledConsumer(ctx context.Context) error {
+	err := a.diContainer.OrderCancelledConsumerService(ctx).RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runAssemblyFailedConsumer(ctx context.Context) error {
+	err := a.diContainer.AssemblyFailedConsumerService(ctx).RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
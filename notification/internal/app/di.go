@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM/sarama"
 	"github.com/go-telegram/bot"
+	redigo "github.com/gomodule/redigo/redis"
 
 	"github.com/dexguitar/spacecraftory/notification/internal/client/http"
 	tgClient "github.com/dexguitar/spacecraftory/notification/internal/client/http/telegram"
@@ -13,9 +14,14 @@ import (
 	kafkaConverter "github.com/dexguitar/spacecraftory/notification/internal/converter/kafka"
 	decoder "github.com/dexguitar/spacecraftory/notification/internal/converter/kafka/decoder"
 	"github.com/dexguitar/spacecraftory/notification/internal/service"
+	"github.com/dexguitar/spacecraftory/notification/internal/service/consumer/assembly_failed_consumer"
 	"github.com/dexguitar/spacecraftory/notification/internal/service/consumer/order_assembled_consumer"
+	"github.com/dexguitar/spacecraftory/notification/internal/service/consumer/order_cancelled_consumer"
+	"github.com/dexguitar/spacecraftory/notification/internal/service/consumer/order_created_consumer"
 	"github.com/dexguitar/spacecraftory/notification/internal/service/consumer/order_paid_consumer"
 	tgService "github.com/dexguitar/spacecraftory/notification/internal/service/telegram"
+	"github.com/dexguitar/spacecraftory/platform/pkg/cache"
+	"github.com/dexguitar/spacecraftory/platform/pkg/cache/redis"
 	"github.com/dexguitar/spacecraftory/platform/pkg/closer"
 	wrappedKafka "github.com/dexguitar/spacecraftory/platform/pkg/kafka"
 	wrappedKafkaConsumer "github.com/dexguitar/spacecraftory/platform/pkg/kafka/consumer"
@@ -32,12 +38,31 @@ type diContainer struct {
 	orderAssembledDecoder       kafkaConverter.OrderAssembledDecoder
 	orderAssembledConsumerGroup sarama.ConsumerGroup
 
+	orderCreatedConsumer      wrappedKafka.Consumer
+	orderCreatedDecoder       kafkaConverter.OrderCreatedDecoder
+	orderCreatedConsumerGroup sarama.ConsumerGroup
+
+	orderCancelledConsumer      wrappedKafka.Consumer
+	orderCancelledDecoder       kafkaConverter.OrderCancelledDecoder
+	orderCancelledConsumerGroup sarama.ConsumerGroup
+
+	assemblyFailedConsumer      wrappedKafka.Consumer
+	assemblyFailedDecoder       kafkaConverter.AssemblyFailedDecoder
+	assemblyFailedConsumerGroup sarama.ConsumerGroup
+
+	retryProducer sarama.SyncProducer
+	redisPool     *redigo.Pool
+	redisClient   cache.RedisClient
+
 	telegramClient  http.TelegramClient
 	telegramBot     *bot.Bot
 	telegramService service.TelegramService
 
 	orderPaidConsumerService      service.ConsumerService
 	orderAssembledConsumerService service.ConsumerService
+	orderCreatedConsumerService   service.ConsumerService
+	orderCancelledConsumerService service.ConsumerService
+	assemblyFailedConsumerService service.ConsumerService
 }
 
 func NewDiContainer() *diContainer {
@@ -101,13 +126,25 @@ func (d *diContainer) OrderAssembledConsumerGroup() sarama.ConsumerGroup {
 
 func (d *diContainer) OrderPaidConsumer() wrappedKafka.Consumer {
 	if d.orderPaidConsumer == nil {
+		retryPolicy := consumerRetryPolicy(
+			config.AppConfig().OrderPaidConsumer.Topic(),
+			config.AppConfig().OrderPaidConsumer.GroupID(),
+		)
 		d.orderPaidConsumer = wrappedKafkaConsumer.NewConsumer(
 			d.OrderPaidConsumerGroup(),
 			[]string{
 				config.AppConfig().OrderPaidConsumer.Topic(),
+				retryPolicy.RetryTopic,
 			},
 			logger.Logger(),
+			kafkaMiddleware.Tracing(config.AppConfig().Tracing.ServiceName()),
 			kafkaMiddleware.Logging(logger.Logger()),
+			wrappedKafkaConsumer.Retry(retryPolicy, d.RetryProducer(), logger.Logger()),
+			wrappedKafkaConsumer.Dedup(
+				d.dedupStore(config.AppConfig().OrderPaidConsumer.GroupID()),
+				d.orderPaidEventUUID,
+				logger.Logger(),
+			),
 		)
 	}
 
@@ -122,15 +159,37 @@ func (d *diContainer) OrderPaidDecoder() kafkaConverter.OrderPaidDecoder {
 	return d.orderPaidDecoder
 }
 
+// orderPaidEventUUID extracts the dedup key of an OrderPaid message
+func (d *diContainer) orderPaidEventUUID(msg wrappedKafka.Message) (string, error) {
+	event, err := d.OrderPaidDecoder().Decode(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return event.EventUUID, nil
+}
+
 func (d *diContainer) OrderAssembledConsumer() wrappedKafka.Consumer {
 	if d.orderAssembledConsumer == nil {
+		retryPolicy := consumerRetryPolicy(
+			config.AppConfig().OrderAssembledConsumer.Topic(),
+			config.AppConfig().OrderAssembledConsumer.GroupID(),
+		)
 		d.orderAssembledConsumer = wrappedKafkaConsumer.NewConsumer(
 			d.OrderAssembledConsumerGroup(),
 			[]string{
 				config.AppConfig().OrderAssembledConsumer.Topic(),
+				retryPolicy.RetryTopic,
 			},
 			logger.Logger(),
+			kafkaMiddleware.Tracing(config.AppConfig().Tracing.ServiceName()),
 			kafkaMiddleware.Logging(logger.Logger()),
+			wrappedKafkaConsumer.Retry(retryPolicy, d.RetryProducer(), logger.Logger()),
+			wrappedKafkaConsumer.Dedup(
+				d.dedupStore(config.AppConfig().OrderAssembledConsumer.GroupID()),
+				d.orderAssembledEventUUID,
+				logger.Logger(),
+			),
 		)
 	}
 
@@ -145,6 +204,225 @@ func (d *diContainer) OrderAssembledDecoder() kafkaConverter.OrderAssembledDecod
 	return d.orderAssembledDecoder
 }
 
+func (d *diContainer) OrderCreatedConsumerService(ctx context.Context) service.ConsumerService {
+	if d.orderCreatedConsumerService == nil {
+		d.orderCreatedConsumerService = order_created_consumer.NewService(d.OrderCreatedConsumer(), d.OrderCreatedDecoder(), d.TelegramService(ctx))
+	}
+
+	return d.orderCreatedConsumerService
+}
+
+func (d *diContainer) OrderCreatedConsumerGroup() sarama.ConsumerGroup {
+	if d.orderCreatedConsumerGroup == nil {
+		orderCreatedConsumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderCreatedConsumer.GroupID(),
+			config.AppConfig().OrderCreatedConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka consumer group", func(ctx context.Context) error {
+			return d.orderCreatedConsumerGroup.Close()
+		})
+
+		d.orderCreatedConsumerGroup = orderCreatedConsumerGroup
+	}
+
+	return d.orderCreatedConsumerGroup
+}
+
+func (d *diContainer) OrderCreatedConsumer() wrappedKafka.Consumer {
+	if d.orderCreatedConsumer == nil {
+		retryPolicy := consumerRetryPolicy(
+			config.AppConfig().OrderCreatedConsumer.Topic(),
+			config.AppConfig().OrderCreatedConsumer.GroupID(),
+		)
+		d.orderCreatedConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.OrderCreatedConsumerGroup(),
+			[]string{
+				config.AppConfig().OrderCreatedConsumer.Topic(),
+				retryPolicy.RetryTopic,
+			},
+			logger.Logger(),
+			kafkaMiddleware.Tracing(config.AppConfig().Tracing.ServiceName()),
+			kafkaMiddleware.Logging(logger.Logger()),
+			wrappedKafkaConsumer.Retry(retryPolicy, d.RetryProducer(), logger.Logger()),
+			wrappedKafkaConsumer.Dedup(
+				d.dedupStore(config.AppConfig().OrderCreatedConsumer.GroupID()),
+				d.orderCreatedEventUUID,
+				logger.Logger(),
+			),
+		)
+	}
+
+	return d.orderCreatedConsumer
+}
+
+func (d *diContainer) OrderCreatedDecoder() kafkaConverter.OrderCreatedDecoder {
+	if d.orderCreatedDecoder == nil {
+		d.orderCreatedDecoder = decoder.NewOrderCreatedDecoder()
+	}
+
+	return d.orderCreatedDecoder
+}
+
+// orderCreatedEventUUID extracts the dedup key of an OrderCreated message
+func (d *diContainer) orderCreatedEventUUID(msg wrappedKafka.Message) (string, error) {
+	event, err := d.OrderCreatedDecoder().Decode(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return event.EventUUID, nil
+}
+
+func (d *diContainer) OrderCancelledConsumerService(ctx context.Context) service.ConsumerService {
+	if d.orderCancelledConsumerService == nil {
+		d.orderCancelledConsumerService = order_cancelled_consumer.NewService(d.OrderCancelledConsumer(), d.OrderCancelledDecoder(), d.TelegramService(ctx))
+	}
+
+	return d.orderCancelledConsumerService
+}
+
+func (d *diContainer) OrderCancelledConsumerGroup() sarama.ConsumerGroup {
+	if d.orderCancelledConsumerGroup == nil {
+		orderCancelledConsumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderCancelledConsumer.GroupID(),
+			config.AppConfig().OrderCancelledConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka consumer group", func(ctx context.Context) error {
+			return d.orderCancelledConsumerGroup.Close()
+		})
+
+		d.orderCancelledConsumerGroup = orderCancelledConsumerGroup
+	}
+
+	return d.orderCancelledConsumerGroup
+}
+
+func (d *diContainer) OrderCancelledConsumer() wrappedKafka.Consumer {
+	if d.orderCancelledConsumer == nil {
+		retryPolicy := consumerRetryPolicy(
+			config.AppConfig().OrderCancelledConsumer.Topic(),
+			config.AppConfig().OrderCancelledConsumer.GroupID(),
+		)
+		d.orderCancelledConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.OrderCancelledConsumerGroup(),
+			[]string{
+				config.AppConfig().OrderCancelledConsumer.Topic(),
+				retryPolicy.RetryTopic,
+			},
+			logger.Logger(),
+			kafkaMiddleware.Tracing(config.AppConfig().Tracing.ServiceName()),
+			kafkaMiddleware.Logging(logger.Logger()),
+			wrappedKafkaConsumer.Retry(retryPolicy, d.RetryProducer(), logger.Logger()),
+			wrappedKafkaConsumer.Dedup(
+				d.dedupStore(config.AppConfig().OrderCancelledConsumer.GroupID()),
+				d.orderCancelledEventUUID,
+				logger.Logger(),
+			),
+		)
+	}
+
+	return d.orderCancelledConsumer
+}
+
+func (d *diContainer) OrderCancelledDecoder() kafkaConverter.OrderCancelledDecoder {
+	if d.orderCancelledDecoder == nil {
+		d.orderCancelledDecoder = decoder.NewOrderCancelledDecoder()
+	}
+
+	return d.orderCancelledDecoder
+}
+
+// orderCancelledEventUUID extracts the dedup key of an OrderCancelled message
+func (d *diContainer) orderCancelledEventUUID(msg wrappedKafka.Message) (string, error) {
+	event, err := d.OrderCancelledDecoder().Decode(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return event.EventUUID, nil
+}
+
+func (d *diContainer) AssemblyFailedConsumerService(ctx context.Context) service.ConsumerService {
+	if d.assemblyFailedConsumerService == nil {
+		d.assemblyFailedConsumerService = assembly_failed_consumer.NewService(d.AssemblyFailedConsumer(), d.AssemblyFailedDecoder(), d.TelegramService(ctx))
+	}
+
+	return d.assemblyFailedConsumerService
+}
+
+func (d *diContainer) AssemblyFailedConsumerGroup() sarama.ConsumerGroup {
+	if d.assemblyFailedConsumerGroup == nil {
+		assemblyFailedConsumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().AssemblyFailedConsumer.GroupID(),
+			config.AppConfig().AssemblyFailedConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka consumer group", func(ctx context.Context) error {
+			return d.assemblyFailedConsumerGroup.Close()
+		})
+
+		d.assemblyFailedConsumerGroup = assemblyFailedConsumerGroup
+	}
+
+	return d.assemblyFailedConsumerGroup
+}
+
+func (d *diContainer) AssemblyFailedConsumer() wrappedKafka.Consumer {
+	if d.assemblyFailedConsumer == nil {
+		retryPolicy := consumerRetryPolicy(
+			config.AppConfig().AssemblyFailedConsumer.Topic(),
+			config.AppConfig().AssemblyFailedConsumer.GroupID(),
+		)
+		d.assemblyFailedConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.AssemblyFailedConsumerGroup(),
+			[]string{
+				config.AppConfig().AssemblyFailedConsumer.Topic(),
+				retryPolicy.RetryTopic,
+			},
+			logger.Logger(),
+			kafkaMiddleware.Tracing(config.AppConfig().Tracing.ServiceName()),
+			kafkaMiddleware.Logging(logger.Logger()),
+			wrappedKafkaConsumer.Retry(retryPolicy, d.RetryProducer(), logger.Logger()),
+			wrappedKafkaConsumer.Dedup(
+				d.dedupStore(config.AppConfig().AssemblyFailedConsumer.GroupID()),
+				d.assemblyFailedEventUUID,
+				logger.Logger(),
+			),
+		)
+	}
+
+	return d.assemblyFailedConsumer
+}
+
+func (d *diContainer) AssemblyFailedDecoder() kafkaConverter.AssemblyFailedDecoder {
+	if d.assemblyFailedDecoder == nil {
+		d.assemblyFailedDecoder = decoder.NewAssemblyFailedDecoder()
+	}
+
+	return d.assemblyFailedDecoder
+}
+
+// assemblyFailedEventUUID extracts the dedup key of an AssemblyFailed message
+func (d *diContainer) assemblyFailedEventUUID(msg wrappedKafka.Message) (string, error) {
+	event, err := d.AssemblyFailedDecoder().Decode(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return event.EventUUID, nil
+}
+
 func (d *diContainer) TelegramClient() http.TelegramClient {
 	if d.telegramClient == nil {
 		d.telegramClient = tgClient.NewClient(d.telegramBot)
@@ -173,3 +451,82 @@ func (d *diContainer) TelegramBot(ctx context.Context) *bot.Bot {
 
 	return d.telegramBot
 }
+
+func (d *diContainer) RetryProducer() sarama.SyncProducer {
+	if d.retryProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().ConsumerRetry.ProducerConfig(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create retry producer: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka retry producer", func(ctx context.Context) error {
+			return p.Close()
+		})
+
+		d.retryProducer = p
+	}
+
+	return d.retryProducer
+}
+
+// orderAssembledEventUUID extracts the dedup key of an OrderAssembled message
+func (d *diContainer) orderAssembledEventUUID(msg wrappedKafka.Message) (string, error) {
+	event, err := d.OrderAssembledDecoder().Decode(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return event.EventUUID, nil
+}
+
+// consumerRetryPolicy builds the retry policy of the consumer group reading the topic
+func consumerRetryPolicy(topic, groupID string) wrappedKafkaConsumer.RetryPolicy {
+	cfg := config.AppConfig().ConsumerRetry
+
+	return wrappedKafkaConsumer.RetryPolicy{
+		MaxAttempts:           cfg.MaxAttempts(),
+		InitialBackoff:        cfg.InitialBackoff(),
+		MaxBackoff:            cfg.MaxBackoff(),
+		RetryTopic:            wrappedKafkaConsumer.RetryTopicName(topic, groupID),
+		RetryDelay:            cfg.RetryTopicDelay(),
+		MaxRetryTopicAttempts: cfg.RetryTopicMaxAttempts(),
+		DeadLetterTopic:       wrappedKafkaConsumer.DeadLetterTopicName(topic, groupID),
+	}
+}
+
+func (d *diContainer) RedisPool() *redigo.Pool {
+	if d.redisPool == nil {
+		d.redisPool = &redigo.Pool{
+			MaxIdle:     config.AppConfig().Redis.MaxIdle(),
+			IdleTimeout: config.AppConfig().Redis.IdleTimeout(),
+			DialContext: func(ctx context.Context) (redigo.Conn, error) {
+				return redigo.DialContext(ctx, "tcp", config.AppConfig().Redis.Address())
+			},
+		}
+		closer.AddNamed("Redis pool", func(ctx context.Context) error {
+			return d.redisPool.Close()
+		})
+	}
+
+	return d.redisPool
+}
+
+func (d *diContainer) RedisClient() cache.RedisClient {
+	if d.redisClient == nil {
+		d.redisClient = redis.NewClient(d.RedisPool(), logger.Logger(), config.AppConfig().Redis.ConnectionTimeout())
+	}
+
+	return d.redisClient
+}
+
+// dedupStore keeps processed event UUIDs of the consumer group in Redis
+func (d *diContainer) dedupStore(groupID string) wrappedKafkaConsumer.DedupStore {
+	return wrappedKafkaConsumer.NewRedisDedupStore(
+		d.RedisClient(),
+		wrappedKafkaConsumer.DedupKeyPrefix(groupID),
+		config.AppConfig().Redis.DedupTTL(),
+		config.AppConfig().Redis.DedupClaimTTL(),
+	)
+}
@@ -12,10 +12,16 @@ var appConfig *config
 
 type config struct {
 	Logger                 LoggerConfig
+	Tracing                TracingConfig
 	Kafka                  KafkaConfig
 	OrderPaidConsumer      OrderPaidConsumerConfig
 	OrderAssembledConsumer OrderAssembledConsumerConfig
+	OrderCreatedConsumer   OrderCreatedConsumerConfig
+	OrderCancelledConsumer OrderCancelledConsumerConfig
+	AssemblyFailedConsumer AssemblyFailedConsumerConfig
 	TelegramBot            TelegramBotConfig
+	ConsumerRetry          ConsumerRetryConfig
+	Redis                  RedisConfig
 }
 
 func Load(path ...string) error {
@@ -29,6 +35,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	tracingCfg, err := env.NewTracingConfig()
+	if err != nil {
+		return err
+	}
+
 	kafkaCfg, err := env.NewKafkaConfig()
 	if err != nil {
 		return err
@@ -44,17 +55,48 @@ func Load(path ...string) error {
 		return err
 	}
 
+	orderCreatedConsumerCfg, err := env.NewOrderCreatedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
+	orderCancelledConsumerCfg, err := env.NewOrderCancelledConsumerConfig()
+	if err != nil {
+		return err
+	}
+
+	assemblyFailedConsumerCfg, err := env.NewAssemblyFailedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	telegramBotCfg, err := env.NewTelegramBotConfig()
 	if err != nil {
 		return err
 	}
 
+	consumerRetryCfg, err := env.NewConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
+	redisCfg, err := env.NewRedisConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:                 loggerCfg,
+		Tracing:                tracingCfg,
 		Kafka:                  kafkaCfg,
 		OrderPaidConsumer:      orderPaidConsumerCfg,
 		OrderAssembledConsumer: orderAssembledConsumerCfg,
+		OrderCreatedConsumer:   orderCreatedConsumerCfg,
+		OrderCancelledConsumer: orderCancelledConsumerCfg,
+		AssemblyFailedConsumer: assemblyFailedConsumerCfg,
 		TelegramBot:            telegramBotCfg,
+		ConsumerRetry:          consumerRetryCfg,
+		Redis:                  redisCfg,
 	}
 
 	return nil
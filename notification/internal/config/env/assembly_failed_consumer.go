@@ -0,0 +1,36 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyFailedConsumerEnvConfig struct {
+	Topic   string `env:"ASSEMBLY_FAILED_TOPIC_NAME,required"`
+	GroupID string `env:"ASSEMBLY_FAILED_CONSUMER_GROUP_ID,required"`
+}
+
+type assemblyFailedConsumerConfig struct {
+	raw assemblyFailedConsumerEnvConfig
+}
+
+func NewAssemblyFailedConsumerConfig() (*assemblyFailedConsumerConfig, error) {
+	var raw assemblyFailedConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &assemblyFailedConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *assemblyFailedConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *assemblyFailedConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *assemblyFailedConsumerConfig) Config() *sarama.Config {
+	return newKafkaConsumerConfig()
+}
@@ -0,0 +1,36 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderCancelledConsumerEnvConfig struct {
+	Topic   string `env:"ORDER_CANCELLED_TOPIC_NAME,required"`
+	GroupID string `env:"ORDER_CANCELLED_CONSUMER_GROUP_ID,required"`
+}
+
+type orderCancelledConsumerConfig struct {
+	raw orderCancelledConsumerEnvConfig
+}
+
+func NewOrderCancelledConsumerConfig() (*orderCancelledConsumerConfig, error) {
+	var raw orderCancelledConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCancelledConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCancelledConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *orderCancelledConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *orderCancelledConsumerConfig) Config() *sarama.Config {
+	return newKafkaConsumerConfig()
+}
@@ -0,0 +1,36 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderCreatedConsumerEnvConfig struct {
+	Topic   string `env:"ORDER_CREATED_TOPIC_NAME,required"`
+	GroupID string `env:"ORDER_CREATED_CONSUMER_GROUP_ID,required"`
+}
+
+type orderCreatedConsumerConfig struct {
+	raw orderCreatedConsumerEnvConfig
+}
+
+func NewOrderCreatedConsumerConfig() (*orderCreatedConsumerConfig, error) {
+	var raw orderCreatedConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCreatedConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCreatedConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *orderCreatedConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *orderCreatedConsumerConfig) Config() *sarama.Config {
+	return newKafkaConsumerConfig()
+}
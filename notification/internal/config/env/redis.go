@@ -0,0 +1,58 @@
+package env
+
+import (
+	"net"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type redisEnvConfig struct {
+	Host              string        `env:"REDIS_HOST,required"`
+	Port              string        `env:"REDIS_PORT,required"`
+	ConnectionTimeout time.Duration `env:"REDIS_CONNECTION_TIMEOUT" envDefault:"10s"`
+	MaxIdle           int           `env:"REDIS_MAX_IDLE" envDefault:"10"`
+	IdleTimeout       time.Duration `env:"REDIS_IDLE_TIMEOUT" envDefault:"10s"`
+	DedupTTL          time.Duration `env:"REDIS_DEDUP_TTL" envDefault:"168h"`
+	DedupClaimTTL     time.Duration `env:"REDIS_DEDUP_CLAIM_TTL" envDefault:"5m"`
+}
+
+type redisConfig struct {
+	raw redisEnvConfig
+}
+
+func NewRedisConfig() (*redisConfig, error) {
+	var raw redisEnvConfig
+	err := env.Parse(&raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisConfig{raw: raw}, nil
+}
+
+func (cfg *redisConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
+
+func (cfg *redisConfig) ConnectionTimeout() time.Duration {
+	return cfg.raw.ConnectionTimeout
+}
+
+func (cfg *redisConfig) MaxIdle() int {
+	return cfg.raw.MaxIdle
+}
+
+func (cfg *redisConfig) IdleTimeout() time.Duration {
+	return cfg.raw.IdleTimeout
+}
+
+// DedupTTL — сколько хранится отметка об обработанном событии
+func (cfg *redisConfig) DedupTTL() time.Duration {
+	return cfg.raw.DedupTTL
+}
+
+// DedupClaimTTL — сколько держится захват события на обработку
+func (cfg *redisConfig) DedupClaimTTL() time.Duration {
+	return cfg.raw.DedupClaimTTL
+}
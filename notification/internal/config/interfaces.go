@@ -1,6 +1,10 @@
 package config
 
-import "github.com/IBM/sarama"
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 type LoggerConfig interface {
 	Level() string
@@ -9,6 +13,13 @@ type LoggerConfig interface {
 	ServiceName() string
 }
 
+type TracingConfig interface {
+	CollectorEndpoint() string
+	ServiceName() string
+	Environment() string
+	ServiceVersion() string
+}
+
 type KafkaConfig interface {
 	Brokers() []string
 }
@@ -25,6 +36,42 @@ type OrderAssembledConsumerConfig interface {
 	Config() *sarama.Config
 }
 
+type OrderCreatedConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
+type OrderCancelledConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
+type AssemblyFailedConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
 type TelegramBotConfig interface {
 	Token() string
 }
+
+type ConsumerRetryConfig interface {
+	MaxAttempts() int
+	InitialBackoff() time.Duration
+	MaxBackoff() time.Duration
+	RetryTopicDelay() time.Duration
+	RetryTopicMaxAttempts() int
+	ProducerConfig() *sarama.Config
+}
+
+type RedisConfig interface {
+	Address() string
+	ConnectionTimeout() time.Duration
+	MaxIdle() int
+	IdleTimeout() time.Duration
+	DedupTTL() time.Duration
+	DedupClaimTTL() time.Duration
+}
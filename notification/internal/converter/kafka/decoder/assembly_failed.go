@@ -0,0 +1,51 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dexguitar/spacecraftory/notification/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
+)
+
+type assemblyFailedDecoder struct {
+	registry *kafka.DecoderRegistry
+}
+
+func NewAssemblyFailedDecoder() *assemblyFailedDecoder {
+	return &assemblyFailedDecoder{
+		registry: kafka.NewDecoderRegistry().
+			Register(model.EventTypeAssemblyFailed, model.AssemblyFailedSchemaVersion, decodeAssemblyFailedV1).
+			WithLegacyEventType(model.EventTypeAssemblyFailed),
+	}
+}
+
+func (d *assemblyFailedDecoder) Decode(msg kafka.Message) (model.AssemblyFailedEvent, error) {
+	event, err := d.registry.Decode(msg)
+	if err != nil {
+		return model.AssemblyFailedEvent{}, err
+	}
+
+	payload, ok := event.Payload.(model.AssemblyFailedEvent)
+	if !ok {
+		return model.AssemblyFailedEvent{}, fmt.Errorf("%w: %s", kafka.ErrUnknownEventType, event.Envelope.EventType)
+	}
+
+	return payload, nil
+}
+
+func decodeAssemblyFailedV1(data []byte) (any, error) {
+	var pb eventsV1.AssemblyFailed
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return model.AssemblyFailedEvent{
+		EventUUID: pb.EventUuid,
+		OrderUUID: pb.OrderUuid,
+		UserUUID:  pb.UserUuid,
+		Reason:    pb.Reason,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dexguitar/spacecraftory/notification/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
+)
+
+type orderCancelledDecoder struct {
+	registry *kafka.DecoderRegistry
+}
+
+func NewOrderCancelledDecoder() *orderCancelledDecoder {
+	return &orderCancelledDecoder{
+		registry: kafka.NewDecoderRegistry().
+			Register(model.EventTypeOrderCancelled, model.OrderCancelledSchemaVersion, decodeOrderCancelledV1).
+			WithLegacyEventType(model.EventTypeOrderCancelled),
+	}
+}
+
+func (d *orderCancelledDecoder) Decode(msg kafka.Message) (model.OrderCancelledEvent, error) {
+	event, err := d.registry.Decode(msg)
+	if err != nil {
+		return model.OrderCancelledEvent{}, err
+	}
+
+	payload, ok := event.Payload.(model.OrderCancelledEvent)
+	if !ok {
+		return model.OrderCancelledEvent{}, fmt.Errorf("%w: %s", kafka.ErrUnknownEventType, event.Envelope.EventType)
+	}
+
+	return payload, nil
+}
+
+func decodeOrderCancelledV1(data []byte) (any, error) {
+	var pb eventsV1.OrderCancelled
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return model.OrderCancelledEvent{
+		EventUUID: pb.EventUuid,
+		OrderUUID: pb.OrderUuid,
+		UserUUID:  pb.UserUuid,
+		Reason:    pb.Reason,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package decoder
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dexguitar/spacecraftory/notification/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
+)
+
+const (
+	orderCreatedV1SchemaVersion = 1
+	// orderCreatedV1Currency — валюта v1, где цены передавались без неё и всегда были в рублях
+	orderCreatedV1Currency = "RUB"
+)
+
+type orderCreatedDecoder struct {
+	registry *kafka.DecoderRegistry
+}
+
+func NewOrderCreatedDecoder() *orderCreatedDecoder {
+	return &orderCreatedDecoder{
+		registry: kafka.NewDecoderRegistry().
+			Register(model.EventTypeOrderCreated, orderCreatedV1SchemaVersion, decodeOrderCreatedV1).
+			Register(model.EventTypeOrderCreated, model.OrderCreatedSchemaVersion, decodeOrderCreatedV2).
+			WithLegacyEventType(model.EventTypeOrderCreated),
+	}
+}
+
+func (d *orderCreatedDecoder) Decode(msg kafka.Message) (model.OrderCreatedEvent, error) {
+	event, err := d.registry.Decode(msg)
+	if err != nil {
+		return model.OrderCreatedEvent{}, err
+	}
+
+	payload, ok := event.Payload.(model.OrderCreatedEvent)
+	if !ok {
+		return model.OrderCreatedEvent{}, fmt.Errorf("%w: %s", kafka.ErrUnknownEventType, event.Envelope.EventType)
+	}
+
+	return payload, nil
+}
+
+// decodeOrderCreatedV1 читает схему без количеств: каждая деталь считается одной штукой
+func decodeOrderCreatedV1(data []byte) (any, error) {
+	var pb eventsV1.OrderCreated
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return orderCreatedFromV1(&pb), nil
+}
+
+// decodeOrderCreatedV2 читает позиции с количествами и сумму десятичной строкой.
+// Сообщения, записанные в outbox до перехода на v2, уходят с заголовком v2,
+// но содержат только поля v1 — они читаются как v1.
+func decodeOrderCreatedV2(data []byte) (any, error) {
+	var pb eventsV1.OrderCreated
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	if pb.TotalAmount == "" {
+		return orderCreatedFromV1(&pb), nil
+	}
+
+	items := make([]model.OrderCreatedItem, 0, len(pb.Items))
+	for _, item := range pb.Items {
+		items = append(items, model.OrderCreatedItem{
+			PartUUID:  item.PartUuid,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		})
+	}
+
+	return model.OrderCreatedEvent{
+		EventUUID:   pb.EventUuid,
+		OrderUUID:   pb.OrderUuid,
+		UserUUID:    pb.UserUuid,
+		Items:       items,
+		TotalAmount: pb.TotalAmount,
+		Currency:    pb.Currency,
+	}, nil
+}
+
+func orderCreatedFromV1(pb *eventsV1.OrderCreated) model.OrderCreatedEvent {
+	//nolint:staticcheck // part_uuids и total_price — поля схемы v1
+	partUUIDs, totalPrice := pb.PartUuids, pb.TotalPrice
+
+	items := make([]model.OrderCreatedItem, 0, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		items = append(items, model.OrderCreatedItem{PartUUID: partUUID, Quantity: 1})
+	}
+
+	return model.OrderCreatedEvent{
+		EventUUID:   pb.EventUuid,
+		OrderUUID:   pb.OrderUuid,
+		UserUUID:    pb.UserUuid,
+		Items:       items,
+		TotalAmount: strconv.FormatFloat(totalPrice, 'f', 2, 64),
+		Currency:    orderCreatedV1Currency,
+	}
+}
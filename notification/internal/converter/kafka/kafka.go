@@ -1,11 +1,26 @@
 package kafka
 
-import "github.com/dexguitar/spacecraftory/notification/internal/model"
+import (
+	"github.com/dexguitar/spacecraftory/notification/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
 
 type OrderPaidDecoder interface {
-	Decode(data []byte) (model.OrderPaidEvent, error)
+	Decode(msg kafka.Message) (model.OrderPaidEvent, error)
 }
 
 type OrderAssembledDecoder interface {
-	Decode(data []byte) (model.OrderAssembledEvent, error)
+	Decode(msg kafka.Message) (model.OrderAssembledEvent, error)
+}
+
+type OrderCreatedDecoder interface {
+	Decode(msg kafka.Message) (model.OrderCreatedEvent, error)
+}
+
+type OrderCancelledDecoder interface {
+	Decode(msg kafka.Message) (model.OrderCancelledEvent, error)
+}
+
+type AssemblyFailedDecoder interface {
+	Decode(msg kafka.Message) (model.AssemblyFailedEvent, error)
 }
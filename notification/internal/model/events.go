@@ -1,11 +1,28 @@
 package model
 
+// Event types and schema versions carried in the Kafka event envelope
+const (
+	EventTypeOrderPaid      = "OrderPaid"
+	EventTypeOrderCreated   = "OrderCreated"
+	EventTypeOrderCancelled = "OrderCancelled"
+	EventTypeShipAssembled  = "ShipAssembled"
+	EventTypeAssemblyFailed = "AssemblyFailed"
+
+	OrderPaidSchemaVersion      = 1
+	OrderCreatedSchemaVersion   = 2
+	OrderCancelledSchemaVersion = 1
+	ShipAssembledSchemaVersion  = 1
+	AssemblyFailedSchemaVersion = 1
+)
+
 type OrderPaidEvent struct {
 	EventUUID       string
 	OrderUUID       string
 	UserUUID        string
 	PaymentMethod   string
 	TransactionUUID string
+	Amount          string
+	Currency        string
 }
 
 type OrderAssembledEvent struct {
@@ -14,3 +31,42 @@ type OrderAssembledEvent struct {
 	UserUUID     string
 	BuildTimeSec int64
 }
+
+type OrderCreatedEvent struct {
+	EventUUID   string
+	OrderUUID   string
+	UserUUID    string
+	Items       []OrderCreatedItem
+	TotalAmount string
+	Currency    string
+}
+
+type OrderCreatedItem struct {
+	PartUUID  string
+	Quantity  int64
+	UnitPrice string
+}
+
+// PartsCount returns the number of parts over all order lines
+func (e OrderCreatedEvent) PartsCount() int64 {
+	var count int64
+	for _, item := range e.Items {
+		count += item.Quantity
+	}
+
+	return count
+}
+
+type OrderCancelledEvent struct {
+	EventUUID string
+	OrderUUID string
+	UserUUID  string
+	Reason    string
+}
+
+type AssemblyFailedEvent struct {
+	EventUUID string
+	OrderUUID string
+	UserUUID  string
+	Reason    string
+}
@@ -0,0 +1,38 @@
+package assembly_failed_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/dexguitar/spacecraftory/notification/internal/converter/kafka"
+	"github.com/dexguitar/spacecraftory/notification/internal/service"
+	wrappedKafka "github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+type assemblyFailedConsumerService struct {
+	assemblyFailedConsumer wrappedKafka.Consumer
+	assemblyFailedDecoder  kafkaConverter.AssemblyFailedDecoder
+	telegramService        service.TelegramService
+}
+
+func NewService(assemblyFailedConsumer wrappedKafka.Consumer, assemblyFailedDecoder kafkaConverter.AssemblyFailedDecoder, telegramService service.TelegramService) *assemblyFailedConsumerService {
+	return &assemblyFailedConsumerService{
+		assemblyFailedConsumer: assemblyFailedConsumer,
+		assemblyFailedDecoder:  assemblyFailedDecoder,
+		telegramService:        telegramService,
+	}
+}
+
+func (s *assemblyFailedConsumerService) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Assembly failed Kafka consumer running")
+
+	err := s.assemblyFailedConsumer.Consume(ctx, s.AssemblyFailedHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from assembly failed topic error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package assembly_failed_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	wrappedKafka "github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+func (s *assemblyFailedConsumerService) AssemblyFailedHandler(ctx context.Context, msg wrappedKafka.Message) error {
+	event, err := s.assemblyFailedDecoder.Decode(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode AssemblyFailed", zap.Error(err))
+		return err
+	}
+
+	err = s.telegramService.SendAssemblyFailedNotification(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to send assembly failed notification", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Processing message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("user_uuid", event.UserUUID),
+		zap.String("reason", event.Reason),
+	)
+
+	return nil
+}
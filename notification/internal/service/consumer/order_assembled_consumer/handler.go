@@ -10,7 +10,7 @@ import (
 )
 
 func (s *orderAssembledConsumerService) OrderAssembledHandler(ctx context.Context, msg wrappedKafka.Message) error {
-	event, err := s.orderAssembledDecoder.Decode(msg.Value)
+	event, err := s.orderAssembledDecoder.Decode(msg)
 	if err != nil {
 		logger.Error(ctx, "Failed to decode OrderAssembled", zap.Error(err))
 		return err
@@ -0,0 +1,38 @@
+package order_cancelled_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/dexguitar/spacecraftory/notification/internal/converter/kafka"
+	"github.com/dexguitar/spacecraftory/notification/internal/service"
+	wrappedKafka "github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+type orderCancelledConsumerService struct {
+	orderCancelledConsumer wrappedKafka.Consumer
+	orderCancelledDecoder  kafkaConverter.OrderCancelledDecoder
+	telegramService        service.TelegramService
+}
+
+func NewService(orderCancelledConsumer wrappedKafka.Consumer, orderCancelledDecoder kafkaConverter.OrderCancelledDecoder, telegramService service.TelegramService) *orderCancelledConsumerService {
+	return &orderCancelledConsumerService{
+		orderCancelledConsumer: orderCancelledConsumer,
+		orderCancelledDecoder:  orderCancelledDecoder,
+		telegramService:        telegramService,
+	}
+}
+
+func (s *orderCancelledConsumerService) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Order cancelled Kafka consumer running")
+
+	err := s.orderCancelledConsumer.Consume(ctx, s.OrderCancelledHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from order cancelled topic error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package order_cancelled_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	wrappedKafka "github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+func (s *orderCancelledConsumerService) OrderCancelledHandler(ctx context.Context, msg wrappedKafka.Message) error {
+	event, err := s.orderCancelledDecoder.Decode(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode OrderCancelled", zap.Error(err))
+		return err
+	}
+
+	err = s.telegramService.SendOrderCancelledNotification(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to send order cancelled notification", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Processing message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("user_uuid", event.UserUUID),
+		zap.String("reason", event.Reason),
+	)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package order_created_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/dexguitar/spacecraftory/notification/internal/converter/kafka"
+	"github.com/dexguitar/spacecraftory/notification/internal/service"
+	wrappedKafka "github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+type orderCreatedConsumerService struct {
+	orderCreatedConsumer wrappedKafka.Consumer
+	orderCreatedDecoder  kafkaConverter.OrderCreatedDecoder
+	telegramService      service.TelegramService
+}
+
+func NewService(orderCreatedConsumer wrappedKafka.Consumer, orderCreatedDecoder kafkaConverter.OrderCreatedDecoder, telegramService service.TelegramService) *orderCreatedConsumerService {
+	return &orderCreatedConsumerService{
+		orderCreatedConsumer: orderCreatedConsumer,
+		orderCreatedDecoder:  orderCreatedDecoder,
+		telegramService:      telegramService,
+	}
+}
+
+func (s *orderCreatedConsumerService) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Order created Kafka consumer running")
+
+	err := s.orderCreatedConsumer.Consume(ctx, s.OrderCreatedHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from order created topic error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
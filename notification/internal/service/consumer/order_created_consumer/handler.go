@@ -0,0 +1,39 @@
+package order_created_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	wrappedKafka "github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+func (s *orderCreatedConsumerService) OrderCreatedHandler(ctx context.Context, msg wrappedKafka.Message) error {
+	event, err := s.orderCreatedDecoder.Decode(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode OrderCreated", zap.Error(err))
+		return err
+	}
+
+	err = s.telegramService.SendOrderCreatedNotification(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to send order created notification", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Processing message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("user_uuid", event.UserUUID),
+		zap.Int("items", len(event.Items)),
+		zap.Int64("parts_count", event.PartsCount()),
+		zap.String("total_amount", event.TotalAmount),
+		zap.String("currency", event.Currency),
+	)
+
+	return nil
+}
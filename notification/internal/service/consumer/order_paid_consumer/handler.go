@@ -10,7 +10,7 @@ import (
 )
 
 func (s *orderPaidConsumerService) OrderPaidHandler(ctx context.Context, msg wrappedKafka.Message) error {
-	event, err := s.orderPaidDecoder.Decode(msg.Value)
+	event, err := s.orderPaidDecoder.Decode(msg)
 	if err != nil {
 		logger.Error(ctx, "Failed to decode OrderPaid", zap.Error(err))
 		return err
@@ -13,4 +13,7 @@ type ConsumerService interface {
 type TelegramService interface {
 	SendOrderPaidNotification(ctx context.Context, event model.OrderPaidEvent) error
 	SendOrderAssembledNotification(ctx context.Context, event model.OrderAssembledEvent) error
+	SendOrderCreatedNotification(ctx context.Context, event model.OrderCreatedEvent) error
+	SendOrderCancelledNotification(ctx context.Context, event model.OrderCancelledEvent) error
+	SendAssemblyFailedNotification(ctx context.Context, event model.AssemblyFailedEvent) error
 }
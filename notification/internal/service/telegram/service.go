@@ -17,6 +17,8 @@ import (
 const chatID = 2407852
 
 //go:embed templates/order_assembled_notification.tmpl templates/order_paid_notification.tmpl
+//go:embed templates/order_created_notification.tmpl templates/order_cancelled_notification.tmpl
+//go:embed templates/assembly_failed_notification.tmpl
 var templateFS embed.FS
 
 type orderPaidTemplateData struct {
@@ -24,6 +26,8 @@ type orderPaidTemplateData struct {
 	UserUUID        string
 	PaymentMethod   string
 	TransactionUUID string
+	Amount          string
+	Currency        string
 	RegisteredAt    time.Time
 }
 
@@ -34,9 +38,35 @@ type orderAssembledTemplateData struct {
 	RegisteredAt time.Time
 }
 
+type orderCreatedTemplateData struct {
+	OrderUUID    string
+	UserUUID     string
+	PartsCount   int64
+	TotalAmount  string
+	Currency     string
+	RegisteredAt time.Time
+}
+
+type orderCancelledTemplateData struct {
+	OrderUUID    string
+	UserUUID     string
+	Reason       string
+	RegisteredAt time.Time
+}
+
+type assemblyFailedTemplateData struct {
+	OrderUUID    string
+	UserUUID     string
+	Reason       string
+	RegisteredAt time.Time
+}
+
 var (
 	orderPaidTemplate      = template.Must(template.ParseFS(templateFS, "templates/order_paid_notification.tmpl"))
 	orderAssembledTemplate = template.Must(template.ParseFS(templateFS, "templates/order_assembled_notification.tmpl"))
+	orderCreatedTemplate   = template.Must(template.ParseFS(templateFS, "templates/order_created_notification.tmpl"))
+	orderCancelledTemplate = template.Must(template.ParseFS(templateFS, "templates/order_cancelled_notification.tmpl"))
+	assemblyFailedTemplate = template.Must(template.ParseFS(templateFS, "templates/assembly_failed_notification.tmpl"))
 )
 
 type service struct {
@@ -69,6 +99,53 @@ func (s *service) SendOrderAssembledNotification(ctx context.Context, event mode
 	return s.sendMessage(ctx, message)
 }
 
+// SendOrderCreatedNotification отправляет уведомление о создании заказа
+func (s *service) SendOrderCreatedNotification(ctx context.Context, event model.OrderCreatedEvent) error {
+	message, err := renderTemplate(orderCreatedTemplate, orderCreatedTemplateData{
+		OrderUUID:    event.OrderUUID,
+		UserUUID:     event.UserUUID,
+		PartsCount:   event.PartsCount(),
+		TotalAmount:  event.TotalAmount,
+		Currency:     event.Currency,
+		RegisteredAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.sendMessage(ctx, message)
+}
+
+// SendOrderCancelledNotification отправляет уведомление об отмене заказа
+func (s *service) SendOrderCancelledNotification(ctx context.Context, event model.OrderCancelledEvent) error {
+	message, err := renderTemplate(orderCancelledTemplate, orderCancelledTemplateData{
+		OrderUUID:    event.OrderUUID,
+		UserUUID:     event.UserUUID,
+		Reason:       event.Reason,
+		RegisteredAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.sendMessage(ctx, message)
+}
+
+// SendAssemblyFailedNotification отправляет уведомление о неудачной сборке корабля
+func (s *service) SendAssemblyFailedNotification(ctx context.Context, event model.AssemblyFailedEvent) error {
+	message, err := renderTemplate(assemblyFailedTemplate, assemblyFailedTemplateData{
+		OrderUUID:    event.OrderUUID,
+		UserUUID:     event.UserUUID,
+		Reason:       event.Reason,
+		RegisteredAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.sendMessage(ctx, message)
+}
+
 func (s *service) sendMessage(ctx context.Context, message string) error {
 	if err := s.telegramClient.SendMessage(ctx, chatID, message); err != nil {
 		return err
@@ -85,6 +162,8 @@ func (s *service) buildOrderPaidMessage(event model.OrderPaidEvent) (string, err
 		UserUUID:        event.UserUUID,
 		PaymentMethod:   event.PaymentMethod,
 		TransactionUUID: event.TransactionUUID,
+		Amount:          event.Amount,
+		Currency:        event.Currency,
 		RegisteredAt:    time.Now(),
 	}
 
@@ -114,3 +193,13 @@ func (s *service) buildOrderAssembledMessage(event model.OrderAssembledEvent) (s
 
 	return buf.String(), nil
 }
+
+// renderTemplate заполняет шаблон уведомления данными
+func renderTemplate(tmpl *template.Template, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
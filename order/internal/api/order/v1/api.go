@@ -2,8 +2,11 @@ package v1
 
 import (
 	"context"
+	"errors"
 
+	"github.com/dexguitar/spacecraftory/order/internal/model"
 	"github.com/dexguitar/spacecraftory/order/internal/service"
+	httpAuth "github.com/dexguitar/spacecraftory/platform/pkg/middleware/http"
 	orderV1 "github.com/dexguitar/spacecraftory/shared/pkg/openapi/order/v1"
 )
 
@@ -18,6 +21,16 @@ func NewAPI(orderService service.OrderService) orderV1.Handler {
 }
 
 func (a *api) NewError(ctx context.Context, err error) *orderV1.GenericErrorStatusCode {
+	if errors.Is(err, model.ErrUnauthenticated) {
+		return &orderV1.GenericErrorStatusCode{
+			StatusCode: 401,
+			Response: orderV1.GenericError{
+				Code:    401,
+				Message: "Authentication required",
+			},
+		}
+	}
+
 	return &orderV1.GenericErrorStatusCode{
 		StatusCode: 500,
 		Response: orderV1.GenericError{
@@ -26,3 +39,16 @@ func (a *api) NewError(ctx context.Context, err error) *orderV1.GenericErrorStat
 		},
 	}
 }
+
+// requesterFromContext builds the requester from the session user put into ctx by the auth middleware
+func requesterFromContext(ctx context.Context) (model.Requester, error) {
+	user, ok := httpAuth.GetUserFromContext(ctx)
+	if !ok || user.GetUuid() == "" {
+		return model.Requester{}, model.ErrUnauthenticated
+	}
+
+	return model.Requester{
+		UserUUID: user.GetUuid(),
+		IsAdmin:  httpAuth.HasRole(user, model.RoleAdmin),
+	}, nil
+}
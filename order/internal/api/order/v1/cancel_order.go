@@ -18,7 +18,12 @@ func (a *api) CancelOrder(ctx context.Context, params orderV1.CancelOrderParams)
 			Message: "Invalid order UUID",
 		}, nil
 	}
-	err = a.orderService.CancelOrder(ctx, params.OrderUUID.String())
+
+	requester, err := requesterFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = a.orderService.CancelOrder(ctx, requester, params.OrderUUID.String())
 	if err != nil {
 		if errors.Is(err, model.ErrOrderNotFound) {
 			return &orderV1.NotFoundError{
@@ -32,6 +37,12 @@ func (a *api) CancelOrder(ctx context.Context, params orderV1.CancelOrderParams)
 				Message: "Cannot cancel already paid or cancelled order",
 			}, nil
 		}
+		if errors.Is(err, model.ErrOrderConflict) {
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: "Order was modified concurrently, retry the request",
+			}, nil
+		}
 		return &orderV1.InternalServerError{
 			Code:    500,
 			Message: "Failed to cancel order",
@@ -13,7 +13,7 @@ import (
 func (s *APISuite) TestCancelOrderSuccess() {
 	orderUUID := uuid.New()
 
-	s.orderService.On("CancelOrder", s.ctx, orderUUID.String()).
+	s.orderService.On("CancelOrder", s.ctx, s.requester, orderUUID.String()).
 		Return(nil).Once()
 
 	params := orderV1.CancelOrderParams{
@@ -51,6 +51,14 @@ func (s *APISuite) TestCancelOrderError() {
 			expectedCode:     409,
 			expectedMessage:  "Cannot cancel already paid or cancelled order",
 		},
+		{
+			name:             "Concurrent modification",
+			orderUUID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174003"),
+			serviceError:     model.ErrOrderConflict,
+			expectedRespType: &orderV1.ConflictError{},
+			expectedCode:     409,
+			expectedMessage:  "Order was modified concurrently, retry the request",
+		},
 		{
 			name:             "Internal server error",
 			orderUUID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
@@ -63,7 +71,7 @@ func (s *APISuite) TestCancelOrderError() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			s.orderService.On("CancelOrder", s.ctx, tc.orderUUID.String()).
+			s.orderService.On("CancelOrder", s.ctx, s.requester, tc.orderUUID.String()).
 				Return(tc.serviceError).Once()
 
 			params := orderV1.CancelOrderParams{
@@ -6,17 +6,37 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/dexguitar/spacecraftory/order/internal/converter"
 	"github.com/dexguitar/spacecraftory/order/internal/model"
 	orderV1 "github.com/dexguitar/spacecraftory/shared/pkg/openapi/order/v1"
 )
 
-func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest) (orderV1.CreateOrderRes, error) {
-	partUUIDs := make([]string, 0, len(req.PartUuids))
-	for _, partUUID := range req.PartUuids {
-		partUUIDs = append(partUUIDs, partUUID.String())
+func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest, _ orderV1.CreateOrderParams) (orderV1.CreateOrderRes, error) {
+	requester, err := requesterFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// user_uuid is optional and may point to another user only for admins
+	userUUID := requester.UserUUID
+	if req.UserUUID.Set && req.UserUUID.Value.String() != requester.UserUUID {
+		if !requester.IsAdmin {
+			return &orderV1.ForbiddenError{
+				Code:    403,
+				Message: "Placing orders for another user is not allowed",
+			}, nil
+		}
+		userUUID = req.UserUUID.Value.String()
 	}
 
-	order, err := a.orderService.CreateOrder(ctx, req.UserUUID.String(), partUUIDs)
+	if len(req.Items) == 0 && len(req.PartUuids) == 0 {
+		return &orderV1.BadRequestError{
+			Code:    400,
+			Message: "Either items or part_uuids must be provided",
+		}, nil
+	}
+
+	order, err := a.orderService.CreateOrder(ctx, userUUID, converter.ToModelOrderItems(req.Items, req.PartUuids))
 	if err != nil {
 		if errors.Is(err, model.ErrBadRequest) || errors.Is(err, model.ErrPartsNotFound) {
 			return &orderV1.BadRequestError{
@@ -24,6 +44,12 @@ func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest)
 				Message: err.Error(),
 			}, nil
 		}
+		if errors.Is(err, model.ErrInsufficientStock) {
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: err.Error(),
+			}, nil
+		}
 		return &orderV1.InternalServerError{
 			Code:    500,
 			Message: "Failed to create order",
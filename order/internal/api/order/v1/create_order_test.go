@@ -1,26 +1,27 @@
 package v1
 
 import (
+	"context"
 	"errors"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/dexguitar/spacecraftory/order/internal/model"
+	grpcAuth "github.com/dexguitar/spacecraftory/platform/pkg/middleware/grpc"
 	orderV1 "github.com/dexguitar/spacecraftory/shared/pkg/openapi/order/v1"
+	commonV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/common/v1"
 )
 
 func (s *APISuite) TestCreateOrderSuccess() {
 	testCases := []struct {
 		name          string
-		userUUID      uuid.UUID
 		partUUIDs     []uuid.UUID
 		createdOrder  *model.Order
 		expectedPrice float64
 	}{
 		{
 			name:      "Single part order",
-			userUUID:  uuid.New(),
 			partUUIDs: []uuid.UUID{uuid.New()},
 			createdOrder: &model.Order{
 				OrderUUID:   uuid.New().String(),
@@ -33,7 +34,6 @@ func (s *APISuite) TestCreateOrderSuccess() {
 		},
 		{
 			name:      "Multiple parts order",
-			userUUID:  uuid.New(),
 			partUUIDs: []uuid.UUID{uuid.New(), uuid.New(), uuid.New()},
 			createdOrder: &model.Order{
 				OrderUUID:   uuid.New().String(),
@@ -48,20 +48,19 @@ func (s *APISuite) TestCreateOrderSuccess() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			partUUIDStrings := make([]string, len(tc.partUUIDs))
+			items := make([]model.OrderItem, len(tc.partUUIDs))
 			for i, partUUID := range tc.partUUIDs {
-				partUUIDStrings[i] = partUUID.String()
+				items[i] = model.OrderItem{PartUUID: partUUID.String(), Quantity: 1}
 			}
 
-			s.orderService.On("CreateOrder", s.ctx, tc.userUUID.String(), partUUIDStrings).
+			s.orderService.On("CreateOrder", s.ctx, s.requester.UserUUID, items).
 				Return(tc.createdOrder, nil).Once()
 
 			req := &orderV1.CreateOrderRequest{
-				UserUUID:  tc.userUUID,
 				PartUuids: tc.partUUIDs,
 			}
 
-			resp, err := s.api.CreateOrder(s.ctx, req)
+			resp, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
 
 			s.Require().NoError(err)
 			createResp, ok := resp.(*orderV1.CreateOrderResponse)
@@ -72,10 +71,40 @@ func (s *APISuite) TestCreateOrderSuccess() {
 	}
 }
 
+func (s *APISuite) TestCreateOrderWithItems() {
+	wingPanel := uuid.New()
+	engine := uuid.New()
+	createdOrder := &model.Order{
+		OrderUUID:   uuid.New().String(),
+		UserUUID:    s.requester.UserUUID,
+		TotalPrice:  300.00,
+		OrderStatus: model.OrderStatusPENDINGPAYMENT,
+	}
+
+	// legacy part_uuids are appended to items as single units
+	expectedItems := []model.OrderItem{
+		{PartUUID: wingPanel.String(), Quantity: 2},
+		{PartUUID: engine.String(), Quantity: 1},
+	}
+	s.orderService.On("CreateOrder", s.ctx, s.requester.UserUUID, expectedItems).
+		Return(createdOrder, nil).Once()
+
+	req := &orderV1.CreateOrderRequest{
+		Items:     []orderV1.OrderItem{{PartUUID: wingPanel, Quantity: 2}},
+		PartUuids: []uuid.UUID{engine},
+	}
+
+	resp, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
+
+	s.Require().NoError(err)
+	createResp, ok := resp.(*orderV1.CreateOrderResponse)
+	s.Require().True(ok, "response should be CreateOrderResponse")
+	assert.Equal(s.T(), createdOrder.TotalPrice, createResp.GetTotalPrice())
+}
+
 func (s *APISuite) TestCreateOrderError() {
 	testCases := []struct {
 		name             string
-		userUUID         uuid.UUID
 		partUUIDs        []uuid.UUID
 		serviceError     error
 		serviceOrder     *model.Order
@@ -85,8 +114,14 @@ func (s *APISuite) TestCreateOrderError() {
 	}{
 		{
 			name:             "Empty part UUIDs - bad request",
-			userUUID:         uuid.New(),
 			partUUIDs:        []uuid.UUID{},
+			expectedRespType: &orderV1.BadRequestError{},
+			expectedCode:     400,
+			expectedMessage:  "Either items or part_uuids must be provided",
+		},
+		{
+			name:             "Service bad request",
+			partUUIDs:        []uuid.UUID{uuid.New()},
 			serviceError:     model.ErrBadRequest,
 			expectedRespType: &orderV1.BadRequestError{},
 			expectedCode:     400,
@@ -94,16 +129,22 @@ func (s *APISuite) TestCreateOrderError() {
 		},
 		{
 			name:             "Parts not found",
-			userUUID:         uuid.New(),
 			partUUIDs:        []uuid.UUID{uuid.New(), uuid.New()},
 			serviceError:     model.ErrPartsNotFound,
 			expectedRespType: &orderV1.BadRequestError{},
 			expectedCode:     400,
 			expectedMessage:  model.ErrPartsNotFound.Error(),
 		},
+		{
+			name:             "Insufficient stock",
+			partUUIDs:        []uuid.UUID{uuid.New()},
+			serviceError:     model.ErrInsufficientStock,
+			expectedRespType: &orderV1.ConflictError{},
+			expectedCode:     409,
+			expectedMessage:  model.ErrInsufficientStock.Error(),
+		},
 		{
 			name:             "Service internal error",
-			userUUID:         uuid.New(),
 			partUUIDs:        []uuid.UUID{uuid.New()},
 			serviceError:     errors.New("database connection failed"),
 			expectedRespType: &orderV1.InternalServerError{},
@@ -112,7 +153,6 @@ func (s *APISuite) TestCreateOrderError() {
 		},
 		{
 			name:      "Invalid order UUID from service",
-			userUUID:  uuid.New(),
 			partUUIDs: []uuid.UUID{uuid.New()},
 			serviceOrder: &model.Order{
 				OrderUUID:   "invalid-uuid-format",
@@ -129,25 +169,24 @@ func (s *APISuite) TestCreateOrderError() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			partUUIDStrings := make([]string, len(tc.partUUIDs))
+			items := make([]model.OrderItem, len(tc.partUUIDs))
 			for i, partUUID := range tc.partUUIDs {
-				partUUIDStrings[i] = partUUID.String()
+				items[i] = model.OrderItem{PartUUID: partUUID.String(), Quantity: 1}
 			}
 
 			if tc.serviceError != nil {
-				s.orderService.On("CreateOrder", s.ctx, tc.userUUID.String(), partUUIDStrings).
+				s.orderService.On("CreateOrder", s.ctx, s.requester.UserUUID, items).
 					Return(nil, tc.serviceError).Once()
 			} else if tc.serviceOrder != nil {
-				s.orderService.On("CreateOrder", s.ctx, tc.userUUID.String(), partUUIDStrings).
+				s.orderService.On("CreateOrder", s.ctx, s.requester.UserUUID, items).
 					Return(tc.serviceOrder, nil).Once()
 			}
 
 			req := &orderV1.CreateOrderRequest{
-				UserUUID:  tc.userUUID,
 				PartUuids: tc.partUUIDs,
 			}
 
-			resp, err := s.api.CreateOrder(s.ctx, req)
+			resp, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
 
 			s.Require().NoError(err)
 
@@ -157,6 +196,11 @@ func (s *APISuite) TestCreateOrderError() {
 				s.Require().True(ok, "response should be BadRequestError")
 				assert.Equal(s.T(), tc.expectedCode, badRequestErr.Code)
 				assert.Equal(s.T(), tc.expectedMessage, badRequestErr.Message)
+			case *orderV1.ConflictError:
+				conflictErr, ok := resp.(*orderV1.ConflictError)
+				s.Require().True(ok, "response should be ConflictError")
+				assert.Equal(s.T(), tc.expectedCode, conflictErr.Code)
+				assert.Equal(s.T(), tc.expectedMessage, conflictErr.Message)
 			case *orderV1.InternalServerError:
 				internalErr, ok := resp.(*orderV1.InternalServerError)
 				s.Require().True(ok, "response should be InternalServerError")
@@ -168,3 +212,55 @@ func (s *APISuite) TestCreateOrderError() {
 		})
 	}
 }
+
+func (s *APISuite) TestCreateOrderForAnotherUser() {
+	otherUserUUID := uuid.New()
+	partUUIDs := []uuid.UUID{uuid.New()}
+	req := &orderV1.CreateOrderRequest{
+		UserUUID:  orderV1.NewOptUUID(otherUserUUID),
+		PartUuids: partUUIDs,
+	}
+
+	s.Run("Forbidden for regular user", func() {
+		resp, err := s.api.CreateOrder(s.ctx, req, orderV1.CreateOrderParams{})
+
+		s.Require().NoError(err)
+		forbiddenErr, ok := resp.(*orderV1.ForbiddenError)
+		s.Require().True(ok, "response should be ForbiddenError")
+		assert.Equal(s.T(), 403, forbiddenErr.Code)
+	})
+
+	s.Run("Allowed for admin", func() {
+		adminCtx := grpcAuth.AddUserToContext(s.ctx, &commonV1.User{
+			Uuid:  s.requester.UserUUID,
+			Roles: []string{model.RoleAdmin},
+		})
+		createdOrder := &model.Order{
+			OrderUUID:   uuid.New().String(),
+			UserUUID:    otherUserUUID.String(),
+			TotalPrice:  100.00,
+			OrderStatus: model.OrderStatusPENDINGPAYMENT,
+		}
+
+		s.orderService.On("CreateOrder", adminCtx, otherUserUUID.String(), []model.OrderItem{{PartUUID: partUUIDs[0].String(), Quantity: 1}}).
+			Return(createdOrder, nil).Once()
+
+		resp, err := s.api.CreateOrder(adminCtx, req, orderV1.CreateOrderParams{})
+
+		s.Require().NoError(err)
+		createResp, ok := resp.(*orderV1.CreateOrderResponse)
+		s.Require().True(ok, "response should be CreateOrderResponse")
+		assert.Equal(s.T(), createdOrder.OrderUUID, createResp.GetOrderUUID().String())
+	})
+}
+
+func (s *APISuite) TestCreateOrderUnauthenticated() {
+	req := &orderV1.CreateOrderRequest{
+		PartUuids: []uuid.UUID{uuid.New()},
+	}
+
+	resp, err := s.api.CreateOrder(context.Background(), req, orderV1.CreateOrderParams{})
+
+	assert.ErrorIs(s.T(), err, model.ErrUnauthenticated)
+	assert.Nil(s.T(), resp)
+}
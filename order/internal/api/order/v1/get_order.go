@@ -19,7 +19,12 @@ func (a *api) GetOrderByUUID(ctx context.Context, params orderV1.GetOrderByUUIDP
 			Message: "Invalid order UUID",
 		}, nil
 	}
-	order, err := a.orderService.GetOrder(ctx, params.OrderUUID.String())
+
+	requester, err := requesterFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	order, err := a.orderService.GetOrder(ctx, requester, params.OrderUUID.String())
 	if err != nil {
 		if errors.Is(err, model.ErrOrderNotFound) {
 			return &orderV1.NotFoundError{
@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	orderV1 "github.com/dexguitar/spacecraftory/shared/pkg/openapi/order/v1"
+)
+
+func (s *APISuite) TestGetOrderHistorySuccess() {
+	orderUUID := uuid.New()
+	userUUID := uuid.NewString()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	paidAt := createdAt.Add(time.Minute)
+
+	history := []*model.OrderStatusTransition{
+		{
+			OrderUUID: orderUUID.String(),
+			To:        model.OrderStatusPENDINGPAYMENT,
+			Actor:     userUUID,
+			Reason:    model.StatusReasonCreated,
+			CreatedAt: createdAt,
+		},
+		{
+			OrderUUID: orderUUID.String(),
+			From:      model.OrderStatusPENDINGPAYMENT,
+			To:        model.OrderStatusPAID,
+			Actor:     userUUID,
+			Reason:    model.StatusReasonPaid,
+			CreatedAt: paidAt,
+		},
+	}
+
+	s.orderService.On("GetOrderHistory", s.ctx, s.requester, orderUUID.String()).
+		Return(history, nil).Once()
+
+	resp, err := s.api.GetOrderHistory(s.ctx, orderV1.GetOrderHistoryParams{OrderUUID: orderUUID})
+
+	s.Require().NoError(err)
+
+	historyResp, ok := resp.(*orderV1.OrderStatusHistoryResponse)
+	s.Require().True(ok, "response should be OrderStatusHistoryResponse")
+	s.Require().Len(historyResp.GetTransitions(), 2)
+
+	// the initial status has no previous one
+	assert.Equal(s.T(), orderV1.OrderStatusTransition{
+		ToStatus:  orderV1.OrderStatusPENDINGPAYMENT,
+		Actor:     userUUID,
+		Reason:    model.StatusReasonCreated,
+		CreatedAt: createdAt,
+	}, historyResp.GetTransitions()[0])
+	assert.Equal(s.T(), orderV1.OrderStatusTransition{
+		FromStatus: orderV1.NewOptOrderStatus(orderV1.OrderStatusPENDINGPAYMENT),
+		ToStatus:   orderV1.OrderStatusPAID,
+		Actor:      userUUID,
+		Reason:     model.StatusReasonPaid,
+		CreatedAt:  paidAt,
+	}, historyResp.GetTransitions()[1])
+}
+
+func (s *APISuite) TestGetOrderHistoryError() {
+	testCases := []struct {
+		name            string
+		serviceError    error
+		expectedCode    int
+		expectedMessage string
+	}{
+		{
+			name:            "Order not found",
+			serviceError:    model.ErrOrderNotFound,
+			expectedCode:    404,
+			expectedMessage: "Order not found",
+		},
+		{
+			name:            "Service internal error",
+			serviceError:    errors.New("database connection failed"),
+			expectedCode:    500,
+			expectedMessage: "Failed to get order history",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			orderUUID := uuid.New()
+			s.orderService.On("GetOrderHistory", s.ctx, s.requester, orderUUID.String()).
+				Return(nil, tc.serviceError).Once()
+
+			resp, err := s.api.GetOrderHistory(s.ctx, orderV1.GetOrderHistoryParams{OrderUUID: orderUUID})
+
+			s.Require().NoError(err)
+
+			switch r := resp.(type) {
+			case *orderV1.NotFoundError:
+				assert.Equal(s.T(), tc.expectedCode, r.Code)
+				assert.Equal(s.T(), tc.expectedMessage, r.Message)
+			case *orderV1.InternalServerError:
+				assert.Equal(s.T(), tc.expectedCode, r.Code)
+				assert.Equal(s.T(), tc.expectedMessage, r.Message)
+			default:
+				s.Fail("unexpected response type")
+			}
+		})
+	}
+}
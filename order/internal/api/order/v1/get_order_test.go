@@ -12,16 +12,22 @@ import (
 
 func (s *APISuite) TestGetOrderByUUIDSuccess() {
 	orderUUID := uuid.New()
+	wingPanel := uuid.New()
+	legacyPart := uuid.New()
 
 	serviceOrder := &model.Order{
-		OrderUUID:   orderUUID.String(),
-		UserUUID:    uuid.New().String(),
-		PartUUIDs:   []string{uuid.New().String(), uuid.New().String()},
+		OrderUUID: orderUUID.String(),
+		UserUUID:  uuid.New().String(),
+		PartUUIDs: []string{wingPanel.String(), legacyPart.String()},
+		Items: []model.OrderItem{
+			{PartUUID: wingPanel.String(), Quantity: 2, UnitPrice: 100.25, Name: "Wing Panel", Category: model.PartCategoryWing},
+			{PartUUID: legacyPart.String(), Quantity: 1},
+		},
 		TotalPrice:  250.75,
 		OrderStatus: model.OrderStatusPENDINGPAYMENT,
 	}
 
-	s.orderService.On("GetOrder", s.ctx, orderUUID.String()).
+	s.orderService.On("GetOrder", s.ctx, s.requester, orderUUID.String()).
 		Return(serviceOrder, nil).Once()
 
 	params := orderV1.GetOrderByUUIDParams{
@@ -38,6 +44,17 @@ func (s *APISuite) TestGetOrderByUUIDSuccess() {
 	assert.Equal(s.T(), orderUUID, getResp.GetOrderUUID())
 	assert.Equal(s.T(), serviceOrder.TotalPrice, getResp.GetTotalPrice())
 	assert.Equal(s.T(), orderV1.OrderStatusPENDINGPAYMENT, getResp.GetStatus())
+
+	s.Require().Len(getResp.GetItems(), 2)
+	assert.Equal(s.T(), orderV1.OrderLine{
+		PartUUID:  wingPanel,
+		Quantity:  2,
+		UnitPrice: orderV1.NewOptFloat64(100.25),
+		Name:      orderV1.NewOptString("Wing Panel"),
+		Category:  orderV1.NewOptPartCategory(orderV1.PartCategoryWING),
+	}, getResp.GetItems()[0])
+	// orders created before snapshots were stored have no snapshot fields
+	assert.Equal(s.T(), orderV1.OrderLine{PartUUID: legacyPart, Quantity: 1}, getResp.GetItems()[1])
 }
 
 func (s *APISuite) TestGetOrderByUUIDError() {
@@ -69,7 +86,7 @@ func (s *APISuite) TestGetOrderByUUIDError() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			s.orderService.On("GetOrder", s.ctx, tc.orderUUID.String()).
+			s.orderService.On("GetOrder", s.ctx, s.requester, tc.orderUUID.String()).
 				Return(nil, tc.serviceError).Once()
 
 			params := orderV1.GetOrderByUUIDParams{
@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dexguitar/spacecraftory/order/internal/converter"
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	orderV1 "github.com/dexguitar/spacecraftory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) ListOrders(ctx context.Context, params orderV1.ListOrdersParams) (orderV1.ListOrdersRes, error) {
+	requester, err := requesterFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// orders of other users are visible only to admins
+	if !requester.IsAdmin && (params.AllUsers.Or(false) ||
+		params.UserUUID.Set && params.UserUUID.Value.String() != requester.UserUUID) {
+		return &orderV1.ForbiddenError{
+			Code:    403,
+			Message: "Listing orders of other users is not allowed",
+		}, nil
+	}
+
+	filter := model.OrderFilter{
+		Limit: params.Limit.Or(0),
+	}
+
+	switch {
+	case params.AllUsers.Or(false):
+		filter.UserUUID = nil
+	case params.UserUUID.Set:
+		userUUID := params.UserUUID.Value.String()
+		filter.UserUUID = &userUUID
+	default:
+		filter.UserUUID = &requester.UserUUID
+	}
+
+	for _, status := range params.Status {
+		filter.Statuses = append(filter.Statuses, converter.ToModelStatus(status))
+	}
+	for _, method := range params.PaymentMethod {
+		filter.PaymentMethods = append(filter.PaymentMethods, converter.ToModelPaymentMethod(method))
+	}
+	if params.CreatedFrom.Set {
+		createdFrom := params.CreatedFrom.Value.UTC()
+		filter.CreatedFrom = &createdFrom
+	}
+	if params.CreatedTo.Set {
+		createdTo := params.CreatedTo.Value.UTC()
+		filter.CreatedTo = &createdTo
+	}
+	if params.Cursor.Set {
+		filter.After, err = converter.DecodeOrderCursor(params.Cursor.Value)
+		if err != nil {
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: "Invalid cursor",
+			}, nil
+		}
+	}
+
+	page, err := a.orderService.ListOrders(ctx, requester, filter)
+	if err != nil {
+		if errors.Is(err, model.ErrBadRequest) {
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, model.ErrForbidden) {
+			return &orderV1.ForbiddenError{
+				Code:    403,
+				Message: "Listing orders of other users is not allowed",
+			}, nil
+		}
+		return &orderV1.InternalServerError{
+			Code:    500,
+			Message: "Failed to list orders",
+		}, nil
+	}
+
+	res := &orderV1.ListOrdersResponse{
+		Orders: make([]orderV1.OrderDto, 0, len(page.Orders)),
+	}
+	for _, order := range page.Orders {
+		if dto := converter.ToDtoOrder(order); dto != nil {
+			res.Orders = append(res.Orders, *dto)
+		}
+	}
+	if page.Next != nil {
+		res.NextCursor = orderV1.NewOptString(converter.EncodeOrderCursor(page.Next))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/order/internal/converter"
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	orderV1 "github.com/dexguitar/spacecraftory/shared/pkg/openapi/order/v1"
+)
+
+func (s *APISuite) TestListOrdersSuccess() {
+	createdAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	serviceOrder := &model.Order{
+		OrderUUID:   uuid.New().String(),
+		UserUUID:    s.requester.UserUUID,
+		PartUUIDs:   []string{uuid.New().String()},
+		TotalPrice:  100,
+		OrderStatus: model.OrderStatusPAID,
+		CreatedAt:   createdAt,
+	}
+	next := &model.OrderCursor{CreatedAt: createdAt, OrderUUID: serviceOrder.OrderUUID}
+	after := &model.OrderCursor{CreatedAt: createdAt.Add(time.Hour), OrderUUID: uuid.New().String()}
+
+	s.orderService.On("ListOrders", s.ctx, s.requester, mock.MatchedBy(func(filter model.OrderFilter) bool {
+		return filter.UserUUID != nil && *filter.UserUUID == s.requester.UserUUID &&
+			assert.ObjectsAreEqual([]model.OrderStatus{model.OrderStatusPAID}, filter.Statuses) &&
+			filter.Limit == 1 &&
+			filter.After != nil && filter.After.OrderUUID == after.OrderUUID && filter.After.CreatedAt.Equal(after.CreatedAt)
+	})).Return(&model.OrderPage{Orders: []*model.Order{serviceOrder}, Next: next}, nil).Once()
+
+	resp, err := s.api.ListOrders(s.ctx, orderV1.ListOrdersParams{
+		Status: []orderV1.OrderStatus{orderV1.OrderStatusPAID},
+		Limit:  orderV1.NewOptInt(1),
+		Cursor: orderV1.NewOptString(converter.EncodeOrderCursor(after)),
+	})
+
+	s.Require().NoError(err)
+
+	listResp, ok := resp.(*orderV1.ListOrdersResponse)
+	s.Require().True(ok, "response should be ListOrdersResponse")
+	s.Require().Len(listResp.Orders, 1)
+	assert.Equal(s.T(), serviceOrder.OrderUUID, listResp.Orders[0].OrderUUID.String())
+	assert.Equal(s.T(), createdAt, listResp.Orders[0].CreatedAt.Value)
+
+	nextToken, ok := listResp.NextCursor.Get()
+	s.Require().True(ok, "next cursor should be set")
+	decoded, err := converter.DecodeOrderCursor(nextToken)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), next.OrderUUID, decoded.OrderUUID)
+	assert.True(s.T(), next.CreatedAt.Equal(decoded.CreatedAt))
+}
+
+func (s *APISuite) TestListOrdersError() {
+	testCases := []struct {
+		name             string
+		params           orderV1.ListOrdersParams
+		serviceError     error
+		expectedRespType any
+		expectedCode     int
+	}{
+		{
+			name:             "All users requested by non-admin",
+			params:           orderV1.ListOrdersParams{AllUsers: orderV1.NewOptBool(true)},
+			expectedRespType: &orderV1.ForbiddenError{},
+			expectedCode:     403,
+		},
+		{
+			name:             "Another user requested by non-admin",
+			params:           orderV1.ListOrdersParams{UserUUID: orderV1.NewOptUUID(uuid.New())},
+			expectedRespType: &orderV1.ForbiddenError{},
+			expectedCode:     403,
+		},
+		{
+			name:             "Invalid cursor",
+			params:           orderV1.ListOrdersParams{Cursor: orderV1.NewOptString("not a cursor")},
+			expectedRespType: &orderV1.BadRequestError{},
+			expectedCode:     400,
+		},
+		{
+			name:             "Service bad request",
+			serviceError:     model.ErrBadRequest,
+			expectedRespType: &orderV1.BadRequestError{},
+			expectedCode:     400,
+		},
+		{
+			name:             "Service internal error",
+			serviceError:     errors.New("database connection failed"),
+			expectedRespType: &orderV1.InternalServerError{},
+			expectedCode:     500,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.serviceError != nil {
+				s.orderService.On("ListOrders", s.ctx, s.requester, mock.Anything).
+					Return(nil, tc.serviceError).Once()
+			}
+
+			resp, err := s.api.ListOrders(s.ctx, tc.params)
+
+			s.Require().NoError(err)
+
+			switch tc.expectedRespType.(type) {
+			case *orderV1.ForbiddenError:
+				forbiddenErr, ok := resp.(*orderV1.ForbiddenError)
+				s.Require().True(ok, "response should be ForbiddenError")
+				assert.Equal(s.T(), tc.expectedCode, forbiddenErr.Code)
+			case *orderV1.BadRequestError:
+				badRequestErr, ok := resp.(*orderV1.BadRequestError)
+				s.Require().True(ok, "response should be BadRequestError")
+				assert.Equal(s.T(), tc.expectedCode, badRequestErr.Code)
+			case *orderV1.InternalServerError:
+				internalErr, ok := resp.(*orderV1.InternalServerError)
+				s.Require().True(ok, "response should be InternalServerError")
+				assert.Equal(s.T(), tc.expectedCode, internalErr.Code)
+			default:
+				s.Fail("unexpected response type")
+			}
+		})
+	}
+}
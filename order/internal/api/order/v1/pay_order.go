@@ -19,9 +19,15 @@ func (a *api) PayOrder(ctx context.Context, req *orderV1.PayOrderRequest, params
 			Message: "Invalid order UUID",
 		}, nil
 	}
+
+	requester, err := requesterFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	paymentMethod := converter.ToModelPaymentMethod(req.PaymentMethod)
 
-	transactionUUID, err := a.orderService.PayOrder(ctx, params.OrderUUID.String(), paymentMethod)
+	transactionUUID, err := a.orderService.PayOrder(ctx, requester, params.OrderUUID.String(), paymentMethod)
 	if err != nil {
 		if errors.Is(err, model.ErrOrderNotFound) {
 			return &orderV1.NotFoundError{
@@ -35,6 +41,60 @@ func (a *api) PayOrder(ctx context.Context, req *orderV1.PayOrderRequest, params
 				Message: "Order has already been paid or cancelled",
 			}, nil
 		}
+		if errors.Is(err, model.ErrOrderConflict) {
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: "Order was modified concurrently, retry the request",
+			}, nil
+		}
+		if errors.Is(err, model.ErrReservationNotFound) {
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: "Reserved parts are no longer held for the order, retry the request",
+			}, nil
+		}
+		if errors.Is(err, model.ErrInsufficientStock) {
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: "Not enough parts in stock to pay the order, create a new order",
+			}, nil
+		}
+		if errors.Is(err, model.ErrPartsNotFound) {
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: "Ordered parts are no longer available, create a new order",
+			}, nil
+		}
+		if errors.Is(err, model.ErrPaymentAlreadyExists) {
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: "Order was already paid with different details",
+			}, nil
+		}
+		if errors.Is(err, model.ErrPaymentLimitExceeded) {
+			return &orderV1.ValidationError{
+				Code:    422,
+				Message: "Order total exceeds the limit of the payment method",
+			}, nil
+		}
+		if errors.Is(err, model.ErrPaymentDeclined) {
+			return &orderV1.PaymentRequiredError{
+				Code:    402,
+				Message: "Payment was declined, use another payment method",
+			}, nil
+		}
+		if errors.Is(err, model.ErrPaymentPending) {
+			return &orderV1.ServiceUnavailableError{
+				Code:    503,
+				Message: "Payment is awaiting confirmation, retry the request",
+			}, nil
+		}
+		if errors.Is(err, model.ErrPaymentTimeout) {
+			return &orderV1.GatewayTimeoutError{
+				Code:    504,
+				Message: "Payment provider did not respond in time, retry the request",
+			}, nil
+		}
 		return &orderV1.InternalServerError{
 			Code:    500,
 			Message: "Failed to process payment",
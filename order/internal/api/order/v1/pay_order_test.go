@@ -54,7 +54,7 @@ func (s *APISuite) TestPayOrderSuccess() {
 		s.Run(tc.name, func() {
 			servicePaymentMethod := converter.ToModelPaymentMethod(tc.paymentMethod)
 
-			s.orderService.On("PayOrder", s.ctx, tc.orderUUID.String(), servicePaymentMethod).
+			s.orderService.On("PayOrder", s.ctx, s.requester, tc.orderUUID.String(), servicePaymentMethod).
 				Return(tc.transactionUUID, nil).Once()
 
 			req := &orderV1.PayOrderRequest{
@@ -106,6 +106,78 @@ func (s *APISuite) TestPayOrderError() {
 			expectedCode:     409,
 			expectedMessage:  "Order has already been paid or cancelled",
 		},
+		{
+			name:             "Concurrent modification",
+			orderUUID:        uuid.New(),
+			paymentMethod:    orderV1.PaymentMethodCARD,
+			serviceError:     model.ErrOrderConflict,
+			expectedRespType: &orderV1.ConflictError{},
+			expectedCode:     409,
+			expectedMessage:  "Order was modified concurrently, retry the request",
+		},
+		{
+			name:             "Reservation expired",
+			orderUUID:        uuid.New(),
+			paymentMethod:    orderV1.PaymentMethodCARD,
+			serviceError:     model.ErrReservationNotFound,
+			expectedRespType: &orderV1.ConflictError{},
+			expectedCode:     409,
+			expectedMessage:  "Reserved parts are no longer held for the order, retry the request",
+		},
+		{
+			name:             "Parts sold out after the reservation was gone",
+			orderUUID:        uuid.New(),
+			paymentMethod:    orderV1.PaymentMethodCARD,
+			serviceError:     model.ErrInsufficientStock,
+			expectedRespType: &orderV1.ConflictError{},
+			expectedCode:     409,
+			expectedMessage:  "Not enough parts in stock to pay the order, create a new order",
+		},
+		{
+			name:             "Payment method limit exceeded",
+			orderUUID:        uuid.New(),
+			paymentMethod:    orderV1.PaymentMethodCREDITCARD,
+			serviceError:     model.ErrPaymentLimitExceeded,
+			expectedRespType: &orderV1.ValidationError{},
+			expectedCode:     422,
+			expectedMessage:  "Order total exceeds the limit of the payment method",
+		},
+		{
+			name:             "Payment declined",
+			orderUUID:        uuid.New(),
+			paymentMethod:    orderV1.PaymentMethodCARD,
+			serviceError:     model.ErrPaymentDeclined,
+			expectedRespType: &orderV1.PaymentRequiredError{},
+			expectedCode:     402,
+			expectedMessage:  "Payment was declined, use another payment method",
+		},
+		{
+			name:             "Payment awaiting confirmation",
+			orderUUID:        uuid.New(),
+			paymentMethod:    orderV1.PaymentMethodSBP,
+			serviceError:     model.ErrPaymentPending,
+			expectedRespType: &orderV1.ServiceUnavailableError{},
+			expectedCode:     503,
+			expectedMessage:  "Payment is awaiting confirmation, retry the request",
+		},
+		{
+			name:             "Payment provider timeout",
+			orderUUID:        uuid.New(),
+			paymentMethod:    orderV1.PaymentMethodCARD,
+			serviceError:     model.ErrPaymentTimeout,
+			expectedRespType: &orderV1.GatewayTimeoutError{},
+			expectedCode:     504,
+			expectedMessage:  "Payment provider did not respond in time, retry the request",
+		},
+		{
+			name:             "Order already paid with different details",
+			orderUUID:        uuid.New(),
+			paymentMethod:    orderV1.PaymentMethodSBP,
+			serviceError:     model.ErrPaymentAlreadyExists,
+			expectedRespType: &orderV1.ConflictError{},
+			expectedCode:     409,
+			expectedMessage:  "Order was already paid with different details",
+		},
 		{
 			name:             "Service internal error",
 			orderUUID:        uuid.New(),
@@ -122,10 +194,10 @@ func (s *APISuite) TestPayOrderError() {
 			servicePaymentMethod := converter.ToModelPaymentMethod(tc.paymentMethod)
 
 			if tc.serviceError != nil {
-				s.orderService.On("PayOrder", s.ctx, tc.orderUUID.String(), servicePaymentMethod).
+				s.orderService.On("PayOrder", s.ctx, s.requester, tc.orderUUID.String(), servicePaymentMethod).
 					Return("", tc.serviceError).Once()
 			} else if tc.serviceTxnUUID != "" {
-				s.orderService.On("PayOrder", s.ctx, tc.orderUUID.String(), servicePaymentMethod).
+				s.orderService.On("PayOrder", s.ctx, s.requester, tc.orderUUID.String(), servicePaymentMethod).
 					Return(tc.serviceTxnUUID, nil).Once()
 			}
 
@@ -151,6 +223,26 @@ func (s *APISuite) TestPayOrderError() {
 				s.Require().True(ok, "response should be ConflictError")
 				assert.Equal(s.T(), tc.expectedCode, conflictErr.Code)
 				assert.Equal(s.T(), tc.expectedMessage, conflictErr.Message)
+			case *orderV1.ValidationError:
+				validationErr, ok := resp.(*orderV1.ValidationError)
+				s.Require().True(ok, "response should be ValidationError")
+				assert.Equal(s.T(), tc.expectedCode, validationErr.Code)
+				assert.Equal(s.T(), tc.expectedMessage, validationErr.Message)
+			case *orderV1.PaymentRequiredError:
+				paymentErr, ok := resp.(*orderV1.PaymentRequiredError)
+				s.Require().True(ok, "response should be PaymentRequiredError")
+				assert.Equal(s.T(), tc.expectedCode, paymentErr.Code)
+				assert.Equal(s.T(), tc.expectedMessage, paymentErr.Message)
+			case *orderV1.ServiceUnavailableError:
+				unavailableErr, ok := resp.(*orderV1.ServiceUnavailableError)
+				s.Require().True(ok, "response should be ServiceUnavailableError")
+				assert.Equal(s.T(), tc.expectedCode, unavailableErr.Code)
+				assert.Equal(s.T(), tc.expectedMessage, unavailableErr.Message)
+			case *orderV1.GatewayTimeoutError:
+				timeoutErr, ok := resp.(*orderV1.GatewayTimeoutError)
+				s.Require().True(ok, "response should be GatewayTimeoutError")
+				assert.Equal(s.T(), tc.expectedCode, timeoutErr.Code)
+				assert.Equal(s.T(), tc.expectedMessage, timeoutErr.Message)
 			case *orderV1.InternalServerError:
 				internalErr, ok := resp.(*orderV1.InternalServerError)
 				s.Require().True(ok, "response should be InternalServerError")
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/dexguitar/spacecraftory/order/internal/converter"
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	orderV1 "github.com/dexguitar/spacecraftory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) RefundOrder(ctx context.Context, req orderV1.OptRefundOrderRequest, params orderV1.RefundOrderParams) (orderV1.RefundOrderRes, error) {
+	_, err := uuid.Parse(params.OrderUUID.String())
+	if err != nil {
+		return &orderV1.BadRequestError{
+			Code:    400,
+			Message: "Invalid order UUID",
+		}, nil
+	}
+
+	// without an amount everything left on the payment is refunded
+	amount := decimal.Zero
+	if value, ok := req.Value.Amount.Get(); ok {
+		amount, err = decimal.NewFromString(value)
+		if err != nil || !amount.IsPositive() {
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: "Invalid refund amount",
+			}, nil
+		}
+	}
+
+	requester, err := requesterFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := a.orderService.RefundOrder(ctx, requester, params.OrderUUID.String(), amount, req.Value.Reason.Or(""))
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			return &orderV1.NotFoundError{
+				Code:    404,
+				Message: "Order not found",
+			}, nil
+		}
+		if errors.Is(err, model.ErrInvalidOrderStatus) {
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: "Only paid orders that are not assembled yet can be refunded",
+			}, nil
+		}
+		if errors.Is(err, model.ErrOrderConflict) {
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: "Order was modified concurrently, retry the request",
+			}, nil
+		}
+		if errors.Is(err, model.ErrRefundExceedsPayment) {
+			return &orderV1.ValidationError{
+				Code:    422,
+				Message: "Refund exceeds the amount left on the payment",
+			}, nil
+		}
+		return &orderV1.InternalServerError{
+			Code:    500,
+			Message: "Failed to refund order",
+		}, nil
+	}
+
+	return converter.ToDtoRefund(refund), nil
+}
@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	orderV1 "github.com/dexguitar/spacecraftory/shared/pkg/openapi/order/v1"
+)
+
+func (s *APISuite) TestRefundOrderSuccess() {
+	refundUUID := uuid.New()
+
+	testCases := []struct {
+		name           string
+		request        orderV1.OptRefundOrderRequest
+		expectedAmount decimal.Decimal
+		expectedReason string
+		refund         *model.Refund
+		expectedStatus orderV1.OrderStatus
+	}{
+		{
+			name:           "Without a body everything is refunded",
+			expectedAmount: decimal.Zero,
+			refund: &model.Refund{
+				RefundUUID:      refundUUID.String(),
+				Amount:          decimal.NewFromInt(1000),
+				Currency:        model.CurrencyRUB,
+				PaymentRefunded: true,
+			},
+			expectedStatus: orderV1.OrderStatusREFUNDED,
+		},
+		{
+			name: "Partial refund with a reason",
+			request: orderV1.NewOptRefundOrderRequest(orderV1.RefundOrderRequest{
+				Amount: orderV1.NewOptString("900.50"),
+				Reason: orderV1.NewOptString("restocking fee withheld"),
+			}),
+			expectedAmount: decimal.RequireFromString("900.50"),
+			expectedReason: "restocking fee withheld",
+			refund: &model.Refund{
+				RefundUUID: refundUUID.String(),
+				Amount:     decimal.RequireFromString("900.50"),
+				Currency:   model.CurrencyRUB,
+			},
+			expectedStatus: orderV1.OrderStatusPARTIALLYREFUNDED,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			orderUUID := uuid.New()
+
+			s.orderService.On("RefundOrder", s.ctx, s.requester, orderUUID.String(), tc.expectedAmount, tc.expectedReason).
+				Return(tc.refund, nil).Once()
+
+			resp, err := s.api.RefundOrder(s.ctx, tc.request, orderV1.RefundOrderParams{OrderUUID: orderUUID})
+
+			s.Require().NoError(err)
+			refundResp, ok := resp.(*orderV1.RefundOrderResponse)
+			s.Require().True(ok, "response should be RefundOrderResponse")
+			assert.Equal(s.T(), refundUUID, refundResp.RefundUUID)
+			assert.Equal(s.T(), tc.refund.Amount.StringFixed(2), refundResp.Amount)
+			assert.Equal(s.T(), model.CurrencyRUB, refundResp.Currency)
+			assert.Equal(s.T(), tc.expectedStatus, refundResp.OrderStatus)
+		})
+	}
+}
+
+func (s *APISuite) TestRefundOrderInvalidAmount() {
+	resp, err := s.api.RefundOrder(s.ctx,
+		orderV1.NewOptRefundOrderRequest(orderV1.RefundOrderRequest{Amount: orderV1.NewOptString("0")}),
+		orderV1.RefundOrderParams{OrderUUID: uuid.New()},
+	)
+
+	s.Require().NoError(err)
+	badRequestErr, ok := resp.(*orderV1.BadRequestError)
+	s.Require().True(ok, "response should be BadRequestError")
+	assert.Equal(s.T(), 400, badRequestErr.Code)
+	assert.Equal(s.T(), "Invalid refund amount", badRequestErr.Message)
+}
+
+func (s *APISuite) TestRefundOrderError() {
+	testCases := []struct {
+		name            string
+		serviceError    error
+		expectedCode    int
+		expectedMessage string
+	}{
+		{
+			name:            "Order not found",
+			serviceError:    model.ErrOrderNotFound,
+			expectedCode:    404,
+			expectedMessage: "Order not found",
+		},
+		{
+			name:            "Order not refundable",
+			serviceError:    model.ErrInvalidOrderStatus,
+			expectedCode:    409,
+			expectedMessage: "Only paid orders that are not assembled yet can be refunded",
+		},
+		{
+			name:            "Concurrent modification",
+			serviceError:    model.ErrOrderConflict,
+			expectedCode:    409,
+			expectedMessage: "Order was modified concurrently, retry the request",
+		},
+		{
+			name:            "Refund exceeds payment",
+			serviceError:    model.ErrRefundExceedsPayment,
+			expectedCode:    422,
+			expectedMessage: "Refund exceeds the amount left on the payment",
+		},
+		{
+			name:            "Service internal error",
+			serviceError:    errors.New("payment service unavailable"),
+			expectedCode:    500,
+			expectedMessage: "Failed to refund order",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			orderUUID := uuid.New()
+
+			s.orderService.On("RefundOrder", s.ctx, s.requester, orderUUID.String(), decimal.Zero, "").
+				Return(nil, tc.serviceError).Once()
+
+			resp, err := s.api.RefundOrder(s.ctx, orderV1.OptRefundOrderRequest{}, orderV1.RefundOrderParams{OrderUUID: orderUUID})
+
+			s.Require().NoError(err)
+			switch errResp := resp.(type) {
+			case *orderV1.NotFoundError:
+				assert.Equal(s.T(), tc.expectedCode, errResp.Code)
+				assert.Equal(s.T(), tc.expectedMessage, errResp.Message)
+			case *orderV1.ConflictError:
+				assert.Equal(s.T(), tc.expectedCode, errResp.Code)
+				assert.Equal(s.T(), tc.expectedMessage, errResp.Message)
+			case *orderV1.ValidationError:
+				assert.Equal(s.T(), tc.expectedCode, errResp.Code)
+				assert.Equal(s.T(), tc.expectedMessage, errResp.Message)
+			case *orderV1.InternalServerError:
+				assert.Equal(s.T(), tc.expectedCode, errResp.Code)
+				assert.Equal(s.T(), tc.expectedMessage, errResp.Message)
+			default:
+				s.Fail("unexpected response type")
+			}
+		})
+	}
+}
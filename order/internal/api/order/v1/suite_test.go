@@ -4,20 +4,27 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/dexguitar/spacecraftory/order/internal/model"
 	"github.com/dexguitar/spacecraftory/order/internal/service/mocks"
+	grpcAuth "github.com/dexguitar/spacecraftory/platform/pkg/middleware/grpc"
+	commonV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/common/v1"
 )
 
 type APISuite struct {
 	suite.Suite
 	ctx          context.Context
+	requester    model.Requester
 	orderService *mocks.OrderService
 	api          *api
 }
 
 func (s *APISuite) SetupTest() {
-	s.ctx = context.Background()
+	user := &commonV1.User{Uuid: uuid.NewString()}
+	s.ctx = grpcAuth.AddUserToContext(context.Background(), user)
+	s.requester = model.Requester{UserUUID: user.GetUuid()}
 	s.orderService = mocks.NewOrderService(s.T())
 	s.api = NewAPI(s.orderService).(*api)
 }
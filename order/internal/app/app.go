@@ -47,7 +47,7 @@ func New(ctx context.Context) (*App, error) {
 
 func (a *App) Run(ctx context.Context) error {
 	// Канал для ошибок от компонентов
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 4)
 
 	// Контекст для остановки всех горутин
 	ctx, cancel := context.WithCancel(ctx)
@@ -60,6 +60,26 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	// Консьюмер проведённых платежей
+	go func() {
+		if err := a.runPaymentConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("payment consumer crashed: %w", err)
+		}
+	}()
+
+	// Outbox relay
+	go func() {
+		if err := a.runOutboxRelay(ctx); err != nil {
+			errCh <- fmt.Errorf("outbox relay crashed: %w", err)
+		}
+	}()
+
+	// Очистка обработанных событий
+	go a.runProcessedEventsPurger(ctx)
+
+	// Очистка отправленных сообщений outbox
+	go a.runOutboxPurger(ctx)
+
 	// HTTP сервер
 	go func() {
 		if err := a.runHTTPServer(ctx); err != nil {
@@ -217,11 +237,12 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 
 	mux := chi.NewRouter()
 
-	authMiddleware := httpAuth.NewAuthMiddleware(a.diContainer.IAMGRPCClient(ctx))
+	authMiddleware := httpAuth.NewAuthMiddleware(a.diContainer.IAMGRPCClient(ctx), nil)
 	mux.Use(authMiddleware.Handle)
 	mux.Use(customMiddleware.RequestLogger)
 	mux.Use(middleware.Recoverer)
 	mux.Use(middleware.Timeout(10 * time.Second))
+	mux.Use(customMiddleware.Idempotency(a.diContainer.IdempotencyService(ctx)))
 
 	mux.Mount("/", orderServer)
 
@@ -269,3 +290,53 @@ func (a *App) runConsumer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runPaymentConsumer(ctx context.Context) error {
+	return a.diContainer.PaymentConsumerService(ctx).RunConsumer(ctx)
+}
+
+func (a *App) runOutboxRelay(ctx context.Context) error {
+	return a.diContainer.OrderProducerService(ctx).RunRelay(ctx)
+}
+
+// runProcessedEventsPurger periodically deletes processed events older than the retention period
+func (a *App) runProcessedEventsPurger(ctx context.Context) {
+	ticker := time.NewTicker(config.AppConfig().ProcessedEvents.PurgeInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := a.diContainer.ProcessedEventRepository(ctx).DeleteProcessedBefore(ctx, config.AppConfig().ProcessedEvents.Retention())
+			if err != nil {
+				logger.Error(ctx, "failed to delete processed events", zap.Error(err))
+			}
+			if deleted > 0 {
+				logger.Info(ctx, "deleted processed events", zap.Int64("count", deleted))
+			}
+		}
+	}
+}
+
+// runOutboxPurger periodically deletes outbox messages sent longer than the retention period ago
+func (a *App) runOutboxPurger(ctx context.Context) {
+	ticker := time.NewTicker(config.AppConfig().OutboxRelay.PurgeInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := a.diContainer.OutboxRepository(ctx).DeleteSentBefore(ctx, config.AppConfig().OutboxRelay.Retention())
+			if err != nil {
+				logger.Error(ctx, "failed to delete sent outbox messages", zap.Error(err))
+			}
+			if deleted > 0 {
+				logger.Info(ctx, "deleted sent outbox messages", zap.Int64("count", deleted))
+			}
+		}
+	}
+}
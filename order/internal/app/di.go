@@ -17,10 +17,17 @@ import (
 	"github.com/dexguitar/spacecraftory/order/internal/config"
 	kafkaConverter "github.com/dexguitar/spacecraftory/order/internal/converter/kafka"
 	decoder "github.com/dexguitar/spacecraftory/order/internal/converter/kafka/decoder"
+	encoder "github.com/dexguitar/spacecraftory/order/internal/converter/kafka/encoder"
+	"github.com/dexguitar/spacecraftory/order/internal/model"
 	"github.com/dexguitar/spacecraftory/order/internal/repository"
+	idempotencyRepository "github.com/dexguitar/spacecraftory/order/internal/repository/idempotency"
 	orderRepository "github.com/dexguitar/spacecraftory/order/internal/repository/order"
+	outboxRepository "github.com/dexguitar/spacecraftory/order/internal/repository/outbox"
+	processedEventRepository "github.com/dexguitar/spacecraftory/order/internal/repository/processed_event"
 	"github.com/dexguitar/spacecraftory/order/internal/service"
 	orderConsumerService "github.com/dexguitar/spacecraftory/order/internal/service/consumer/order_consumer"
+	paymentConsumerService "github.com/dexguitar/spacecraftory/order/internal/service/consumer/payment_consumer"
+	idempotencyService "github.com/dexguitar/spacecraftory/order/internal/service/idempotency"
 	orderService "github.com/dexguitar/spacecraftory/order/internal/service/order"
 	orderProducerService "github.com/dexguitar/spacecraftory/order/internal/service/producer/order_producer"
 	"github.com/dexguitar/spacecraftory/platform/pkg/closer"
@@ -28,6 +35,7 @@ import (
 	wrappedKafkaConsumer "github.com/dexguitar/spacecraftory/platform/pkg/kafka/consumer"
 	wrappedKafkaProducer "github.com/dexguitar/spacecraftory/platform/pkg/kafka/producer"
 	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+	kafkaMiddleware "github.com/dexguitar/spacecraftory/platform/pkg/middleware/kafka"
 	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
 	orderV1 "github.com/dexguitar/spacecraftory/shared/pkg/openapi/order/v1"
 	authV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/auth/v1"
@@ -38,10 +46,17 @@ import (
 type diContainer struct {
 	orderV1API orderV1.Handler
 
-	orderService         service.OrderService
-	orderRepository      repository.OrderRepository
-	orderProducerService service.ProducerService
-	orderConsumerService service.ConsumerService
+	orderService             service.OrderService
+	orderRepository          repository.OrderRepository
+	outboxRepository         repository.OutboxRepository
+	processedEventRepository repository.ProcessedEventRepository
+	// paymentSettledEvents deduplicates PaymentSettled, events are tracked per consumer group
+	paymentSettledEvents   repository.ProcessedEventRepository
+	idempotencyRepository  repository.IdempotencyRepository
+	idempotencyService     service.IdempotencyService
+	orderProducerService   service.ProducerService
+	orderConsumerService   service.ConsumerService
+	paymentConsumerService service.ConsumerService
 
 	inventoryClient client.InventoryClient
 	paymentClient   client.PaymentClient
@@ -55,10 +70,20 @@ type diContainer struct {
 
 	consumerGroup          sarama.ConsumerGroup
 	orderAssembledConsumer wrappedKafka.Consumer
-
-	orderAssembledDecoder kafkaConverter.OrderAssembledDecoder
-	syncProducer          sarama.SyncProducer
-	orderPaidProducer     wrappedKafka.Producer
+	// PaymentSettled is consumed by its own group, a consumer group runs one Consume at a time
+	paymentSettledGroup    sarama.ConsumerGroup
+	paymentSettledConsumer wrappedKafka.Consumer
+	paymentSettledDecoder  kafkaConverter.PaymentSettledDecoder
+
+	orderAssembledDecoder  kafkaConverter.OrderAssembledDecoder
+	orderPaidEncoder       kafkaConverter.OrderPaidEncoder
+	orderCreatedEncoder    kafkaConverter.OrderCreatedEncoder
+	orderCancelledEncoder  kafkaConverter.OrderCancelledEncoder
+	syncProducer           sarama.SyncProducer
+	orderPaidProducer      wrappedKafka.Producer
+	orderCreatedProducer   wrappedKafka.Producer
+	orderCancelledProducer wrappedKafka.Producer
+	retryProducer          sarama.SyncProducer
 }
 
 func NewDiContainer() *diContainer {
@@ -81,9 +106,71 @@ func (d *diContainer) OrderRepository(ctx context.Context) repository.OrderRepos
 	return d.orderRepository
 }
 
+func (d *diContainer) OutboxRepository(ctx context.Context) repository.OutboxRepository {
+	if d.outboxRepository == nil {
+		d.outboxRepository = outboxRepository.NewOutboxRepository(d.PgPool(ctx))
+	}
+
+	return d.outboxRepository
+}
+
+func (d *diContainer) ProcessedEventRepository(ctx context.Context) repository.ProcessedEventRepository {
+	if d.processedEventRepository == nil {
+		d.processedEventRepository = processedEventRepository.NewProcessedEventRepository(
+			d.PgPool(ctx),
+			config.AppConfig().OrderAssembledConsumer.GroupID(),
+			config.AppConfig().ProcessedEvents.ClaimTTL(),
+		)
+	}
+
+	return d.processedEventRepository
+}
+
+func (d *diContainer) PaymentSettledEventRepository(ctx context.Context) repository.ProcessedEventRepository {
+	if d.paymentSettledEvents == nil {
+		d.paymentSettledEvents = processedEventRepository.NewProcessedEventRepository(
+			d.PgPool(ctx),
+			config.AppConfig().PaymentSettledConsumer.GroupID(),
+			config.AppConfig().ProcessedEvents.ClaimTTL(),
+		)
+	}
+
+	return d.paymentSettledEvents
+}
+
+func (d *diContainer) IdempotencyRepository(ctx context.Context) repository.IdempotencyRepository {
+	if d.idempotencyRepository == nil {
+		d.idempotencyRepository = idempotencyRepository.NewIdempotencyRepository(d.PgPool(ctx))
+	}
+
+	return d.idempotencyRepository
+}
+
+func (d *diContainer) IdempotencyService(ctx context.Context) service.IdempotencyService {
+	if d.idempotencyService == nil {
+		d.idempotencyService = idempotencyService.NewService(
+			d.IdempotencyRepository(ctx),
+			config.AppConfig().Idempotency.KeyTTL(),
+			config.AppConfig().Idempotency.LockTimeout(),
+		)
+	}
+
+	return d.idempotencyService
+}
+
 func (d *diContainer) OrderProducerService(ctx context.Context) service.ProducerService {
 	if d.orderProducerService == nil {
-		d.orderProducerService = orderProducerService.NewService(d.OrderPaidProducer(ctx))
+		d.orderProducerService = orderProducerService.NewService(
+			d.OutboxRepository(ctx),
+			map[string]wrappedKafka.Producer{
+				model.OutboxEventOrderPaid:      d.OrderPaidProducer(ctx),
+				model.OutboxEventOrderCreated:   d.OrderCreatedProducer(ctx),
+				model.OutboxEventOrderCancelled: d.OrderCancelledProducer(ctx),
+			},
+			config.AppConfig().OutboxRelay.PollInterval(),
+			config.AppConfig().OutboxRelay.BatchSize(),
+			config.AppConfig().OutboxRelay.MaxRetryDelay(),
+		)
 	}
 
 	return d.orderProducerService
@@ -101,6 +188,18 @@ func (d *diContainer) OrderConsumerService(ctx context.Context) service.Consumer
 	return d.orderConsumerService
 }
 
+func (d *diContainer) PaymentConsumerService(ctx context.Context) service.ConsumerService {
+	if d.paymentConsumerService == nil {
+		d.paymentConsumerService = paymentConsumerService.NewService(
+			d.PaymentSettledConsumer(ctx),
+			d.PaymentSettledDecoder(),
+			d.OrderService(ctx),
+		)
+	}
+
+	return d.paymentConsumerService
+}
+
 func (d *diContainer) OrderService(ctx context.Context) service.OrderService {
 	if d.orderService == nil {
 		d.orderService = orderService.NewService(
@@ -108,7 +207,9 @@ func (d *diContainer) OrderService(ctx context.Context) service.OrderService {
 			d.InventoryClient(ctx),
 			d.PaymentClient(ctx),
 			d.IAMClient(ctx),
-			d.OrderProducerService(ctx),
+			d.OrderPaidEncoder(),
+			d.OrderCreatedEncoder(),
+			d.OrderCancelledEncoder(),
 		)
 	}
 
@@ -118,7 +219,7 @@ func (d *diContainer) OrderService(ctx context.Context) service.OrderService {
 func (d *diContainer) InventoryClient(ctx context.Context) client.InventoryClient {
 	if d.inventoryClient == nil {
 		grpcClient := inventoryV1.NewInventoryServiceClient(d.InventoryGRPCConn(ctx))
-		d.inventoryClient = inventoryClientImpl.NewInventoryClient(grpcClient)
+		d.inventoryClient = inventoryClientImpl.NewInventoryClient(grpcClient, config.AppConfig().GRPCClient.InventoryServiceToken())
 	}
 
 	return d.inventoryClient
@@ -253,18 +354,76 @@ func (d *diContainer) ConsumerGroup() sarama.ConsumerGroup {
 
 func (d *diContainer) OrderAssembledConsumer(ctx context.Context) wrappedKafka.Consumer {
 	if d.orderAssembledConsumer == nil {
+		retryPolicy := consumerRetryPolicy(
+			config.AppConfig().OrderAssembledConsumer.Topic(),
+			config.AppConfig().OrderAssembledConsumer.GroupID(),
+		)
 		d.orderAssembledConsumer = wrappedKafkaConsumer.NewConsumer(
 			d.ConsumerGroup(),
 			[]string{
 				config.AppConfig().OrderAssembledConsumer.Topic(),
+				retryPolicy.RetryTopic,
 			},
 			logger.Logger(),
+			kafkaMiddleware.Tracing(config.AppConfig().Tracing.ServiceName()),
+			wrappedKafkaConsumer.Retry(retryPolicy, d.RetryProducer(), logger.Logger()),
+			wrappedKafkaConsumer.Dedup(d.ProcessedEventRepository(ctx), d.orderAssembledEventUUID, logger.Logger()),
 		)
 	}
 
 	return d.orderAssembledConsumer
 }
 
+func (d *diContainer) PaymentSettledGroup() sarama.ConsumerGroup {
+	if d.paymentSettledGroup == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().PaymentSettledConsumer.GroupID(),
+			config.AppConfig().PaymentSettledConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create payment settled consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka payment settled consumer group", func(ctx context.Context) error {
+			return consumerGroup.Close()
+		})
+
+		d.paymentSettledGroup = consumerGroup
+	}
+
+	return d.paymentSettledGroup
+}
+
+func (d *diContainer) PaymentSettledConsumer(ctx context.Context) wrappedKafka.Consumer {
+	if d.paymentSettledConsumer == nil {
+		retryPolicy := consumerRetryPolicy(
+			config.AppConfig().PaymentSettledConsumer.Topic(),
+			config.AppConfig().PaymentSettledConsumer.GroupID(),
+		)
+		d.paymentSettledConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.PaymentSettledGroup(),
+			[]string{
+				config.AppConfig().PaymentSettledConsumer.Topic(),
+				retryPolicy.RetryTopic,
+			},
+			logger.Logger(),
+			kafkaMiddleware.Tracing(config.AppConfig().Tracing.ServiceName()),
+			wrappedKafkaConsumer.Retry(retryPolicy, d.RetryProducer(), logger.Logger()),
+			wrappedKafkaConsumer.Dedup(d.PaymentSettledEventRepository(ctx), d.paymentSettledEventUUID, logger.Logger()),
+		)
+	}
+
+	return d.paymentSettledConsumer
+}
+
+func (d *diContainer) PaymentSettledDecoder() kafkaConverter.PaymentSettledDecoder {
+	if d.paymentSettledDecoder == nil {
+		d.paymentSettledDecoder = decoder.NewPaymentSettledDecoder()
+	}
+
+	return d.paymentSettledDecoder
+}
+
 func (d *diContainer) OrderAssembledDecoder() kafkaConverter.OrderAssembledDecoder {
 	if d.orderAssembledDecoder == nil {
 		d.orderAssembledDecoder = decoder.NewOrderAssembledDecoder()
@@ -273,6 +432,50 @@ func (d *diContainer) OrderAssembledDecoder() kafkaConverter.OrderAssembledDecod
 	return d.orderAssembledDecoder
 }
 
+func (d *diContainer) OrderPaidEncoder() kafkaConverter.OrderPaidEncoder {
+	if d.orderPaidEncoder == nil {
+		d.orderPaidEncoder = encoder.NewOrderPaidEncoder()
+	}
+
+	return d.orderPaidEncoder
+}
+
+func (d *diContainer) OrderCreatedEncoder() kafkaConverter.OrderCreatedEncoder {
+	if d.orderCreatedEncoder == nil {
+		d.orderCreatedEncoder = encoder.NewOrderCreatedEncoder()
+	}
+
+	return d.orderCreatedEncoder
+}
+
+func (d *diContainer) OrderCancelledEncoder() kafkaConverter.OrderCancelledEncoder {
+	if d.orderCancelledEncoder == nil {
+		d.orderCancelledEncoder = encoder.NewOrderCancelledEncoder()
+	}
+
+	return d.orderCancelledEncoder
+}
+
+// orderAssembledEventUUID extracts the dedup key of an OrderAssembled message
+func (d *diContainer) orderAssembledEventUUID(msg wrappedKafka.Message) (string, error) {
+	event, err := d.OrderAssembledDecoder().Decode(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return event.EventUUID, nil
+}
+
+// paymentSettledEventUUID extracts the dedup key of a PaymentSettled message
+func (d *diContainer) paymentSettledEventUUID(msg wrappedKafka.Message) (string, error) {
+	event, err := d.PaymentSettledDecoder().Decode(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return event.EventUUID, nil
+}
+
 func (d *diContainer) SyncProducer() sarama.SyncProducer {
 	if d.syncProducer == nil {
 		p, err := sarama.NewSyncProducer(
@@ -298,8 +501,75 @@ func (d *diContainer) OrderPaidProducer(ctx context.Context) wrappedKafka.Produc
 			d.SyncProducer(),
 			config.AppConfig().OrderPaidProducer.Topic(),
 			logger.Logger(),
+			wrappedKafkaProducer.WithEventType(model.OutboxEventOrderPaid),
+			wrappedKafkaProducer.WithSchemaVersion(model.OrderPaidSchemaVersion),
+			wrappedKafkaProducer.WithProducerService(config.AppConfig().Tracing.ServiceName()),
 		)
 	}
 
 	return d.orderPaidProducer
 }
+
+func (d *diContainer) OrderCreatedProducer(ctx context.Context) wrappedKafka.Producer {
+	if d.orderCreatedProducer == nil {
+		d.orderCreatedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderCreatedProducer.Topic(),
+			logger.Logger(),
+			wrappedKafkaProducer.WithEventType(model.OutboxEventOrderCreated),
+			wrappedKafkaProducer.WithSchemaVersion(model.OrderCreatedSchemaVersion),
+			wrappedKafkaProducer.WithProducerService(config.AppConfig().Tracing.ServiceName()),
+		)
+	}
+
+	return d.orderCreatedProducer
+}
+
+func (d *diContainer) OrderCancelledProducer(ctx context.Context) wrappedKafka.Producer {
+	if d.orderCancelledProducer == nil {
+		d.orderCancelledProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderCancelledProducer.Topic(),
+			logger.Logger(),
+			wrappedKafkaProducer.WithEventType(model.OutboxEventOrderCancelled),
+			wrappedKafkaProducer.WithSchemaVersion(model.OrderCancelledSchemaVersion),
+			wrappedKafkaProducer.WithProducerService(config.AppConfig().Tracing.ServiceName()),
+		)
+	}
+
+	return d.orderCancelledProducer
+}
+
+func (d *diContainer) RetryProducer() sarama.SyncProducer {
+	if d.retryProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().ConsumerRetry.ProducerConfig(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create retry producer: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka retry producer", func(ctx context.Context) error {
+			return p.Close()
+		})
+
+		d.retryProducer = p
+	}
+
+	return d.retryProducer
+}
+
+// consumerRetryPolicy builds the retry policy of the consumer group reading the topic
+func consumerRetryPolicy(topic, groupID string) wrappedKafkaConsumer.RetryPolicy {
+	cfg := config.AppConfig().ConsumerRetry
+
+	return wrappedKafkaConsumer.RetryPolicy{
+		MaxAttempts:           cfg.MaxAttempts(),
+		InitialBackoff:        cfg.InitialBackoff(),
+		MaxBackoff:            cfg.MaxBackoff(),
+		RetryTopic:            wrappedKafkaConsumer.RetryTopicName(topic, groupID),
+		RetryDelay:            cfg.RetryTopicDelay(),
+		MaxRetryTopicAttempts: cfg.RetryTopicMaxAttempts(),
+		DeadLetterTopic:       wrappedKafkaConsumer.DeadLetterTopicName(topic, groupID),
+	}
+}
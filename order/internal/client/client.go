@@ -3,15 +3,24 @@ package client
 import (
 	"context"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/dexguitar/spacecraftory/order/internal/model"
 )
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, filter *model.PartsFilter) ([]model.Part, error)
+	ReserveParts(ctx context.Context, orderUUID string, items []model.OrderItem) error
+	ReleaseReservation(ctx context.Context, orderUUID string) error
+	CommitReservation(ctx context.Context, orderUUID string) error
 }
 
 type PaymentClient interface {
-	PayOrder(ctx context.Context, orderUUID, userUUID string, paymentMethod model.PaymentMethod) (string, error)
+	// PayOrder charges the order once per attempt key, a repeated attempt returns the original transaction.
+	PayOrder(ctx context.Context, orderUUID, attemptKey, userUUID string, amount decimal.Decimal, currency string, paymentMethod model.PaymentMethod) (string, error)
+	// RefundPayment refunds the amount of the transaction, a zero amount refunds everything left on it.
+	// A repeated refund key returns the original refund.
+	RefundPayment(ctx context.Context, transactionUUID, refundKey string, amount decimal.Decimal, reason string) (*model.Refund, error)
 }
 
 type IAMClient interface {
@@ -7,8 +7,36 @@ import (
 
 func PartProtoToServiceModel(protoPart *inventoryV1.Part) model.Part {
 	return model.Part{
-		UUID:  protoPart.GetUuid(),
-		Name:  protoPart.GetName(),
-		Price: protoPart.GetPrice(),
+		UUID:     protoPart.GetUuid(),
+		Name:     protoPart.GetName(),
+		Price:    protoPart.GetPrice(),
+		Category: CategoryProtoToServiceModel(protoPart.GetCategory()),
 	}
 }
+
+func CategoryProtoToServiceModel(category inventoryV1.Category) model.PartCategory {
+	switch category {
+	case inventoryV1.Category_CATEGORY_ENGINE:
+		return model.PartCategoryEngine
+	case inventoryV1.Category_CATEGORY_FUEL:
+		return model.PartCategoryFuel
+	case inventoryV1.Category_CATEGORY_PORTHOLE:
+		return model.PartCategoryPorthole
+	case inventoryV1.Category_CATEGORY_WING:
+		return model.PartCategoryWing
+	default:
+		return model.PartCategoryUnknown
+	}
+}
+
+func OrderItemsToReservationItems(items []model.OrderItem) []*inventoryV1.ReservationItem {
+	protoItems := make([]*inventoryV1.ReservationItem, 0, len(items))
+	for _, item := range items {
+		protoItems = append(protoItems, &inventoryV1.ReservationItem{
+			PartUuid: item.PartUUID,
+			Quantity: int64(item.Quantity),
+		})
+	}
+
+	return protoItems
+}
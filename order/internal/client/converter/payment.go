@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"github.com/shopspring/decimal"
+
 	"github.com/dexguitar/spacecraftory/order/internal/model"
 	paymentV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1"
 )
@@ -19,3 +21,19 @@ func PaymentMethodToProto(paymentMethod model.PaymentMethod) paymentV1.PaymentMe
 		return paymentV1.PaymentMethod_PAYMENT_METHOD_UNKNOWN_UNSPECIFIED
 	}
 }
+
+// RefundProtoToServiceModel converts the payment service refund, an amount that is
+// not a decimal is returned as zero
+func RefundProtoToServiceModel(resp *paymentV1.RefundPaymentResponse) *model.Refund {
+	amount, err := decimal.NewFromString(resp.GetAmount())
+	if err != nil {
+		amount = decimal.Zero
+	}
+
+	return &model.Refund{
+		RefundUUID:      resp.GetRefundUuid(),
+		Amount:          amount,
+		Currency:        resp.GetCurrency(),
+		PaymentRefunded: resp.GetPaymentStatus() == paymentV1.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+	}
+}
@@ -16,8 +16,9 @@ func SessionProtoToServiceModel(protoSession *commonV1.Session) *model.Session {
 
 func UserProtoToServiceModel(protoUser *commonV1.User) *model.User {
 	return &model.User{
-		UUID: protoUser.GetUuid(),
-		Info: *UserInfoProtoToServiceModel(protoUser.GetInfo()),
+		UUID:  protoUser.GetUuid(),
+		Info:  *UserInfoProtoToServiceModel(protoUser.GetInfo()),
+		Roles: protoUser.GetRoles(),
 	}
 }
 
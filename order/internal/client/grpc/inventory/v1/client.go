@@ -6,10 +6,13 @@ import (
 
 type inventoryClient struct {
 	grpcClient inventoryV1.InventoryServiceClient
+	// serviceToken authenticates order itself for reservation methods
+	serviceToken string
 }
 
-func NewInventoryClient(grpcClient inventoryV1.InventoryServiceClient) *inventoryClient {
+func NewInventoryClient(grpcClient inventoryV1.InventoryServiceClient, serviceToken string) *inventoryClient {
 	return &inventoryClient{
-		grpcClient: grpcClient,
+		grpcClient:   grpcClient,
+		serviceToken: serviceToken,
 	}
 }
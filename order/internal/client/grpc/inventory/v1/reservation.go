@@ -0,0 +1,65 @@
+package inventory
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/order/internal/client/converter"
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	authGrpc "github.com/dexguitar/spacecraftory/platform/pkg/middleware/grpc"
+	inventoryV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *inventoryClient) ReserveParts(ctx context.Context, orderUUID string, items []model.OrderItem) error {
+	req := &inventoryV1.ReservePartsRequest{
+		OrderUuid: orderUUID,
+		Items:     converter.OrderItemsToReservationItems(items),
+	}
+
+	ctx = authGrpc.ForwardServiceTokenToGRPC(ctx, c.serviceToken)
+
+	_, err := c.grpcClient.ReserveParts(ctx, req)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return model.ErrInsufficientStock
+		case codes.NotFound:
+			return model.ErrPartsNotFound
+		case codes.AlreadyExists:
+			return model.ErrReservationExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *inventoryClient) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	ctx = authGrpc.ForwardServiceTokenToGRPC(ctx, c.serviceToken)
+
+	_, err := c.grpcClient.ReleaseReservation(ctx, &inventoryV1.ReleaseReservationRequest{OrderUuid: orderUUID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return model.ErrReservationNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *inventoryClient) CommitReservation(ctx context.Context, orderUUID string) error {
+	ctx = authGrpc.ForwardServiceTokenToGRPC(ctx, c.serviceToken)
+
+	_, err := c.grpcClient.CommitReservation(ctx, &inventoryV1.CommitReservationRequest{OrderUuid: orderUUID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return model.ErrReservationNotFound
+		}
+		return err
+	}
+
+	return nil
+}
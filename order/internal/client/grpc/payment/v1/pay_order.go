@@ -3,20 +3,39 @@ package payment
 import (
 	"context"
 
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/dexguitar/spacecraftory/order/internal/client/converter"
 	"github.com/dexguitar/spacecraftory/order/internal/model"
 	paymentV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1"
 )
 
-func (c *paymentClient) PayOrder(ctx context.Context, orderUUID, userUUID string, paymentMethod model.PaymentMethod) (string, error) {
+func (c *paymentClient) PayOrder(ctx context.Context, orderUUID, attemptKey, userUUID string, amount decimal.Decimal, currency string, paymentMethod model.PaymentMethod) (string, error) {
 	req := &paymentV1.PayOrderRequest{
 		OrderUuid:     orderUUID,
 		UserUuid:      userUUID,
 		PaymentMethod: converter.PaymentMethodToProto(paymentMethod),
+		Amount:        amount.StringFixed(2),
+		Currency:      currency,
+		AttemptKey:    attemptKey,
 	}
 
 	resp, err := c.grpcClient.PayOrder(ctx, req)
 	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return "", model.ErrPaymentLimitExceeded
+		case codes.AlreadyExists:
+			return "", model.ErrPaymentAlreadyExists
+		case codes.PermissionDenied:
+			return "", model.ErrPaymentDeclined
+		case codes.Unavailable:
+			return "", model.ErrPaymentPending
+		case codes.DeadlineExceeded:
+			return "", model.ErrPaymentTimeout
+		}
 		return "", err
 	}
 
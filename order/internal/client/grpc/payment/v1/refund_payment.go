@@ -0,0 +1,39 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/order/internal/client/converter"
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	paymentV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1"
+)
+
+func (c *paymentClient) RefundPayment(ctx context.Context, transactionUUID, refundKey string, amount decimal.Decimal, reason string) (*model.Refund, error) {
+	req := &paymentV1.RefundPaymentRequest{
+		TransactionUuid: transactionUUID,
+		Reason:          reason,
+		RefundKey:       refundKey,
+	}
+	// an empty amount asks the payment service to refund everything left
+	if !amount.IsZero() {
+		req.Amount = amount.StringFixed(2)
+	}
+
+	resp, err := c.grpcClient.RefundPayment(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, model.ErrRefundExceedsPayment
+		}
+		// the key was used by a concurrent refund of the same order version
+		if status.Code(err) == codes.AlreadyExists {
+			return nil, model.ErrOrderConflict
+		}
+		return nil, err
+	}
+
+	return converter.RefundProtoToServiceModel(resp), nil
+}
@@ -22,6 +22,53 @@ func (_m *InventoryClient) EXPECT() *InventoryClient_Expecter {
 	return &InventoryClient_Expecter{mock: &_m.Mock}
 }
 
+// CommitReservation provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryClient) CommitReservation(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type InventoryClient_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryClient_Expecter) CommitReservation(ctx interface{}, orderUUID interface{}) *InventoryClient_CommitReservation_Call {
+	return &InventoryClient_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, orderUUID)}
+}
+
+func (_c *InventoryClient_CommitReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryClient_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_CommitReservation_Call) Return(_a0 error) *InventoryClient_CommitReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_CommitReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryClient_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListParts provides a mock function with given fields: ctx, filter
 func (_m *InventoryClient) ListParts(ctx context.Context, filter *model.PartsFilter) ([]model.Part, error) {
 	ret := _m.Called(ctx, filter)
@@ -81,6 +128,101 @@ func (_c *InventoryClient_ListParts_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ReleaseReservation provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryClient) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type InventoryClient_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryClient_Expecter) ReleaseReservation(ctx interface{}, orderUUID interface{}) *InventoryClient_ReleaseReservation_Call {
+	return &InventoryClient_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, orderUUID)}
+}
+
+func (_c *InventoryClient_ReleaseReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryClient_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ReleaseReservation_Call) Return(_a0 error) *InventoryClient_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) error) *InventoryClient_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, orderUUID, items
+func (_m *InventoryClient) ReserveParts(ctx context.Context, orderUUID string, items []model.OrderItem) error {
+	ret := _m.Called(ctx, orderUUID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.OrderItem) error); ok {
+		r0 = rf(ctx, orderUUID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type InventoryClient_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - items []model.OrderItem
+func (_e *InventoryClient_Expecter) ReserveParts(ctx interface{}, orderUUID interface{}, items interface{}) *InventoryClient_ReserveParts_Call {
+	return &InventoryClient_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, orderUUID, items)}
+}
+
+func (_c *InventoryClient_ReserveParts_Call) Run(run func(ctx context.Context, orderUUID string, items []model.OrderItem)) *InventoryClient_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.OrderItem))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ReserveParts_Call) Return(_a0 error) *InventoryClient_ReserveParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_ReserveParts_Call) RunAndReturn(run func(context.Context, string, []model.OrderItem) error) *InventoryClient_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryClient creates a new instance of InventoryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryClient(t interface {
@@ -5,8 +5,10 @@ package mocks
 import (
 	context "context"
 
-	model "github.com/dexguitar/spacecraftory/order/internal/model"
+	decimal "github.com/shopspring/decimal"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dexguitar/spacecraftory/order/internal/model"
 )
 
 // PaymentClient is an autogenerated mock type for the PaymentClient type
@@ -22,9 +24,9 @@ func (_m *PaymentClient) EXPECT() *PaymentClient_Expecter {
 	return &PaymentClient_Expecter{mock: &_m.Mock}
 }
 
-// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod
-func (_m *PaymentClient) PayOrder(ctx context.Context, orderUUID string, userUUID string, paymentMethod model.PaymentMethod) (string, error) {
-	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod)
+// PayOrder provides a mock function with given fields: ctx, orderUUID, attemptKey, userUUID, amount, currency, paymentMethod
+func (_m *PaymentClient) PayOrder(ctx context.Context, orderUUID string, attemptKey string, userUUID string, amount decimal.Decimal, currency string, paymentMethod model.PaymentMethod) (string, error) {
+	ret := _m.Called(ctx, orderUUID, attemptKey, userUUID, amount, currency, paymentMethod)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -32,17 +34,17 @@ func (_m *PaymentClient) PayOrder(ctx context.Context, orderUUID string, userUUI
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod) (string, error)); ok {
-		return rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, decimal.Decimal, string, model.PaymentMethod) (string, error)); ok {
+		return rf(ctx, orderUUID, attemptKey, userUUID, amount, currency, paymentMethod)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod) string); ok {
-		r0 = rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, decimal.Decimal, string, model.PaymentMethod) string); ok {
+		r0 = rf(ctx, orderUUID, attemptKey, userUUID, amount, currency, paymentMethod)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.PaymentMethod) error); ok {
-		r1 = rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, decimal.Decimal, string, model.PaymentMethod) error); ok {
+		r1 = rf(ctx, orderUUID, attemptKey, userUUID, amount, currency, paymentMethod)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,15 +60,18 @@ type PaymentClient_PayOrder_Call struct {
 // PayOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - orderUUID string
+//   - attemptKey string
 //   - userUUID string
+//   - amount decimal.Decimal
+//   - currency string
 //   - paymentMethod model.PaymentMethod
-func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}) *PaymentClient_PayOrder_Call {
-	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod)}
+func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, attemptKey interface{}, userUUID interface{}, amount interface{}, currency interface{}, paymentMethod interface{}) *PaymentClient_PayOrder_Call {
+	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, attemptKey, userUUID, amount, currency, paymentMethod)}
 }
 
-func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, userUUID string, paymentMethod model.PaymentMethod)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, attemptKey string, userUUID string, amount decimal.Decimal, currency string, paymentMethod model.PaymentMethod)) *PaymentClient_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PaymentMethod))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(decimal.Decimal), args[5].(string), args[6].(model.PaymentMethod))
 	})
 	return _c
 }
@@ -76,7 +81,69 @@ func (_c *PaymentClient_PayOrder_Call) Return(_a0 string, _a1 error) *PaymentCli
 	return _c
 }
 
-func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, model.PaymentMethod) (string, error)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string, decimal.Decimal, string, model.PaymentMethod) (string, error)) *PaymentClient_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function with given fields: ctx, transactionUUID, refundKey, amount, reason
+func (_m *PaymentClient) RefundPayment(ctx context.Context, transactionUUID string, refundKey string, amount decimal.Decimal, reason string) (*model.Refund, error) {
+	ret := _m.Called(ctx, transactionUUID, refundKey, amount, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 *model.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, decimal.Decimal, string) (*model.Refund, error)); ok {
+		return rf(ctx, transactionUUID, refundKey, amount, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, decimal.Decimal, string) *model.Refund); ok {
+		r0 = rf(ctx, transactionUUID, refundKey, amount, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Refund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, decimal.Decimal, string) error); ok {
+		r1 = rf(ctx, transactionUUID, refundKey, amount, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentClient_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentClient_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - refundKey string
+//   - amount decimal.Decimal
+//   - reason string
+func (_e *PaymentClient_Expecter) RefundPayment(ctx interface{}, transactionUUID interface{}, refundKey interface{}, amount interface{}, reason interface{}) *PaymentClient_RefundPayment_Call {
+	return &PaymentClient_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, transactionUUID, refundKey, amount, reason)}
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Run(run func(ctx context.Context, transactionUUID string, refundKey string, amount decimal.Decimal, reason string)) *PaymentClient_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(decimal.Decimal), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Return(_a0 *model.Refund, _a1 error) *PaymentClient_RefundPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) RunAndReturn(run func(context.Context, string, string, decimal.Decimal, string) (*model.Refund, error)) *PaymentClient_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
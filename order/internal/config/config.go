@@ -19,7 +19,14 @@ type config struct {
 	Postgres               PostgresConfig
 	Kafka                  KafkaConfig
 	OrderPaidProducer      OrderPaidProducerConfig
+	OrderCreatedProducer   OrderCreatedProducerConfig
+	OrderCancelledProducer OrderCancelledProducerConfig
 	OrderAssembledConsumer OrderAssembledConsumerConfig
+	PaymentSettledConsumer PaymentSettledConsumerConfig
+	OutboxRelay            OutboxRelayConfig
+	ConsumerRetry          ConsumerRetryConfig
+	Idempotency            IdempotencyConfig
+	ProcessedEvents        ProcessedEventsConfig
 }
 
 func Load(path ...string) error {
@@ -68,11 +75,46 @@ func Load(path ...string) error {
 		return err
 	}
 
+	orderCreatedProducerCfg, err := env.NewOrderCreatedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	orderCancelledProducerCfg, err := env.NewOrderCancelledProducerConfig()
+	if err != nil {
+		return err
+	}
+
 	orderAssembledConsumerCfg, err := env.NewOrderAssembledConsumerConfig()
 	if err != nil {
 		return err
 	}
 
+	paymentSettledConsumerCfg, err := env.NewPaymentSettledConsumerConfig()
+	if err != nil {
+		return err
+	}
+
+	outboxRelayCfg, err := env.NewOrderOutboxRelayConfig()
+	if err != nil {
+		return err
+	}
+
+	consumerRetryCfg, err := env.NewOrderConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
+	idempotencyCfg, err := env.NewOrderIdempotencyConfig()
+	if err != nil {
+		return err
+	}
+
+	processedEventsCfg, err := env.NewOrderProcessedEventsConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:                 loggerCfg,
 		Metrics:                metricsCfg,
@@ -82,7 +124,14 @@ func Load(path ...string) error {
 		Postgres:               postgresCfg,
 		Kafka:                  kafkaCfg,
 		OrderPaidProducer:      orderPaidProducerCfg,
+		OrderCreatedProducer:   orderCreatedProducerCfg,
+		OrderCancelledProducer: orderCancelledProducerCfg,
 		OrderAssembledConsumer: orderAssembledConsumerCfg,
+		PaymentSettledConsumer: paymentSettledConsumerCfg,
+		OutboxRelay:            outboxRelayCfg,
+		ConsumerRetry:          consumerRetryCfg,
+		Idempotency:            idempotencyCfg,
+		ProcessedEvents:        processedEventsCfg,
 	}
 
 	return nil
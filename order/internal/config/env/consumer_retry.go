@@ -0,0 +1,59 @@
+package env
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderConsumerRetryEnvConfig struct {
+	MaxAttempts           int           `env:"ORDER_KAFKA_RETRY_MAX_ATTEMPTS" envDefault:"3"`
+	InitialBackoff        time.Duration `env:"ORDER_KAFKA_RETRY_INITIAL_BACKOFF" envDefault:"200ms"`
+	MaxBackoff            time.Duration `env:"ORDER_KAFKA_RETRY_MAX_BACKOFF" envDefault:"5s"`
+	RetryTopicDelay       time.Duration `env:"ORDER_KAFKA_RETRY_TOPIC_DELAY" envDefault:"30s"`
+	RetryTopicMaxAttempts int           `env:"ORDER_KAFKA_RETRY_TOPIC_MAX_ATTEMPTS" envDefault:"3"`
+}
+
+type orderConsumerRetryConfig struct {
+	raw orderConsumerRetryEnvConfig
+}
+
+func NewOrderConsumerRetryConfig() (*orderConsumerRetryConfig, error) {
+	var raw orderConsumerRetryEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderConsumerRetryConfig{raw: raw}, nil
+}
+
+func (cfg *orderConsumerRetryConfig) MaxAttempts() int {
+	return cfg.raw.MaxAttempts
+}
+
+func (cfg *orderConsumerRetryConfig) InitialBackoff() time.Duration {
+	return cfg.raw.InitialBackoff
+}
+
+func (cfg *orderConsumerRetryConfig) MaxBackoff() time.Duration {
+	return cfg.raw.MaxBackoff
+}
+
+func (cfg *orderConsumerRetryConfig) RetryTopicDelay() time.Duration {
+	return cfg.raw.RetryTopicDelay
+}
+
+func (cfg *orderConsumerRetryConfig) RetryTopicMaxAttempts() int {
+	return cfg.raw.RetryTopicMaxAttempts
+}
+
+// ProducerConfig возвращает конфигурацию для sarama producer, пересылающего сообщения в retry и dead-letter топики
+func (cfg *orderConsumerRetryConfig) ProducerConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+
+	return config
+}
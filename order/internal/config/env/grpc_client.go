@@ -7,12 +7,13 @@ import (
 )
 
 type orderGRPCClientEnvConfig struct {
-	InventoryHost string `env:"ORDER_INVENTORY_GRPC_HOST,required"`
-	InventoryPort string `env:"ORDER_INVENTORY_GRPC_PORT,required"`
-	PaymentHost   string `env:"ORDER_PAYMENT_GRPC_HOST,required"`
-	PaymentPort   string `env:"ORDER_PAYMENT_GRPC_PORT,required"`
-	IAMHost       string `env:"ORDER_IAM_GRPC_HOST,required"`
-	IAMPort       string `env:"ORDER_IAM_GRPC_PORT,required"`
+	InventoryHost  string `env:"ORDER_INVENTORY_GRPC_HOST,required"`
+	InventoryPort  string `env:"ORDER_INVENTORY_GRPC_PORT,required"`
+	InventoryToken string `env:"ORDER_INVENTORY_SERVICE_TOKEN,required"`
+	PaymentHost    string `env:"ORDER_PAYMENT_GRPC_HOST,required"`
+	PaymentPort    string `env:"ORDER_PAYMENT_GRPC_PORT,required"`
+	IAMHost        string `env:"ORDER_IAM_GRPC_HOST,required"`
+	IAMPort        string `env:"ORDER_IAM_GRPC_PORT,required"`
 }
 
 type orderGRPCClientConfig struct {
@@ -33,6 +34,11 @@ func (cfg *orderGRPCClientConfig) InventoryAddress() string {
 	return net.JoinHostPort(cfg.raw.InventoryHost, cfg.raw.InventoryPort)
 }
 
+// InventoryServiceToken is presented to inventory to call its reservation methods
+func (cfg *orderGRPCClientConfig) InventoryServiceToken() string {
+	return cfg.raw.InventoryToken
+}
+
 func (cfg *orderGRPCClientConfig) PaymentAddress() string {
 	return net.JoinHostPort(cfg.raw.PaymentHost, cfg.raw.PaymentPort)
 }
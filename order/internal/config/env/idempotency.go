@@ -0,0 +1,33 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderIdempotencyEnvConfig struct {
+	KeyTTL      time.Duration `env:"ORDER_IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+	LockTimeout time.Duration `env:"ORDER_IDEMPOTENCY_LOCK_TIMEOUT" envDefault:"1m"`
+}
+
+type orderIdempotencyConfig struct {
+	raw orderIdempotencyEnvConfig
+}
+
+func NewOrderIdempotencyConfig() (*orderIdempotencyConfig, error) {
+	var raw orderIdempotencyEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderIdempotencyConfig{raw: raw}, nil
+}
+
+func (cfg *orderIdempotencyConfig) KeyTTL() time.Duration {
+	return cfg.raw.KeyTTL
+}
+
+func (cfg *orderIdempotencyConfig) LockTimeout() time.Duration {
+	return cfg.raw.LockTimeout
+}
@@ -0,0 +1,36 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderCancelledProducerEnvConfig struct {
+	TopicName string `env:"ORDER_CANCELLED_TOPIC_NAME,required"`
+}
+
+type orderCancelledProducerConfig struct {
+	raw orderCancelledProducerEnvConfig
+}
+
+func NewOrderCancelledProducerConfig() (*orderCancelledProducerConfig, error) {
+	var raw orderCancelledProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCancelledProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCancelledProducerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+// Config возвращает конфигурацию для sarama producer
+func (cfg *orderCancelledProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
@@ -0,0 +1,36 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderCreatedProducerEnvConfig struct {
+	TopicName string `env:"ORDER_CREATED_TOPIC_NAME,required"`
+}
+
+type orderCreatedProducerConfig struct {
+	raw orderCreatedProducerEnvConfig
+}
+
+func NewOrderCreatedProducerConfig() (*orderCreatedProducerConfig, error) {
+	var raw orderCreatedProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCreatedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCreatedProducerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+// Config возвращает конфигурацию для sarama producer
+func (cfg *orderCreatedProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
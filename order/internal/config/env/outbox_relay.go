@@ -0,0 +1,48 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderOutboxRelayEnvConfig struct {
+	PollInterval  time.Duration `env:"ORDER_OUTBOX_POLL_INTERVAL" envDefault:"1s"`
+	BatchSize     int           `env:"ORDER_OUTBOX_BATCH_SIZE" envDefault:"100"`
+	MaxRetryDelay time.Duration `env:"ORDER_OUTBOX_MAX_RETRY_DELAY" envDefault:"1m"`
+	Retention     time.Duration `env:"ORDER_OUTBOX_RETENTION" envDefault:"168h"`
+	PurgeInterval time.Duration `env:"ORDER_OUTBOX_PURGE_INTERVAL" envDefault:"1h"`
+}
+
+type orderOutboxRelayConfig struct {
+	raw orderOutboxRelayEnvConfig
+}
+
+func NewOrderOutboxRelayConfig() (*orderOutboxRelayConfig, error) {
+	var raw orderOutboxRelayEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderOutboxRelayConfig{raw: raw}, nil
+}
+
+func (cfg *orderOutboxRelayConfig) PollInterval() time.Duration {
+	return cfg.raw.PollInterval
+}
+
+func (cfg *orderOutboxRelayConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
+
+func (cfg *orderOutboxRelayConfig) MaxRetryDelay() time.Duration {
+	return cfg.raw.MaxRetryDelay
+}
+
+func (cfg *orderOutboxRelayConfig) Retention() time.Duration {
+	return cfg.raw.Retention
+}
+
+func (cfg *orderOutboxRelayConfig) PurgeInterval() time.Duration {
+	return cfg.raw.PurgeInterval
+}
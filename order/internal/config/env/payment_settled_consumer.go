@@ -0,0 +1,41 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentSettledConsumerEnvConfig struct {
+	Topic   string `env:"PAYMENT_SETTLED_TOPIC_NAME,required"`
+	GroupID string `env:"PAYMENT_SETTLED_CONSUMER_GROUP_ID,required"`
+}
+
+type paymentSettledConsumerConfig struct {
+	raw paymentSettledConsumerEnvConfig
+}
+
+func NewPaymentSettledConsumerConfig() (*paymentSettledConsumerConfig, error) {
+	var raw paymentSettledConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentSettledConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *paymentSettledConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *paymentSettledConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *paymentSettledConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	return config
+}
@@ -0,0 +1,38 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderProcessedEventsEnvConfig struct {
+	ClaimTTL      time.Duration `env:"ORDER_PROCESSED_EVENTS_CLAIM_TTL" envDefault:"5m"`
+	Retention     time.Duration `env:"ORDER_PROCESSED_EVENTS_RETENTION" envDefault:"168h"`
+	PurgeInterval time.Duration `env:"ORDER_PROCESSED_EVENTS_PURGE_INTERVAL" envDefault:"1h"`
+}
+
+type orderProcessedEventsConfig struct {
+	raw orderProcessedEventsEnvConfig
+}
+
+func NewOrderProcessedEventsConfig() (*orderProcessedEventsConfig, error) {
+	var raw orderProcessedEventsEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderProcessedEventsConfig{raw: raw}, nil
+}
+
+func (cfg *orderProcessedEventsConfig) ClaimTTL() time.Duration {
+	return cfg.raw.ClaimTTL
+}
+
+func (cfg *orderProcessedEventsConfig) Retention() time.Duration {
+	return cfg.raw.Retention
+}
+
+func (cfg *orderProcessedEventsConfig) PurgeInterval() time.Duration {
+	return cfg.raw.PurgeInterval
+}
@@ -34,6 +34,7 @@ type HTTPConfig interface {
 
 type GRPCClientConfig interface {
 	InventoryAddress() string
+	InventoryServiceToken() string
 	PaymentAddress() string
 	IAMAddress() string
 }
@@ -52,8 +53,56 @@ type OrderPaidProducerConfig interface {
 	Config() *sarama.Config
 }
 
+type OrderCreatedProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
+}
+
+type OrderCancelledProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
+}
+
 type OrderAssembledConsumerConfig interface {
 	Topic() string
 	GroupID() string
 	Config() *sarama.Config
 }
+
+type PaymentSettledConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
+// OutboxRelayConfig holds how the relay publishes outbox messages
+// and how long sent messages are kept before they are deleted.
+type OutboxRelayConfig interface {
+	PollInterval() time.Duration
+	BatchSize() int
+	MaxRetryDelay() time.Duration
+	Retention() time.Duration
+	PurgeInterval() time.Duration
+}
+
+// ProcessedEventsConfig holds how long a consumer may hold a claim of an event
+// and how long processed events are kept to detect redeliveries.
+type ProcessedEventsConfig interface {
+	ClaimTTL() time.Duration
+	Retention() time.Duration
+	PurgeInterval() time.Duration
+}
+
+type IdempotencyConfig interface {
+	KeyTTL() time.Duration
+	LockTimeout() time.Duration
+}
+
+type ConsumerRetryConfig interface {
+	MaxAttempts() int
+	InitialBackoff() time.Duration
+	MaxBackoff() time.Duration
+	RetryTopicDelay() time.Duration
+	RetryTopicMaxAttempts() int
+	ProducerConfig() *sarama.Config
+}
@@ -6,19 +6,40 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
 	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
 )
 
-type decoder struct{}
+type decoder struct {
+	registry *kafka.DecoderRegistry
+}
 
 func NewOrderAssembledDecoder() *decoder {
-	return &decoder{}
+	return &decoder{
+		registry: kafka.NewDecoderRegistry().
+			Register(model.EventTypeShipAssembled, model.ShipAssembledSchemaVersion, decodeShipAssembledV1).
+			WithLegacyEventType(model.EventTypeShipAssembled),
+	}
+}
+
+func (d *decoder) Decode(msg kafka.Message) (model.OrderAssembledEvent, error) {
+	event, err := d.registry.Decode(msg)
+	if err != nil {
+		return model.OrderAssembledEvent{}, err
+	}
+
+	payload, ok := event.Payload.(model.OrderAssembledEvent)
+	if !ok {
+		return model.OrderAssembledEvent{}, fmt.Errorf("%w: %s", kafka.ErrUnknownEventType, event.Envelope.EventType)
+	}
+
+	return payload, nil
 }
 
-func (d *decoder) Decode(data []byte) (model.OrderAssembledEvent, error) {
+func decodeShipAssembledV1(data []byte) (any, error) {
 	var pb eventsV1.ShipAssembled
 	if err := proto.Unmarshal(data, &pb); err != nil {
-		return model.OrderAssembledEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal protobuf: %w", err)
 	}
 
 	return model.OrderAssembledEvent{
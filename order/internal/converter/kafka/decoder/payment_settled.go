@@ -0,0 +1,60 @@
+package decoder
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
+)
+
+type paymentSettledDecoder struct {
+	registry *kafka.DecoderRegistry
+}
+
+func NewPaymentSettledDecoder() *paymentSettledDecoder {
+	return &paymentSettledDecoder{
+		registry: kafka.NewDecoderRegistry().
+			Register(model.EventTypePaymentSettled, model.PaymentSettledSchemaVersion, decodePaymentSettledV1),
+	}
+}
+
+func (d *paymentSettledDecoder) Decode(msg kafka.Message) (model.PaymentSettledEvent, error) {
+	event, err := d.registry.Decode(msg)
+	if err != nil {
+		return model.PaymentSettledEvent{}, err
+	}
+
+	payload, ok := event.Payload.(model.PaymentSettledEvent)
+	if !ok {
+		return model.PaymentSettledEvent{}, fmt.Errorf("%w: %s", kafka.ErrUnknownEventType, event.Envelope.EventType)
+	}
+
+	return payload, nil
+}
+
+func decodePaymentSettledV1(data []byte) (any, error) {
+	var pb eventsV1.PaymentSettled
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	amount, err := decimal.NewFromString(pb.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount %q: %w", pb.Amount, err)
+	}
+
+	return model.PaymentSettledEvent{
+		EventUUID:       pb.EventUuid,
+		OrderUUID:       pb.OrderUuid,
+		TransactionUUID: pb.TransactionUuid,
+		AttemptKey:      pb.AttemptKey,
+		Status:          pb.Status,
+		PaymentMethod:   model.PaymentMethod(pb.PaymentMethod),
+		Amount:          amount,
+		Currency:        pb.Currency,
+	}, nil
+}
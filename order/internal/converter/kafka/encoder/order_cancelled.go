@@ -0,0 +1,30 @@
+package encoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
+)
+
+type orderCancelledEncoder struct{}
+
+func NewOrderCancelledEncoder() *orderCancelledEncoder {
+	return &orderCancelledEncoder{}
+}
+
+func (e *orderCancelledEncoder) Encode(event model.OrderCancelledEvent) ([]byte, error) {
+	payload, err := proto.Marshal(&eventsV1.OrderCancelled{
+		EventUuid: event.EventUUID,
+		OrderUuid: event.OrderUUID,
+		UserUuid:  event.UserUUID,
+		Reason:    event.Reason,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,42 @@
+package encoder
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
+)
+
+type orderCreatedEncoder struct{}
+
+func NewOrderCreatedEncoder() *orderCreatedEncoder {
+	return &orderCreatedEncoder{}
+}
+
+func (e *orderCreatedEncoder) Encode(event model.OrderCreatedEvent) ([]byte, error) {
+	items := make([]*eventsV1.OrderCreatedItem, 0, len(event.Items))
+	for _, item := range event.Items {
+		items = append(items, &eventsV1.OrderCreatedItem{
+			PartUuid:  item.PartUUID,
+			Quantity:  int64(item.Quantity),
+			UnitPrice: decimal.NewFromFloat(item.UnitPrice).StringFixed(2),
+		})
+	}
+
+	payload, err := proto.Marshal(&eventsV1.OrderCreated{
+		EventUuid:   event.EventUUID,
+		OrderUuid:   event.OrderUUID,
+		UserUuid:    event.UserUUID,
+		Items:       items,
+		TotalAmount: event.TotalAmount.StringFixed(2),
+		Currency:    event.Currency,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	return payload, nil
+}
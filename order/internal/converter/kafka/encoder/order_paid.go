@@ -0,0 +1,33 @@
+package encoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
+)
+
+type encoder struct{}
+
+func NewOrderPaidEncoder() *encoder {
+	return &encoder{}
+}
+
+func (e *encoder) Encode(event model.OrderPaidEvent) ([]byte, error) {
+	payload, err := proto.Marshal(&eventsV1.OrderPaid{
+		EventUuid:       event.EventUUID,
+		OrderUuid:       event.OrderUUID,
+		UserUuid:        event.UserUUID,
+		PaymentMethod:   event.PaymentMethod,
+		TransactionUuid: event.TransactionUUID,
+		Amount:          event.Amount.StringFixed(2),
+		Currency:        event.Currency,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	return payload, nil
+}
@@ -1,7 +1,26 @@
 package kafka
 
-import "github.com/dexguitar/spacecraftory/order/internal/model"
+import (
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
 
 type OrderAssembledDecoder interface {
-	Decode(data []byte) (model.OrderAssembledEvent, error)
+	Decode(msg kafka.Message) (model.OrderAssembledEvent, error)
+}
+
+type PaymentSettledDecoder interface {
+	Decode(msg kafka.Message) (model.PaymentSettledEvent, error)
+}
+
+type OrderPaidEncoder interface {
+	Encode(event model.OrderPaidEvent) ([]byte, error)
+}
+
+type OrderCreatedEncoder interface {
+	Encode(event model.OrderCreatedEvent) ([]byte, error)
+}
+
+type OrderCancelledEncoder interface {
+	Encode(event model.OrderCancelledEvent) ([]byte, error)
 }
@@ -1,6 +1,10 @@
 package converter
 
 import (
+	"encoding/base64"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 
 	"github.com/dexguitar/spacecraftory/order/internal/model"
@@ -28,10 +32,18 @@ func ToDtoOrder(serviceOrder *model.Order) *orderV1.OrderDto {
 		}
 	}
 
+	items := make([]orderV1.OrderLine, 0, len(serviceOrder.Items))
+	for _, item := range serviceOrder.Items {
+		if line, ok := ToDtoOrderLine(item); ok {
+			items = append(items, line)
+		}
+	}
+
 	dto := &orderV1.OrderDto{
 		OrderUUID:  orderUUID,
 		UserUUID:   userUUID,
 		PartUuids:  partUUIDs,
+		Items:      items,
 		TotalPrice: serviceOrder.TotalPrice,
 		Status:     ToDtoStatus(serviceOrder.OrderStatus),
 	}
@@ -56,9 +68,90 @@ func ToDtoOrder(serviceOrder *model.Order) *orderV1.OrderDto {
 		}
 	}
 
+	if !serviceOrder.CreatedAt.IsZero() {
+		dto.CreatedAt = orderV1.NewOptDateTime(serviceOrder.CreatedAt)
+	}
+
 	return dto
 }
 
+func ToModelStatus(status orderV1.OrderStatus) model.OrderStatus {
+	switch status {
+	case orderV1.OrderStatusPENDINGPAYMENT:
+		return model.OrderStatusPENDINGPAYMENT
+	case orderV1.OrderStatusPAID:
+		return model.OrderStatusPAID
+	case orderV1.OrderStatusCANCELLED:
+		return model.OrderStatusCANCELLED
+	case orderV1.OrderStatusASSEMBLED:
+		return model.OrderStatusASSEMBLED
+	case orderV1.OrderStatusPARTIALLYREFUNDED:
+		return model.OrderStatusPARTIALLYREFUNDED
+	case orderV1.OrderStatusREFUNDED:
+		return model.OrderStatusREFUNDED
+	default:
+		return model.OrderStatusUNKNOWN
+	}
+}
+
+func ToDtoOrderLine(item model.OrderItem) (orderV1.OrderLine, bool) {
+	partUUID, err := uuid.Parse(item.PartUUID)
+	if err != nil {
+		return orderV1.OrderLine{}, false
+	}
+
+	line := orderV1.OrderLine{
+		PartUUID: partUUID,
+		Quantity: item.Quantity,
+	}
+
+	// snapshot fields are empty for orders created before snapshots were stored
+	if item.Name != "" || item.UnitPrice != 0 {
+		line.Name = orderV1.NewOptString(item.Name)
+		line.UnitPrice = orderV1.NewOptFloat64(item.UnitPrice)
+	}
+	if item.Category != "" {
+		line.Category = orderV1.NewOptPartCategory(ToDtoPartCategory(item.Category))
+	}
+
+	return line, true
+}
+
+func ToDtoPartCategory(category model.PartCategory) orderV1.PartCategory {
+	switch category {
+	case model.PartCategoryEngine:
+		return orderV1.PartCategoryENGINE
+	case model.PartCategoryFuel:
+		return orderV1.PartCategoryFUEL
+	case model.PartCategoryPorthole:
+		return orderV1.PartCategoryPORTHOLE
+	case model.PartCategoryWing:
+		return orderV1.PartCategoryWING
+	default:
+		return orderV1.PartCategoryUNKNOWN
+	}
+}
+
+// ToModelOrderItems merges request line items with the legacy flat part list,
+// where every part UUID counts as one unit
+func ToModelOrderItems(items []orderV1.OrderItem, partUUIDs []uuid.UUID) []model.OrderItem {
+	result := make([]model.OrderItem, 0, len(items)+len(partUUIDs))
+	for _, item := range items {
+		result = append(result, model.OrderItem{
+			PartUUID: item.PartUUID.String(),
+			Quantity: item.Quantity,
+		})
+	}
+	for _, partUUID := range partUUIDs {
+		result = append(result, model.OrderItem{
+			PartUUID: partUUID.String(),
+			Quantity: 1,
+		})
+	}
+
+	return result
+}
+
 func ToDtoStatus(status model.OrderStatus) orderV1.OrderStatus {
 	switch status {
 	case model.OrderStatusPENDINGPAYMENT:
@@ -69,6 +162,10 @@ func ToDtoStatus(status model.OrderStatus) orderV1.OrderStatus {
 		return orderV1.OrderStatusCANCELLED
 	case model.OrderStatusASSEMBLED:
 		return orderV1.OrderStatusASSEMBLED
+	case model.OrderStatusPARTIALLYREFUNDED:
+		return orderV1.OrderStatusPARTIALLYREFUNDED
+	case model.OrderStatusREFUNDED:
+		return orderV1.OrderStatusREFUNDED
 	default:
 		return orderV1.OrderStatusUNKNOWN
 	}
@@ -111,3 +208,64 @@ func ToProtoTransactionUUID(transactionUUID string) uuid.UUID {
 	}
 	return txUUID
 }
+
+// EncodeOrderCursor turns a keyset position into an opaque page token
+func EncodeOrderCursor(cursor *model.OrderCursor) string {
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.OrderUUID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeOrderCursor parses a page token produced by EncodeOrderCursor
+func DecodeOrderCursor(token string) (*model.OrderCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, model.ErrBadRequest
+	}
+
+	createdAt, orderUUID, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, model.ErrBadRequest
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, model.ErrBadRequest
+	}
+	if _, err = uuid.Parse(orderUUID); err != nil {
+		return nil, model.ErrBadRequest
+	}
+
+	return &model.OrderCursor{
+		CreatedAt: t,
+		OrderUUID: orderUUID,
+	}, nil
+}
+
+func ToDtoOrderStatusHistory(history []*model.OrderStatusTransition) *orderV1.OrderStatusHistoryResponse {
+	transitions := make([]orderV1.OrderStatusTransition, 0, len(history))
+	for _, transition := range history {
+		dto := orderV1.OrderStatusTransition{
+			ToStatus:  ToDtoStatus(transition.To),
+			Actor:     transition.Actor,
+			Reason:    transition.Reason,
+			CreatedAt: transition.CreatedAt,
+		}
+		if transition.From != "" {
+			dto.FromStatus = orderV1.NewOptOrderStatus(ToDtoStatus(transition.From))
+		}
+		transitions = append(transitions, dto)
+	}
+
+	return &orderV1.OrderStatusHistoryResponse{
+		Transitions: transitions,
+	}
+}
+
+func ToDtoRefund(refund *model.Refund) *orderV1.RefundOrderResponse {
+	return &orderV1.RefundOrderResponse{
+		RefundUUID:  ToProtoTransactionUUID(refund.RefundUUID),
+		Amount:      refund.Amount.StringFixed(2),
+		Currency:    refund.Currency,
+		OrderStatus: ToDtoStatus(refund.OrderStatus()),
+	}
+}
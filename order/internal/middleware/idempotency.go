@@ -0,0 +1,169 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/order/internal/service"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+	httpAuth "github.com/dexguitar/spacecraftory/platform/pkg/middleware/http"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a response replayed from a previous request with the key
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+const ordersPath = "/api/v1/orders"
+
+// Idempotency replays the stored response to retries of order creation, payment and refund
+// made with the same Idempotency-Key. Must run after authentication, keys are scoped to the user.
+func Idempotency(idempotencyService service.IdempotencyService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			user, ok := httpAuth.GetUserFromContext(r.Context())
+			if key == "" || !ok || !isIdempotentRoute(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "Failed to read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			request := model.IdempotentRequest{
+				UserUUID:    user.GetUuid(),
+				Key:         key,
+				RequestHash: requestHash(r, body),
+				ClaimUUID:   uuid.NewString(),
+			}
+
+			stored, err := idempotencyService.Begin(r.Context(), request)
+			switch {
+			case errors.Is(err, model.ErrIdempotencyKeyMismatch):
+				writeError(w, http.StatusUnprocessableEntity, "Idempotency-Key was already used with a different request")
+				return
+			case errors.Is(err, model.ErrIdempotencyKeyInProgress):
+				writeError(w, http.StatusConflict, "Request with this Idempotency-Key is in progress")
+				return
+			case err != nil:
+				logger.Error(r.Context(), "failed to claim idempotency key", zap.Error(err))
+				writeError(w, http.StatusInternalServerError, "Internal server error")
+				return
+			case stored != nil:
+				replay(w, stored)
+				return
+			}
+
+			// the outcome is stored even if the client gave up waiting for it
+			ctx := context.WithoutCancel(r.Context())
+			recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			defer func() {
+				if p := recover(); p != nil {
+					if err := idempotencyService.Abort(ctx, request); err != nil {
+						logger.Error(ctx, "failed to release idempotency key", zap.Error(err))
+					}
+					panic(p)
+				}
+			}()
+
+			next.ServeHTTP(recorder, r)
+
+			err = idempotencyService.Complete(ctx, request, model.IdempotentResponse{
+				StatusCode:  recorder.statusCode,
+				ContentType: recorder.Header().Get("Content-Type"),
+				Body:        recorder.body.Bytes(),
+			})
+			if err != nil {
+				logger.Error(ctx, "failed to store idempotent response", zap.Error(err))
+			}
+		})
+	}
+}
+
+// isIdempotentRoute reports whether the request creates, pays for or refunds an order
+func isIdempotentRoute(r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		return false
+	}
+	if r.URL.Path == ordersPath {
+		return true
+	}
+
+	orderPath, ok := strings.CutPrefix(r.URL.Path, ordersPath+"/")
+	if !ok {
+		return false
+	}
+	for _, action := range []string{"/pay", "/refund"} {
+		if orderUUID, ok := strings.CutSuffix(orderPath, action); ok {
+			return orderUUID != "" && !strings.Contains(orderUUID, "/")
+		}
+	}
+	return false
+}
+
+// requestHash identifies the request a key is used with: the same key on another
+// route or with another body is a different request
+func requestHash(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func replay(w http.ResponseWriter, response *model.IdempotentResponse) {
+	if response.ContentType != "" {
+		w.Header().Set("Content-Type", response.ContentType)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(response.StatusCode)
+	w.Write(response.Body) //nolint:errcheck,gosec
+}
+
+// writeError writes an error in the format of the API error responses
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]any{ //nolint:errcheck,gosec
+		"code":    statusCode,
+		"message": message,
+	})
+}
+
+// responseRecorder passes the response through and keeps a copy of it
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if !r.wroteHeader {
+		r.statusCode = statusCode
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(p []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(p)
+	return r.ResponseWriter.Write(p)
+}
@@ -0,0 +1,202 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/order/internal/service/mocks"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+	grpcAuth "github.com/dexguitar/spacecraftory/platform/pkg/middleware/grpc"
+	commonV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/common/v1"
+)
+
+const (
+	testUserUUID = "123e4567-e89b-12d3-a456-426614174012"
+	testKey      = "5f0c6a8e-3c1f-4a0e-9a43-2b9f1d7c8e21"
+	testPayPath  = "/api/v1/orders/123e4567-e89b-12d3-a456-426614174000/pay"
+	testPayBody  = `{"payment_method":"CARD"}`
+)
+
+type IdempotencyMiddlewareSuite struct {
+	suite.Suite
+	idempotencyService *mocks.IdempotencyService
+	handlerCalls       int
+	handler            http.Handler
+}
+
+func (s *IdempotencyMiddlewareSuite) SetupTest() {
+	logger.SetNopLogger()
+
+	s.idempotencyService = mocks.NewIdempotencyService(s.T())
+	s.handlerCalls = 0
+	s.handler = Idempotency(s.idempotencyService)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handlerCalls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"transaction_uuid":"txn-123"}`)) //nolint:errcheck,gosec
+	}))
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	suite.Run(t, new(IdempotencyMiddlewareSuite))
+}
+
+func newRequest(path, body, key string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+	if key != "" {
+		r.Header.Set(IdempotencyKeyHeader, key)
+	}
+	ctx := grpcAuth.AddUserToContext(r.Context(), &commonV1.User{Uuid: testUserUUID})
+	return r.WithContext(ctx)
+}
+
+// matchRequest matches the request the key is claimed for, ClaimUUID differs on every call
+func matchRequest(path, body string) any {
+	expectedHash := requestHash(httptest.NewRequest(http.MethodPost, path, nil), []byte(body))
+	return mock.MatchedBy(func(request model.IdempotentRequest) bool {
+		return request.UserUUID == testUserUUID &&
+			request.Key == testKey &&
+			request.RequestHash == expectedHash &&
+			request.ClaimUUID != ""
+	})
+}
+
+func (s *IdempotencyMiddlewareSuite) TestRequestHash() {
+	pay := httptest.NewRequest(http.MethodPost, testPayPath, nil)
+	refund := httptest.NewRequest(http.MethodPost, "/api/v1/orders/123e4567-e89b-12d3-a456-426614174000/refund", nil)
+
+	assert.Equal(s.T(), requestHash(pay, []byte(testPayBody)), requestHash(pay, []byte(testPayBody)))
+	assert.NotEqual(s.T(), requestHash(pay, []byte(testPayBody)), requestHash(pay, []byte(`{"payment_method":"SBP"}`)))
+	assert.NotEqual(s.T(), requestHash(pay, []byte(testPayBody)), requestHash(refund, []byte(testPayBody)))
+}
+
+func (s *IdempotencyMiddlewareSuite) TestIsIdempotentRoute() {
+	testCases := []struct {
+		method   string
+		path     string
+		expected bool
+	}{
+		{method: http.MethodPost, path: "/api/v1/orders", expected: true},
+		{method: http.MethodPost, path: testPayPath, expected: true},
+		{method: http.MethodPost, path: "/api/v1/orders/123e4567-e89b-12d3-a456-426614174000/refund", expected: true},
+		{method: http.MethodPost, path: "/api/v1/orders/123e4567-e89b-12d3-a456-426614174000/cancel", expected: false},
+		{method: http.MethodPost, path: "/api/v1/orders//pay", expected: false},
+		{method: http.MethodPost, path: "/api/v1/orders/a/b/pay", expected: false},
+		{method: http.MethodGet, path: "/api/v1/orders", expected: false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.method+" "+tc.path, func() {
+			assert.Equal(s.T(), tc.expected, isIdempotentRoute(httptest.NewRequest(tc.method, tc.path, nil)))
+		})
+	}
+}
+
+func (s *IdempotencyMiddlewareSuite) TestWithoutKey() {
+	w := httptest.NewRecorder()
+
+	s.handler.ServeHTTP(w, newRequest(testPayPath, testPayBody, ""))
+
+	assert.Equal(s.T(), http.StatusOK, w.Code)
+	assert.Equal(s.T(), 1, s.handlerCalls)
+}
+
+func (s *IdempotencyMiddlewareSuite) TestFirstRequestStoresResponse() {
+	s.idempotencyService.On("Begin", mock.Anything, matchRequest(testPayPath, testPayBody)).
+		Return(nil, nil).Once()
+	s.idempotencyService.On("Complete", mock.Anything, matchRequest(testPayPath, testPayBody), model.IdempotentResponse{
+		StatusCode:  http.StatusOK,
+		ContentType: "application/json",
+		Body:        []byte(`{"transaction_uuid":"txn-123"}`),
+	}).Return(nil).Once()
+
+	w := httptest.NewRecorder()
+
+	s.handler.ServeHTTP(w, newRequest(testPayPath, testPayBody, testKey))
+
+	assert.Equal(s.T(), http.StatusOK, w.Code)
+	assert.Equal(s.T(), `{"transaction_uuid":"txn-123"}`, w.Body.String())
+	assert.Empty(s.T(), w.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(s.T(), 1, s.handlerCalls)
+}
+
+func (s *IdempotencyMiddlewareSuite) TestReplay() {
+	s.idempotencyService.On("Begin", mock.Anything, matchRequest(testPayPath, testPayBody)).
+		Return(&model.IdempotentResponse{
+			StatusCode:  http.StatusOK,
+			ContentType: "application/json",
+			Body:        []byte(`{"transaction_uuid":"txn-first"}`),
+		}, nil).Once()
+
+	w := httptest.NewRecorder()
+
+	s.handler.ServeHTTP(w, newRequest(testPayPath, testPayBody, testKey))
+
+	assert.Equal(s.T(), http.StatusOK, w.Code)
+	assert.Equal(s.T(), `{"transaction_uuid":"txn-first"}`, w.Body.String())
+	assert.Equal(s.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(s.T(), "true", w.Header().Get(IdempotentReplayedHeader))
+	assert.Zero(s.T(), s.handlerCalls)
+}
+
+func (s *IdempotencyMiddlewareSuite) TestBeginError() {
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{
+			name:         "Key used with a different request",
+			err:          model.ErrIdempotencyKeyMismatch,
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "First request in progress",
+			err:          model.ErrIdempotencyKeyInProgress,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "Storage error",
+			err:          errors.New("database connection failed"),
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.idempotencyService.On("Begin", mock.Anything, matchRequest(testPayPath, testPayBody)).
+				Return(nil, tc.err).Once()
+
+			w := httptest.NewRecorder()
+
+			s.handler.ServeHTTP(w, newRequest(testPayPath, testPayBody, testKey))
+
+			assert.Equal(s.T(), tc.expectedCode, w.Code)
+			assert.Contains(s.T(), w.Body.String(), `"code":`)
+			assert.Zero(s.T(), s.handlerCalls)
+		})
+	}
+}
+
+func (s *IdempotencyMiddlewareSuite) TestPanicReleasesKey() {
+	s.idempotencyService.On("Begin", mock.Anything, matchRequest(testPayPath, testPayBody)).
+		Return(nil, nil).Once()
+	s.idempotencyService.On("Abort", mock.Anything, matchRequest(testPayPath, testPayBody)).
+		Return(nil).Once()
+
+	handler := Idempotency(s.idempotencyService)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("handler failed")
+	}))
+
+	assert.PanicsWithValue(s.T(), "handler failed", func() {
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest(testPayPath, testPayBody, testKey))
+	})
+}
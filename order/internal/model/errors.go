@@ -3,12 +3,29 @@ package model
 import "errors"
 
 var (
-	ErrOrderNotFound       = errors.New("order not found")
-	ErrOrderAlreadyPaid    = errors.New("order already paid")
-	ErrOrderNotPaid        = errors.New("order not paid yet")
-	ErrInvalidOrderStatus  = errors.New("invalid order status")
-	ErrBadRequest          = errors.New("bad request")
-	ErrPartsNotFound       = errors.New("some parts were not found")
-	ErrPaymentFailed       = errors.New("payment failed")
-	ErrInternalServerError = errors.New("internal server error")
+	ErrOrderNotFound        = errors.New("order not found")
+	ErrOrderAlreadyPaid     = errors.New("order already paid")
+	ErrOrderNotPaid         = errors.New("order not paid yet")
+	ErrInvalidOrderStatus   = errors.New("invalid order status")
+	ErrOrderConflict        = errors.New("order was modified concurrently")
+	ErrBadRequest           = errors.New("bad request")
+	ErrPartsNotFound        = errors.New("some parts were not found")
+	ErrPaymentFailed        = errors.New("payment failed")
+	ErrPaymentDeclined      = errors.New("payment was declined")
+	ErrPaymentPending       = errors.New("payment is awaiting confirmation")
+	ErrPaymentTimeout       = errors.New("payment provider did not respond in time")
+	ErrPaymentLimitExceeded = errors.New("order total exceeds payment method limit")
+	ErrPaymentAlreadyExists = errors.New("order was already paid with different details")
+	ErrRefundFailed         = errors.New("refund failed")
+	ErrRefundExceedsPayment = errors.New("refund exceeds the amount left on the payment")
+	ErrInternalServerError  = errors.New("internal server error")
+	ErrUnauthenticated      = errors.New("unauthenticated")
+	ErrForbidden            = errors.New("forbidden")
+	ErrInsufficientStock    = errors.New("not enough parts in stock")
+	ErrReservationNotFound  = errors.New("reservation not found")
+	ErrReservationExists    = errors.New("reservation already exists")
+	ErrUnknownEventType     = errors.New("unknown event type")
+
+	ErrIdempotencyKeyMismatch   = errors.New("idempotency key was used with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("request with the idempotency key is in progress")
 )
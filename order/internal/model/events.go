@@ -1,11 +1,55 @@
 package model
 
+import "github.com/shopspring/decimal"
+
+// Event types and schema versions carried in the Kafka event envelope
+const (
+	EventTypeShipAssembled  = "ShipAssembled"
+	EventTypePaymentSettled = "PaymentSettled"
+
+	OrderPaidSchemaVersion      = 1
+	OrderCreatedSchemaVersion   = 2
+	OrderCancelledSchemaVersion = 1
+	ShipAssembledSchemaVersion  = 1
+	PaymentSettledSchemaVersion = 1
+)
+
+// Final statuses of a payment announced with PaymentSettled
+const (
+	PaymentStatusSUCCEEDED = "SUCCEEDED"
+	PaymentStatusDECLINED  = "DECLINED"
+)
+
+// Reasons sent with OrderCancelled
+const (
+	CancelReasonUserRequest  = "cancelled by user"
+	CancelReasonAdminRequest = "cancelled by administrator"
+)
+
 type OrderPaidEvent struct {
 	EventUUID       string
 	OrderUUID       string
 	UserUUID        string
 	PaymentMethod   string
 	TransactionUUID string
+	Amount          decimal.Decimal
+	Currency        string
+}
+
+type OrderCreatedEvent struct {
+	EventUUID   string
+	OrderUUID   string
+	UserUUID    string
+	Items       []OrderItem
+	TotalAmount decimal.Decimal
+	Currency    string
+}
+
+type OrderCancelledEvent struct {
+	EventUUID string
+	OrderUUID string
+	UserUUID  string
+	Reason    string
 }
 
 type OrderAssembledEvent struct {
@@ -14,3 +58,16 @@ type OrderAssembledEvent struct {
 	UserUUID     string
 	BuildTimeSec int64
 }
+
+// PaymentSettledEvent announces the final status of a charge the payment service
+// could not confirm while the order was being paid
+type PaymentSettledEvent struct {
+	EventUUID       string
+	OrderUUID       string
+	TransactionUUID string
+	AttemptKey      string
+	Status          string
+	PaymentMethod   PaymentMethod
+	Amount          decimal.Decimal
+	Currency        string
+}
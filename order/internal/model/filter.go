@@ -1,5 +1,32 @@
 package model
 
+import "time"
+
 type PartsFilter struct {
 	UUIDs []string
 }
+
+// OrderFilter describes a page of orders; empty fields are not filtered on
+type OrderFilter struct {
+	// UserUUID limits orders to one user, nil lists orders of all users
+	UserUUID       *string
+	Statuses       []OrderStatus
+	PaymentMethods []PaymentMethod
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	Limit          int
+	// After continues the listing right after the given position
+	After *OrderCursor
+}
+
+// OrderCursor is a keyset position in the (created_at, id) ordering
+type OrderCursor struct {
+	CreatedAt time.Time
+	OrderUUID string
+}
+
+type OrderPage struct {
+	Orders []*Order
+	// Next is nil on the last page
+	Next *OrderCursor
+}
@@ -0,0 +1,26 @@
+package model
+
+// IdempotentRequest is a request made with an Idempotency-Key. Keys are scoped
+// to the user, RequestHash identifies the request the key was first used with.
+// ClaimUUID identifies this execution of the request, a request whose claim was
+// taken over after the lock timeout can no longer store its response.
+type IdempotentRequest struct {
+	UserUUID    string
+	Key         string
+	RequestHash string
+	ClaimUUID   string
+}
+
+// IdempotentResponse is the response replayed to retries of an idempotent request
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// IdempotencyRecord is the stored state of an idempotency key.
+// Response is nil while the first request with the key is in progress.
+type IdempotencyRecord struct {
+	RequestHash string
+	Response    *IdempotentResponse
+}
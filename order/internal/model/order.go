@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type (
 	OrderStatus   string
 	PaymentMethod string
@@ -13,20 +15,44 @@ const (
 	PaymentMethodINVESTOR_MONEY PaymentMethod = "INVESTOR_MONEY"
 )
 
+// CurrencyRUB is the currency order prices are denominated in.
+const CurrencyRUB = "RUB"
+
 const (
 	OrderStatusUNKNOWN        OrderStatus = "UNKNOWN"
 	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
 	OrderStatusPAID           OrderStatus = "PAID"
 	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
 	OrderStatusASSEMBLED      OrderStatus = "ASSEMBLED"
+	// refunded orders are cancelled, PARTIALLY_REFUNDED ones still hold part of the payment
+	OrderStatusPARTIALLYREFUNDED OrderStatus = "PARTIALLY_REFUNDED"
+	OrderStatusREFUNDED          OrderStatus = "REFUNDED"
 )
 
 type Order struct {
 	OrderUUID       string
 	UserUUID        string
 	PartUUIDs       []string
+	Items           []OrderItem
 	TotalPrice      float64
 	OrderStatus     OrderStatus
 	TransactionUUID string
 	PaymentMethod   PaymentMethod
+	CreatedAt       time.Time
+	// Version is incremented on every update, updating an order read at an older
+	// version fails with ErrOrderConflict
+	Version int64
+}
+
+// OrderItem is one order line: a part and how many units of it were ordered.
+// Order.PartUUIDs holds the same parts without quantities.
+//
+// UnitPrice, Name and Category are a snapshot of the part taken when the order
+// was created; they are empty for orders created before snapshots were stored.
+type OrderItem struct {
+	PartUUID  string
+	Quantity  int
+	UnitPrice float64
+	Name      string
+	Category  PartCategory
 }
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// orderStatusTransitions is the order state machine: the statuses an order may move to
+// from each status. Statuses without an entry are final.
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPENDINGPAYMENT:    {OrderStatusPAID, OrderStatusCANCELLED},
+	OrderStatusPAID:              {OrderStatusASSEMBLED, OrderStatusPARTIALLYREFUNDED, OrderStatusREFUNDED},
+	OrderStatusPARTIALLYREFUNDED: {OrderStatusPARTIALLYREFUNDED, OrderStatusREFUNDED},
+}
+
+// Actors of status changes caused by other services
+const (
+	ActorAssembly = "assembly"
+	ActorPayment  = "payment"
+)
+
+// Reasons recorded in the status history, cancellations use the CancelReason constants
+const (
+	StatusReasonCreated   = "order created"
+	StatusReasonPaid      = "payment completed"
+	StatusReasonAssembled = "ship assembled"
+	StatusReasonRefunded  = "payment refunded"
+)
+
+// OrderStatusTransition is a status change of an order as recorded in its history.
+// From is empty for the status the order was created with.
+type OrderStatusTransition struct {
+	OrderUUID string
+	From      OrderStatus
+	To        OrderStatus
+	Actor     string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// CanTransitionTo reports whether the state machine allows moving from s to the status
+func (s OrderStatus) CanTransitionTo(to OrderStatus) bool {
+	return slices.Contains(orderStatusTransitions[s], to)
+}
+
+// TransitionTo moves the order to the status and returns the change to record,
+// or ErrInvalidOrderStatus if the state machine does not allow it
+func (o *Order) TransitionTo(to OrderStatus, actor, reason string) (*OrderStatusTransition, error) {
+	if !o.OrderStatus.CanTransitionTo(to) {
+		return nil, fmt.Errorf("%w: %s -> %s", ErrInvalidOrderStatus, o.OrderStatus, to)
+	}
+
+	transition := &OrderStatusTransition{
+		OrderUUID: o.OrderUUID,
+		From:      o.OrderStatus,
+		To:        to,
+		Actor:     actor,
+		Reason:    reason,
+	}
+	o.OrderStatus = to
+
+	return transition, nil
+}
+
+// InitialTransition is the history record of the status a new order is created with
+func (o *Order) InitialTransition(actor string) *OrderStatusTransition {
+	return &OrderStatusTransition{
+		OrderUUID: o.OrderUUID,
+		To:        o.OrderStatus,
+		Actor:     actor,
+		Reason:    StatusReasonCreated,
+	}
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Event types of outbox messages, sent in the event type header as well
+const (
+	OutboxEventOrderPaid      = "OrderPaid"
+	OutboxEventOrderCreated   = "OrderCreated"
+	OutboxEventOrderCancelled = "OrderCancelled"
+)
+
+// OutboxMessage is an event stored in the same transaction as the order change
+// it describes; the outbox relay publishes it to Kafka afterwards.
+type OutboxMessage struct {
+	ID        string
+	EventType string
+	Key       string
+	Payload   []byte
+	Headers   map[string]string
+	Attempts  int
+	CreatedAt time.Time
+}
@@ -1,7 +1,18 @@
 package model
 
+type PartCategory string
+
+const (
+	PartCategoryUnknown  PartCategory = "UNKNOWN"
+	PartCategoryEngine   PartCategory = "ENGINE"
+	PartCategoryFuel     PartCategory = "FUEL"
+	PartCategoryPorthole PartCategory = "PORTHOLE"
+	PartCategoryWing     PartCategory = "WING"
+)
+
 type Part struct {
-	UUID  string
-	Name  string
-	Price float64
+	UUID     string
+	Name     string
+	Price    float64
+	Category PartCategory
 }
@@ -0,0 +1,20 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+// Refund is a refund of an order payment made by the payment service.
+// PaymentRefunded reports whether nothing is left on the payment after it.
+type Refund struct {
+	RefundUUID      string
+	Amount          decimal.Decimal
+	Currency        string
+	PaymentRefunded bool
+}
+
+// OrderStatus is the status the refunded order moves to
+func (r *Refund) OrderStatus() OrderStatus {
+	if r.PaymentRefunded {
+		return OrderStatusREFUNDED
+	}
+	return OrderStatusPARTIALLYREFUNDED
+}
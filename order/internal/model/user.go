@@ -4,6 +4,7 @@ type User struct {
 	UUID     string
 	Info     UserInfo
 	Password string
+	Roles    []string
 }
 
 type UserInfo struct {
@@ -16,3 +17,17 @@ type NotificationMethod struct {
 	ProviderName string
 	Target       string
 }
+
+// RoleAdmin grants access to orders of every user
+const RoleAdmin = "admin"
+
+// Requester is the authenticated user an order operation is performed for
+type Requester struct {
+	UserUUID string
+	IsAdmin  bool
+}
+
+// CanAccess reports whether the requester may read or act on the order
+func (r Requester) CanAccess(order *Order) bool {
+	return r.IsAdmin || order.UserUUID == r.UserUUID
+}
@@ -27,6 +27,7 @@ func ToRepoOrder(serviceOrder *serviceModel.Order) *repoModel.Order {
 		Status:          serviceOrder.OrderStatus,
 		TransactionUUID: transactionUUID,
 		PaymentMethod:   paymentMethod,
+		CreatedAt:       serviceOrder.CreatedAt,
 	}
 }
 
@@ -49,9 +50,64 @@ func ToModelOrder(repoOrder *repoModel.Order) *serviceModel.Order {
 		OrderUUID:       repoOrder.OrderUUID,
 		UserUUID:        repoOrder.UserUUID,
 		PartUUIDs:       []string{}, // Will be filled by repository
+		Items:           []serviceModel.OrderItem{},
 		TotalPrice:      repoOrder.TotalPrice,
 		OrderStatus:     repoOrder.Status,
 		TransactionUUID: transactionUUID,
 		PaymentMethod:   paymentMethod,
+		CreatedAt:       repoOrder.CreatedAt,
+		Version:         repoOrder.Version,
+	}
+}
+
+func ToModelOrderItem(repoPart repoModel.OrderPart) serviceModel.OrderItem {
+	item := serviceModel.OrderItem{
+		PartUUID: repoPart.PartID,
+		Quantity: repoPart.Quantity,
+	}
+
+	// snapshot columns are null for orders created before they were introduced
+	if repoPart.UnitPrice != nil {
+		item.UnitPrice = *repoPart.UnitPrice
+	}
+	if repoPart.Name != nil {
+		item.Name = *repoPart.Name
+	}
+	if repoPart.Category != nil {
+		item.Category = *repoPart.Category
+	}
+
+	return item
+}
+
+func ToModelOutboxMessage(repoMessage repoModel.OutboxMessage) *serviceModel.OutboxMessage {
+	return &serviceModel.OutboxMessage{
+		ID:        repoMessage.ID,
+		EventType: repoMessage.EventType,
+		Key:       repoMessage.Key,
+		Payload:   repoMessage.Payload,
+		Headers:   repoMessage.Headers,
+		Attempts:  repoMessage.Attempts,
+		CreatedAt: repoMessage.CreatedAt,
+	}
+}
+
+func ToModelOrderStatusTransition(repoTransition *repoModel.OrderStatusTransition) *serviceModel.OrderStatusTransition {
+	if repoTransition == nil {
+		return nil
+	}
+
+	from := serviceModel.OrderStatus("")
+	if repoTransition.From != nil {
+		from = *repoTransition.From
+	}
+
+	return &serviceModel.OrderStatusTransition{
+		OrderUUID: repoTransition.OrderUUID,
+		From:      from,
+		To:        repoTransition.To,
+		Actor:     repoTransition.Actor,
+		Reason:    repoTransition.Reason,
+		CreatedAt: repoTransition.CreatedAt,
 	}
 }
@@ -0,0 +1,130 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+// ClaimKey stores the key for the request unless a live record of it exists.
+// It returns nil when the key was claimed and the stored record otherwise.
+// An expired record is taken over as if it did not exist. So is the claim of
+// the same request that did not finish within the lock timeout, its process
+// is presumed dead.
+func (r *idempotencyRepository) ClaimKey(ctx context.Context, request model.IdempotentRequest, ttl, lockTimeout time.Duration) (*model.IdempotencyRecord, error) {
+	builderInsert := sq.
+		Insert("idempotency_keys").
+		PlaceholderFormat(sq.Dollar).
+		Columns("user_uuid", "key", "request_hash", "claim_uuid", "expires_at").
+		Values(request.UserUUID, request.Key, request.RequestHash, request.ClaimUUID, sq.Expr("now() + make_interval(secs => ?)", ttl.Seconds())).
+		Suffix(`ON CONFLICT (user_uuid, key) DO UPDATE SET
+			request_hash = excluded.request_hash,
+			claim_uuid = excluded.claim_uuid,
+			status_code = NULL,
+			content_type = NULL,
+			response_body = NULL,
+			created_at = now(),
+			claimed_at = now(),
+			expires_at = excluded.expires_at
+		WHERE idempotency_keys.expires_at < now()
+			OR (idempotency_keys.status_code IS NULL
+				AND idempotency_keys.request_hash = excluded.request_hash
+				AND idempotency_keys.claimed_at < now() - make_interval(secs => ?))
+		RETURNING key`, lockTimeout.Seconds())
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var key string
+	err = r.db.QueryRow(ctx, query, args...).Scan(&key)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	// the key has a live record
+	return r.getRecord(ctx, request.UserUUID, request.Key)
+}
+
+func (r *idempotencyRepository) getRecord(ctx context.Context, userUUID, key string) (*model.IdempotencyRecord, error) {
+	builderSelect := sq.
+		Select("request_hash", "status_code", "content_type", "response_body").
+		From("idempotency_keys").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"user_uuid": userUUID, "key": key})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		record      model.IdempotencyRecord
+		statusCode  *int
+		contentType *string
+		body        []byte
+	)
+	err = r.db.QueryRow(ctx, query, args...).Scan(&record.RequestHash, &statusCode, &contentType, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != nil {
+		record.Response = &model.IdempotentResponse{
+			StatusCode: *statusCode,
+			Body:       body,
+		}
+		if contentType != nil {
+			record.Response.ContentType = *contentType
+		}
+	}
+
+	return &record, nil
+}
+
+// SaveResponse stores the response of the request that claimed the key,
+// a request whose claim was taken over stores nothing
+func (r *idempotencyRepository) SaveResponse(ctx context.Context, request model.IdempotentRequest, response model.IdempotentResponse) error {
+	builderUpdate := sq.
+		Update("idempotency_keys").
+		PlaceholderFormat(sq.Dollar).
+		Set("status_code", response.StatusCode).
+		Set("content_type", response.ContentType).
+		Set("response_body", response.Body).
+		Where(sq.Eq{"user_uuid": request.UserUUID, "key": request.Key, "claim_uuid": request.ClaimUUID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	return err
+}
+
+// ReleaseKey drops the claim of a request that produced no response to replay,
+// so a retry with the key runs the request again
+func (r *idempotencyRepository) ReleaseKey(ctx context.Context, request model.IdempotentRequest) error {
+	builderDelete := sq.
+		Delete("idempotency_keys").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"user_uuid": request.UserUUID, "key": request.Key, "claim_uuid": request.ClaimUUID}).
+		Where(sq.Eq{"status_code": nil})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	return err
+}
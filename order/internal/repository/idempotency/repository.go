@@ -0,0 +1,15 @@
+package idempotency
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type idempotencyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewIdempotencyRepository(db *pgxpool.Pool) *idempotencyRepository {
+	return &idempotencyRepository{
+		db: db,
+	}
+}
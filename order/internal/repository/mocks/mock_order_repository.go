@@ -22,9 +22,9 @@ func (_m *OrderRepository) EXPECT() *OrderRepository_Expecter {
 	return &OrderRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateOrder provides a mock function with given fields: ctx, order
-func (_m *OrderRepository) CreateOrder(ctx context.Context, order *model.Order) (*model.Order, error) {
-	ret := _m.Called(ctx, order)
+// CreateOrder provides a mock function with given fields: ctx, order, transition
+func (_m *OrderRepository) CreateOrder(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition) (*model.Order, error) {
+	ret := _m.Called(ctx, order, transition)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
@@ -32,19 +32,19 @@ func (_m *OrderRepository) CreateOrder(ctx context.Context, order *model.Order)
 
 	var r0 *model.Order
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Order) (*model.Order, error)); ok {
-		return rf(ctx, order)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, *model.OrderStatusTransition) (*model.Order, error)); ok {
+		return rf(ctx, order, transition)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Order) *model.Order); ok {
-		r0 = rf(ctx, order)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, *model.OrderStatusTransition) *model.Order); ok {
+		r0 = rf(ctx, order, transition)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Order)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.Order) error); ok {
-		r1 = rf(ctx, order)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Order, *model.OrderStatusTransition) error); ok {
+		r1 = rf(ctx, order, transition)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,13 +60,14 @@ type OrderRepository_CreateOrder_Call struct {
 // CreateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - order *model.Order
-func (_e *OrderRepository_Expecter) CreateOrder(ctx interface{}, order interface{}) *OrderRepository_CreateOrder_Call {
-	return &OrderRepository_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, order)}
+//   - transition *model.OrderStatusTransition
+func (_e *OrderRepository_Expecter) CreateOrder(ctx interface{}, order interface{}, transition interface{}) *OrderRepository_CreateOrder_Call {
+	return &OrderRepository_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, order, transition)}
 }
 
-func (_c *OrderRepository_CreateOrder_Call) Run(run func(ctx context.Context, order *model.Order)) *OrderRepository_CreateOrder_Call {
+func (_c *OrderRepository_CreateOrder_Call) Run(run func(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition)) *OrderRepository_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Order))
+		run(args[0].(context.Context), args[1].(*model.Order), args[2].(*model.OrderStatusTransition))
 	})
 	return _c
 }
@@ -76,7 +77,68 @@ func (_c *OrderRepository_CreateOrder_Call) Return(_a0 *model.Order, _a1 error)
 	return _c
 }
 
-func (_c *OrderRepository_CreateOrder_Call) RunAndReturn(run func(context.Context, *model.Order) (*model.Order, error)) *OrderRepository_CreateOrder_Call {
+func (_c *OrderRepository_CreateOrder_Call) RunAndReturn(run func(context.Context, *model.Order, *model.OrderStatusTransition) (*model.Order, error)) *OrderRepository_CreateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrderWithOutbox provides a mock function with given fields: ctx, order, transition, message
+func (_m *OrderRepository) CreateOrderWithOutbox(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition, message *model.OutboxMessage) (*model.Order, error) {
+	ret := _m.Called(ctx, order, transition, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrderWithOutbox")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, *model.OrderStatusTransition, *model.OutboxMessage) (*model.Order, error)); ok {
+		return rf(ctx, order, transition, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, *model.OrderStatusTransition, *model.OutboxMessage) *model.Order); ok {
+		r0 = rf(ctx, order, transition, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Order, *model.OrderStatusTransition, *model.OutboxMessage) error); ok {
+		r1 = rf(ctx, order, transition, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_CreateOrderWithOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrderWithOutbox'
+type OrderRepository_CreateOrderWithOutbox_Call struct {
+	*mock.Call
+}
+
+// CreateOrderWithOutbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - transition *model.OrderStatusTransition
+//   - message *model.OutboxMessage
+func (_e *OrderRepository_Expecter) CreateOrderWithOutbox(ctx interface{}, order interface{}, transition interface{}, message interface{}) *OrderRepository_CreateOrderWithOutbox_Call {
+	return &OrderRepository_CreateOrderWithOutbox_Call{Call: _e.mock.On("CreateOrderWithOutbox", ctx, order, transition, message)}
+}
+
+func (_c *OrderRepository_CreateOrderWithOutbox_Call) Run(run func(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition, message *model.OutboxMessage)) *OrderRepository_CreateOrderWithOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Order), args[2].(*model.OrderStatusTransition), args[3].(*model.OutboxMessage))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_CreateOrderWithOutbox_Call) Return(_a0 *model.Order, _a1 error) *OrderRepository_CreateOrderWithOutbox_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_CreateOrderWithOutbox_Call) RunAndReturn(run func(context.Context, *model.Order, *model.OrderStatusTransition, *model.OutboxMessage) (*model.Order, error)) *OrderRepository_CreateOrderWithOutbox_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -140,17 +202,135 @@ func (_c *OrderRepository_GetOrder_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// UpdateOrder provides a mock function with given fields: ctx, order
-func (_m *OrderRepository) UpdateOrder(ctx context.Context, order *model.Order) error {
-	ret := _m.Called(ctx, order)
+// GetOrderStatusHistory provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderRepository) GetOrderStatusHistory(ctx context.Context, orderUUID string) ([]*model.OrderStatusTransition, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderStatusHistory")
+	}
+
+	var r0 []*model.OrderStatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.OrderStatusTransition, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.OrderStatusTransition); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderStatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_GetOrderStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderStatusHistory'
+type OrderRepository_GetOrderStatusHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderRepository_Expecter) GetOrderStatusHistory(ctx interface{}, orderUUID interface{}) *OrderRepository_GetOrderStatusHistory_Call {
+	return &OrderRepository_GetOrderStatusHistory_Call{Call: _e.mock.On("GetOrderStatusHistory", ctx, orderUUID)}
+}
+
+func (_c *OrderRepository_GetOrderStatusHistory_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderRepository_GetOrderStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_GetOrderStatusHistory_Call) Return(_a0 []*model.OrderStatusTransition, _a1 error) *OrderRepository_GetOrderStatusHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_GetOrderStatusHistory_Call) RunAndReturn(run func(context.Context, string) ([]*model.OrderStatusTransition, error)) *OrderRepository_GetOrderStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, filter
+func (_m *OrderRepository) ListOrders(ctx context.Context, filter *model.OrderFilter) ([]*model.Order, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []*model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.OrderFilter) ([]*model.Order, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.OrderFilter) []*model.Order); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.OrderFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderRepository_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *model.OrderFilter
+func (_e *OrderRepository_Expecter) ListOrders(ctx interface{}, filter interface{}) *OrderRepository_ListOrders_Call {
+	return &OrderRepository_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter)}
+}
+
+func (_c *OrderRepository_ListOrders_Call) Run(run func(ctx context.Context, filter *model.OrderFilter)) *OrderRepository_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.OrderFilter))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ListOrders_Call) Return(_a0 []*model.Order, _a1 error) *OrderRepository_ListOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_ListOrders_Call) RunAndReturn(run func(context.Context, *model.OrderFilter) ([]*model.Order, error)) *OrderRepository_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrder provides a mock function with given fields: ctx, order, transition
+func (_m *OrderRepository) UpdateOrder(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition) error {
+	ret := _m.Called(ctx, order, transition)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateOrder")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Order) error); ok {
-		r0 = rf(ctx, order)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, *model.OrderStatusTransition) error); ok {
+		r0 = rf(ctx, order, transition)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -166,13 +346,14 @@ type OrderRepository_UpdateOrder_Call struct {
 // UpdateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - order *model.Order
-func (_e *OrderRepository_Expecter) UpdateOrder(ctx interface{}, order interface{}) *OrderRepository_UpdateOrder_Call {
-	return &OrderRepository_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, order)}
+//   - transition *model.OrderStatusTransition
+func (_e *OrderRepository_Expecter) UpdateOrder(ctx interface{}, order interface{}, transition interface{}) *OrderRepository_UpdateOrder_Call {
+	return &OrderRepository_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, order, transition)}
 }
 
-func (_c *OrderRepository_UpdateOrder_Call) Run(run func(ctx context.Context, order *model.Order)) *OrderRepository_UpdateOrder_Call {
+func (_c *OrderRepository_UpdateOrder_Call) Run(run func(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition)) *OrderRepository_UpdateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Order))
+		run(args[0].(context.Context), args[1].(*model.Order), args[2].(*model.OrderStatusTransition))
 	})
 	return _c
 }
@@ -182,7 +363,56 @@ func (_c *OrderRepository_UpdateOrder_Call) Return(_a0 error) *OrderRepository_U
 	return _c
 }
 
-func (_c *OrderRepository_UpdateOrder_Call) RunAndReturn(run func(context.Context, *model.Order) error) *OrderRepository_UpdateOrder_Call {
+func (_c *OrderRepository_UpdateOrder_Call) RunAndReturn(run func(context.Context, *model.Order, *model.OrderStatusTransition) error) *OrderRepository_UpdateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrderWithOutbox provides a mock function with given fields: ctx, order, transition, message
+func (_m *OrderRepository) UpdateOrderWithOutbox(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition, message *model.OutboxMessage) error {
+	ret := _m.Called(ctx, order, transition, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderWithOutbox")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, *model.OrderStatusTransition, *model.OutboxMessage) error); ok {
+		r0 = rf(ctx, order, transition, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_UpdateOrderWithOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrderWithOutbox'
+type OrderRepository_UpdateOrderWithOutbox_Call struct {
+	*mock.Call
+}
+
+// UpdateOrderWithOutbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - transition *model.OrderStatusTransition
+//   - message *model.OutboxMessage
+func (_e *OrderRepository_Expecter) UpdateOrderWithOutbox(ctx interface{}, order interface{}, transition interface{}, message interface{}) *OrderRepository_UpdateOrderWithOutbox_Call {
+	return &OrderRepository_UpdateOrderWithOutbox_Call{Call: _e.mock.On("UpdateOrderWithOutbox", ctx, order, transition, message)}
+}
+
+func (_c *OrderRepository_UpdateOrderWithOutbox_Call) Run(run func(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition, message *model.OutboxMessage)) *OrderRepository_UpdateOrderWithOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Order), args[2].(*model.OrderStatusTransition), args[3].(*model.OutboxMessage))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_UpdateOrderWithOutbox_Call) Return(_a0 error) *OrderRepository_UpdateOrderWithOutbox_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_UpdateOrderWithOutbox_Call) RunAndReturn(run func(context.Context, *model.Order, *model.OrderStatusTransition, *model.OutboxMessage) error) *OrderRepository_UpdateOrderWithOutbox_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/dexguitar/spacecraftory/order/internal/model"
 )
 
@@ -11,4 +13,34 @@ type Order struct {
 	Status          model.OrderStatus    `db:"status"`
 	TransactionUUID *string              `db:"transaction_uuid"`
 	PaymentMethod   *model.PaymentMethod `db:"payment_method"`
+	CreatedAt       time.Time            `db:"created_at"`
+	Version         int64                `db:"version"`
+}
+
+type OrderPart struct {
+	OrderID   string              `db:"order_id"`
+	PartID    string              `db:"part_id"`
+	Quantity  int                 `db:"quantity"`
+	UnitPrice *float64            `db:"unit_price"`
+	Name      *string             `db:"name"`
+	Category  *model.PartCategory `db:"category"`
+}
+
+type OutboxMessage struct {
+	ID        string            `db:"id"`
+	EventType string            `db:"event_type"`
+	Key       string            `db:"event_key"`
+	Payload   []byte            `db:"payload"`
+	Headers   map[string]string `db:"headers"`
+	Attempts  int               `db:"attempts"`
+	CreatedAt time.Time         `db:"created_at"`
+}
+
+type OrderStatusTransition struct {
+	OrderUUID string             `db:"order_id"`
+	From      *model.OrderStatus `db:"from_status"`
+	To        model.OrderStatus  `db:"to_status"`
+	Actor     string             `db:"actor"`
+	Reason    string             `db:"reason"`
+	CreatedAt time.Time          `db:"created_at"`
 }
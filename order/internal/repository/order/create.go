@@ -12,10 +12,31 @@ import (
 )
 
 type orderID struct {
-	ID string `db:"id"`
+	ID      string `db:"id"`
+	Version int64  `db:"version"`
 }
 
-func (r *orderRepository) CreateOrder(ctx context.Context, order *serviceModel.Order) (*serviceModel.Order, error) {
+// CreateOrder stores the new order together with its initial status in the status history
+func (r *orderRepository) CreateOrder(ctx context.Context, order *serviceModel.Order, transition *serviceModel.OrderStatusTransition) (*serviceModel.Order, error) {
+	return r.createOrder(ctx, order, transition, nil)
+}
+
+// CreateOrderWithOutbox stores the new order and the event describing it atomically
+func (r *orderRepository) CreateOrderWithOutbox(
+	ctx context.Context,
+	order *serviceModel.Order,
+	transition *serviceModel.OrderStatusTransition,
+	message *serviceModel.OutboxMessage,
+) (*serviceModel.Order, error) {
+	return r.createOrder(ctx, order, transition, message)
+}
+
+func (r *orderRepository) createOrder(
+	ctx context.Context,
+	order *serviceModel.Order,
+	transition *serviceModel.OrderStatusTransition,
+	message *serviceModel.OutboxMessage,
+) (*serviceModel.Order, error) {
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return nil, err
@@ -27,12 +48,19 @@ func (r *orderRepository) CreateOrder(ctx context.Context, order *serviceModel.O
 		}
 	}()
 
-	// Create order in orders table
+	// Create order in orders table, id is generated unless the caller has chosen one
 	orderInsert := sq.Insert("orders").
 		PlaceholderFormat(sq.Dollar).
-		Columns("user_uuid", "total_price", "status").
-		Values(order.UserUUID, order.TotalPrice, order.OrderStatus).
-		Suffix("RETURNING id")
+		Suffix("RETURNING id, version")
+	if order.OrderUUID != "" {
+		orderInsert = orderInsert.
+			Columns("id", "user_uuid", "total_price", "status").
+			Values(order.OrderUUID, order.UserUUID, order.TotalPrice, order.OrderStatus)
+	} else {
+		orderInsert = orderInsert.
+			Columns("user_uuid", "total_price", "status").
+			Values(order.UserUUID, order.TotalPrice, order.OrderStatus)
+	}
 
 	query, args, err := orderInsert.ToSql()
 	if err != nil {
@@ -50,14 +78,14 @@ func (r *orderRepository) CreateOrder(ctx context.Context, order *serviceModel.O
 		return nil, err
 	}
 
-	// Insert parts into order_parts table
-	if len(order.PartUUIDs) > 0 {
+	// Insert order lines into order_parts table
+	if len(order.Items) > 0 {
 		partsInsert := sq.Insert("order_parts").
 			PlaceholderFormat(sq.Dollar).
-			Columns("order_id", "part_id")
+			Columns("order_id", "part_id", "quantity", "unit_price", "name", "category")
 
-		for _, partUUID := range order.PartUUIDs {
-			partsInsert = partsInsert.Values(result.ID, partUUID)
+		for _, item := range order.Items {
+			partsInsert = partsInsert.Values(result.ID, item.PartUUID, item.Quantity, item.UnitPrice, item.Name, item.Category)
 		}
 
 		partsQuery, partsArgs, err := partsInsert.ToSql()
@@ -71,10 +99,23 @@ func (r *orderRepository) CreateOrder(ctx context.Context, order *serviceModel.O
 		}
 	}
 
+	if transition != nil {
+		if err := insertStatusTransition(ctx, tx, result.ID, transition); err != nil {
+			return nil, err
+		}
+	}
+
+	if message != nil {
+		if err := insertOutboxMessage(ctx, tx, message); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 
 	order.OrderUUID = result.ID
+	order.Version = result.Version
 	return order, nil
 }
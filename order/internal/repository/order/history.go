@@ -0,0 +1,66 @@
+package order
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/converter"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/model"
+)
+
+// GetOrderStatusHistory returns the status changes of the order, oldest first
+func (r *orderRepository) GetOrderStatusHistory(ctx context.Context, orderUUID string) ([]*serviceModel.OrderStatusTransition, error) {
+	historyQuery := sq.
+		Select("order_id", "from_status", "to_status", "actor", "reason", "created_at").
+		From("order_status_history").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"order_id": orderUUID}).
+		OrderBy("id")
+
+	query, args, err := historyQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transitions, err := pgx.CollectRows(rows, pgx.RowToStructByName[model.OrderStatusTransition])
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*serviceModel.OrderStatusTransition, 0, len(transitions))
+	for i := range transitions {
+		history = append(history, converter.ToModelOrderStatusTransition(&transitions[i]))
+	}
+
+	return history, nil
+}
+
+// insertStatusTransition records the status change within the transaction of the order change
+func insertStatusTransition(ctx context.Context, tx pgx.Tx, orderUUID string, transition *serviceModel.OrderStatusTransition) error {
+	var from *serviceModel.OrderStatus
+	if transition.From != "" {
+		from = &transition.From
+	}
+
+	historyInsert := sq.Insert("order_status_history").
+		PlaceholderFormat(sq.Dollar).
+		Columns("order_id", "from_status", "to_status", "actor", "reason").
+		Values(orderUUID, from, transition.To, transition.Actor, transition.Reason)
+
+	query, args, err := historyInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,68 @@
+package order
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/converter"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/model"
+)
+
+// ListOrders returns up to filter.Limit orders ordered by (created_at, id) descending
+func (r *orderRepository) ListOrders(ctx context.Context, filter *serviceModel.OrderFilter) ([]*serviceModel.Order, error) {
+	ordersQuery := sq.
+		Select(orderColumns...).
+		From("orders").
+		PlaceholderFormat(sq.Dollar).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(uint64(filter.Limit))
+
+	if filter.UserUUID != nil {
+		ordersQuery = ordersQuery.Where(sq.Eq{"user_uuid": *filter.UserUUID})
+	}
+	if len(filter.Statuses) > 0 {
+		ordersQuery = ordersQuery.Where(sq.Eq{"status": filter.Statuses})
+	}
+	if len(filter.PaymentMethods) > 0 {
+		ordersQuery = ordersQuery.Where(sq.Eq{"payment_method": filter.PaymentMethods})
+	}
+	if filter.CreatedFrom != nil {
+		ordersQuery = ordersQuery.Where(sq.GtOrEq{"created_at": *filter.CreatedFrom})
+	}
+	if filter.CreatedTo != nil {
+		ordersQuery = ordersQuery.Where(sq.Lt{"created_at": *filter.CreatedTo})
+	}
+	if filter.After != nil {
+		ordersQuery = ordersQuery.Where(sq.Expr("(created_at, id) < (?, ?)", filter.After.CreatedAt, filter.After.OrderUUID))
+	}
+
+	query, args, err := ordersQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repoOrders, err := pgx.CollectRows(rows, pgx.RowToStructByName[model.Order])
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*serviceModel.Order, 0, len(repoOrders))
+	for i := range repoOrders {
+		orders = append(orders, converter.ToModelOrder(&repoOrders[i]))
+	}
+
+	if err := r.fillOrderParts(ctx, orders...); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
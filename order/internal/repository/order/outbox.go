@@ -0,0 +1,26 @@
+package order
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+// insertOutboxMessage adds the event to the outbox within the transaction of the order change
+func insertOutboxMessage(ctx context.Context, tx pgx.Tx, message *serviceModel.OutboxMessage) error {
+	outboxInsert := sq.Insert("outbox").
+		PlaceholderFormat(sq.Dollar).
+		Columns("id", "event_type", "event_key", "payload", "headers").
+		Values(message.ID, message.EventType, message.Key, message.Payload, message.Headers)
+
+	query, args, err := outboxInsert.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,56 @@
+package order
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/converter"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/model"
+)
+
+// fillOrderParts loads order lines of all given orders in one query
+func (r *orderRepository) fillOrderParts(ctx context.Context, orders ...*serviceModel.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	orderIDs := make([]string, 0, len(orders))
+	byID := make(map[string]*serviceModel.Order, len(orders))
+	for _, order := range orders {
+		orderIDs = append(orderIDs, order.OrderUUID)
+		byID[order.OrderUUID] = order
+	}
+
+	partsQuery := sq.Select("order_id", "part_id", "quantity", "unit_price", "name", "category").
+		From("order_parts").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"order_id": orderIDs})
+
+	query, args, err := partsQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	parts, err := pgx.CollectRows(rows, pgx.RowToStructByName[model.OrderPart])
+	if err != nil {
+		return err
+	}
+
+	for _, part := range parts {
+		if order, ok := byID[part.OrderID]; ok {
+			order.PartUUIDs = append(order.PartUUIDs, part.PartID)
+			order.Items = append(order.Items, converter.ToModelOrderItem(part))
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+var orderColumns = []string{"id", "user_uuid", "total_price", "status", "transaction_uuid", "payment_method", "created_at", "version"}
+
 type orderRepository struct {
 	db *pgxpool.Pool
 }
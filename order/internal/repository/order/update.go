@@ -2,30 +2,91 @@ package order
 
 import (
 	"context"
+	"errors"
+	"log"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 
 	serviceModel "github.com/dexguitar/spacecraftory/order/internal/model"
 )
 
-func (r *orderRepository) UpdateOrder(ctx context.Context, order *serviceModel.Order) error {
-	builderUpdate := sq.
-		Update("orders").
-		PlaceholderFormat(sq.Dollar).
-		Set("status", order.OrderStatus).
-		Set("transaction_uuid", order.TransactionUUID).
-		Set("payment_method", order.PaymentMethod).
-		Where(sq.Eq{"id": order.OrderUUID})
+// UpdateOrder stores the order change, transition is recorded in the status history
+// unless it is nil. It fails with ErrOrderConflict if the order was updated since it was read.
+func (r *orderRepository) UpdateOrder(ctx context.Context, order *serviceModel.Order, transition *serviceModel.OrderStatusTransition) error {
+	return r.updateOrder(ctx, order, transition, nil)
+}
+
+// UpdateOrderWithOutbox stores the order change and the event describing it atomically,
+// so the event is published if and only if the change is committed
+func (r *orderRepository) UpdateOrderWithOutbox(
+	ctx context.Context,
+	order *serviceModel.Order,
+	transition *serviceModel.OrderStatusTransition,
+	message *serviceModel.OutboxMessage,
+) error {
+	return r.updateOrder(ctx, order, transition, message)
+}
 
-	query, args, err := builderUpdate.ToSql()
+func (r *orderRepository) updateOrder(
+	ctx context.Context,
+	order *serviceModel.Order,
+	transition *serviceModel.OrderStatusTransition,
+	message *serviceModel.OutboxMessage,
+) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return err
 	}
+	defer func() {
+		err = tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Printf("failed to rollback transaction: %v", err)
+		}
+	}()
 
-	_, err = r.db.Exec(ctx, query, args...)
+	query, args, err := updateOrderQuery(order).ToSql()
 	if err != nil {
 		return err
 	}
 
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	// the order is missing or was updated since it was read
+	if tag.RowsAffected() == 0 {
+		return serviceModel.ErrOrderConflict
+	}
+
+	if transition != nil {
+		if err := insertStatusTransition(ctx, tx, order.OrderUUID, transition); err != nil {
+			return err
+		}
+	}
+
+	if message != nil {
+		if err := insertOutboxMessage(ctx, tx, message); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	order.Version++
 	return nil
 }
+
+// updateOrderQuery is a compare-and-swap on the order version
+func updateOrderQuery(order *serviceModel.Order) sq.UpdateBuilder {
+	return sq.
+		Update("orders").
+		PlaceholderFormat(sq.Dollar).
+		Set("status", order.OrderStatus).
+		Set("transaction_uuid", order.TransactionUUID).
+		Set("payment_method", order.PaymentMethod).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": order.OrderUUID, "version": order.Version})
+}
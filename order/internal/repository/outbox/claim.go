@@ -0,0 +1,52 @@
+package outbox
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/converter"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/model"
+)
+
+// claimQuery hides the claimed rows from other relays for the lease duration,
+// SKIP LOCKED lets several order instances claim disjoint batches concurrently
+const claimQuery = `
+update outbox
+set available_at = now() + make_interval(secs => $2)
+where id in (
+    select id from outbox
+    where sent_at is null and available_at <= now()
+    order by created_at
+    limit $1
+    for update skip locked
+)
+returning id, event_type, event_key, payload, headers, attempts, created_at`
+
+func (r *outboxRepository) ClaimPendingMessages(ctx context.Context, limit int, lease time.Duration) ([]*serviceModel.OutboxMessage, error) {
+	rows, err := r.db.Query(ctx, claimQuery, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repoMessages, err := pgx.CollectRows(rows, pgx.RowToStructByName[model.OutboxMessage])
+	if err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the subquery order
+	sort.Slice(repoMessages, func(i, j int) bool {
+		return repoMessages[i].CreatedAt.Before(repoMessages[j].CreatedAt)
+	})
+
+	messages := make([]*serviceModel.OutboxMessage, 0, len(repoMessages))
+	for _, repoMessage := range repoMessages {
+		messages = append(messages, converter.ToModelOutboxMessage(repoMessage))
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,51 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+func (r *outboxRepository) MarkSent(ctx context.Context, id string) error {
+	builderUpdate := sq.
+		Update("outbox").
+		PlaceholderFormat(sq.Dollar).
+		Set("sent_at", sq.Expr("now()")).
+		Set("last_error", nil).
+		Where(sq.Eq{"id": id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) MarkFailed(ctx context.Context, id, lastError string, retryDelay time.Duration) error {
+	builderUpdate := sq.
+		Update("outbox").
+		PlaceholderFormat(sq.Dollar).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("last_error", lastError).
+		Set("available_at", sq.Expr("now() + make_interval(secs => ?)", retryDelay.Seconds())).
+		Where(sq.Eq{"id": id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
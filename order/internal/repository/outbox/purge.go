@@ -0,0 +1,30 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// DeleteSentBefore deletes messages sent longer than retention ago.
+// Pending and failed messages are kept until the relay sends them.
+func (r *outboxRepository) DeleteSentBefore(ctx context.Context, retention time.Duration) (int64, error) {
+	builderDelete := sq.
+		Delete("outbox").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.NotEq{"sent_at": nil}).
+		Where(sq.Expr("sent_at < now() - make_interval(secs => ?)", retention.Seconds()))
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
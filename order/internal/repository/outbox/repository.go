@@ -0,0 +1,15 @@
+package outbox
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type outboxRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOutboxRepository(db *pgxpool.Pool) *outboxRepository {
+	return &outboxRepository{
+		db: db,
+	}
+}
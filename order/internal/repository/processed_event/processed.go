@@ -0,0 +1,106 @@
+package processed_event
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// Claim stores the event as being processed until the claim TTL passes.
+// It returns false if the event was processed already or another consumer holds
+// a live claim of it, an expired claim is taken over.
+func (r *processedEventRepository) Claim(ctx context.Context, eventUUID string) (bool, error) {
+	builderInsert := sq.
+		Insert("processed_events").
+		PlaceholderFormat(sq.Dollar).
+		Columns("consumer_group", "event_uuid", "claimed_until").
+		Values(r.consumerGroup, eventUUID, sq.Expr("now() + make_interval(secs => ?)", r.claimTTL.Seconds())).
+		Suffix(`ON CONFLICT (consumer_group, event_uuid) DO UPDATE SET
+			claimed_until = excluded.claimed_until,
+			processed_at = now()
+		WHERE processed_events.claimed_until < now()
+		RETURNING event_uuid`)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var claimed string
+	err = r.db.QueryRow(ctx, query, args...).Scan(&claimed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *processedEventRepository) MarkProcessed(ctx context.Context, eventUUID string) error {
+	builderUpdate := sq.
+		Update("processed_events").
+		PlaceholderFormat(sq.Dollar).
+		Set("claimed_until", nil).
+		Set("processed_at", sq.Expr("now()")).
+		Where(sq.Eq{"consumer_group": r.consumerGroup, "event_uuid": eventUUID})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Release drops the claim of an event whose processing failed, so a retry processes it again
+func (r *processedEventRepository) Release(ctx context.Context, eventUUID string) error {
+	builderDelete := sq.
+		Delete("processed_events").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"consumer_group": r.consumerGroup, "event_uuid": eventUUID}).
+		Where(sq.NotEq{"claimed_until": nil})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteProcessedBefore deletes events processed longer than retention ago.
+// Kafka no longer redelivers them, so they are not needed to detect duplicates.
+func (r *processedEventRepository) DeleteProcessedBefore(ctx context.Context, retention time.Duration) (int64, error) {
+	builderDelete := sq.
+		Delete("processed_events").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"claimed_until": nil}).
+		Where(sq.Expr("processed_at < now() - make_interval(secs => ?)", retention.Seconds()))
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,21 @@
+package processed_event
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type processedEventRepository struct {
+	db            *pgxpool.Pool
+	consumerGroup string
+	claimTTL      time.Duration
+}
+
+func NewProcessedEventRepository(db *pgxpool.Pool, consumerGroup string, claimTTL time.Duration) *processedEventRepository {
+	return &processedEventRepository{
+		db:            db,
+		consumerGroup: consumerGroup,
+		claimTTL:      claimTTL,
+	}
+}
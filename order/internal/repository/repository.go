@@ -2,12 +2,37 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/dexguitar/spacecraftory/order/internal/model"
 )
 
 type OrderRepository interface {
-	CreateOrder(ctx context.Context, order *model.Order) (*model.Order, error)
+	CreateOrder(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition) (*model.Order, error)
+	CreateOrderWithOutbox(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition, message *model.OutboxMessage) (*model.Order, error)
 	GetOrder(ctx context.Context, orderUUID string) (*model.Order, error)
-	UpdateOrder(ctx context.Context, order *model.Order) error
+	GetOrderStatusHistory(ctx context.Context, orderUUID string) ([]*model.OrderStatusTransition, error)
+	UpdateOrder(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition) error
+	UpdateOrderWithOutbox(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition, message *model.OutboxMessage) error
+	ListOrders(ctx context.Context, filter *model.OrderFilter) ([]*model.Order, error)
+}
+
+type OutboxRepository interface {
+	ClaimPendingMessages(ctx context.Context, limit int, lease time.Duration) ([]*model.OutboxMessage, error)
+	MarkSent(ctx context.Context, id string) error
+	MarkFailed(ctx context.Context, id, lastError string, retryDelay time.Duration) error
+	DeleteSentBefore(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+type ProcessedEventRepository interface {
+	Claim(ctx context.Context, eventUUID string) (bool, error)
+	MarkProcessed(ctx context.Context, eventUUID string) error
+	Release(ctx context.Context, eventUUID string) error
+	DeleteProcessedBefore(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+type IdempotencyRepository interface {
+	ClaimKey(ctx context.Context, request model.IdempotentRequest, ttl, lockTimeout time.Duration) (*model.IdempotencyRecord, error)
+	SaveResponse(ctx context.Context, request model.IdempotentRequest, response model.IdempotentResponse) error
+	ReleaseKey(ctx context.Context, request model.IdempotentRequest) error
 }
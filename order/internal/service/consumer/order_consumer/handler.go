@@ -2,6 +2,7 @@ package order_consumer
 
 import (
 	"context"
+	"fmt"
 
 	"go.uber.org/zap"
 
@@ -11,7 +12,7 @@ import (
 )
 
 func (s *service) OrderHandler(ctx context.Context, msg kafka.Message) error {
-	event, err := s.orderAssembledDecoder.Decode(msg.Value)
+	event, err := s.orderAssembledDecoder.Decode(msg)
 	if err != nil {
 		logger.Error(ctx, "Failed to decode OrderAssembled", zap.Error(err))
 		return err
@@ -27,21 +28,41 @@ func (s *service) OrderHandler(ctx context.Context, msg kafka.Message) error {
 		zap.Int64("build_time_sec", event.BuildTimeSec),
 	)
 
-	order, err := s.orderRepository.GetOrder(ctx, event.OrderUUID)
+	// a concurrent update of the order is retried after a short pause with a fresh read
+	err = retryOnConflict(ctx, func() error {
+		return s.assembleOrder(ctx, event.OrderUUID)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Order updated successfully", zap.String("order_uuid", event.OrderUUID))
+
+	return nil
+}
+
+func (s *service) assembleOrder(ctx context.Context, orderUUID string) error {
+	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
 	if err != nil {
 		logger.Error(ctx, "Failed to get order", zap.Error(err))
 		return err
 	}
 
-	order.OrderStatus = model.OrderStatusASSEMBLED
+	transition, err := order.TransitionTo(model.OrderStatusASSEMBLED, model.ActorAssembly, model.StatusReasonAssembled)
+	if err != nil {
+		// redelivery cannot make an illegal transition legal, the event goes straight to the DLQ
+		logger.Error(ctx, "Order cannot be assembled",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("%w: %w", kafka.ErrPermanent, err)
+	}
 
-	err = s.orderRepository.UpdateOrder(ctx, order)
+	err = s.orderRepository.UpdateOrder(ctx, order, transition)
 	if err != nil {
 		logger.Error(ctx, "Failed to update order", zap.Error(err))
 		return err
 	}
 
-	logger.Info(ctx, "Order updated successfully", zap.String("order_uuid", order.OrderUUID))
-
 	return nil
 }
@@ -0,0 +1,68 @@
+package order_consumer
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+const (
+	// maxConflictAttempts bounds how many times an order update is attempted
+	// while other writers keep changing the order
+	maxConflictAttempts = 3
+	// conflictBackoff is the pause after the first conflict, it doubles after every next one
+	conflictBackoff = 20 * time.Millisecond
+)
+
+// retryOnConflict runs fn again while it fails with ErrOrderConflict. fn must read
+// the order itself, so each attempt starts from the current version. Attempts are
+// spread by a jittered backoff, so contending writers do not collide again right away.
+// A conflict left after the last attempt is returned and handled by the consumer retry policy.
+func retryOnConflict(ctx context.Context, fn func() error) error {
+	var err error
+	backoff := conflictBackoff
+	for attempt := 1; attempt <= maxConflictAttempts; attempt++ {
+		err = fn()
+		if !errors.Is(err, model.ErrOrderConflict) {
+			return err
+		}
+		if attempt == maxConflictAttempts {
+			break
+		}
+
+		logger.Warn(ctx, "Order was modified concurrently",
+			zap.Int("attempt", attempt),
+			zap.Error(err),
+		)
+
+		if err := sleep(ctx, jitter(backoff)); err != nil {
+			return err
+		}
+		backoff *= 2
+	}
+
+	return err
+}
+
+// jitter picks a pause between half of d and d
+func jitter(d time.Duration) time.Duration {
+	return d/2 + rand.N(d/2+1)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
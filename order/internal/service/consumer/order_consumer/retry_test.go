@@ -0,0 +1,83 @@
+package order_consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+func TestRetryOnConflictThenSuccess(t *testing.T) {
+	logger.SetNopLogger()
+
+	calls := 0
+	started := time.Now()
+	err := retryOnConflict(context.Background(), func() error {
+		calls++
+		if calls == 1 {
+			return model.ErrOrderConflict
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	// the second attempt waits for at least half of the first backoff
+	assert.GreaterOrEqual(t, time.Since(started), conflictBackoff/2)
+}
+
+func TestRetryOnConflictExhausted(t *testing.T) {
+	logger.SetNopLogger()
+
+	calls := 0
+	err := retryOnConflict(context.Background(), func() error {
+		calls++
+		return model.ErrOrderConflict
+	})
+
+	assert.ErrorIs(t, err, model.ErrOrderConflict)
+	assert.Equal(t, maxConflictAttempts, calls)
+}
+
+func TestRetryOnConflictOtherError(t *testing.T) {
+	logger.SetNopLogger()
+
+	failure := errors.New("database is down")
+	calls := 0
+	err := retryOnConflict(context.Background(), func() error {
+		calls++
+		return failure
+	})
+
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryOnConflictContextCancelled(t *testing.T) {
+	logger.SetNopLogger()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := retryOnConflict(ctx, func() error {
+		calls++
+		// the consumer shuts down while the order is contended
+		cancel()
+		return model.ErrOrderConflict
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls)
+}
+
+func TestJitterStaysWithinBackoff(t *testing.T) {
+	for range 100 {
+		d := jitter(conflictBackoff)
+		assert.GreaterOrEqual(t, d, conflictBackoff/2)
+		assert.LessOrEqual(t, d, conflictBackoff)
+	}
+}
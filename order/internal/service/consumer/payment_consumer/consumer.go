@@ -0,0 +1,38 @@
+package payment_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/dexguitar/spacecraftory/order/internal/converter/kafka"
+	def "github.com/dexguitar/spacecraftory/order/internal/service"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+type service struct {
+	paymentSettledConsumer kafka.Consumer
+	paymentSettledDecoder  kafkaConverter.PaymentSettledDecoder
+	orderService           def.OrderService
+}
+
+func NewService(paymentSettledConsumer kafka.Consumer, paymentSettledDecoder kafkaConverter.PaymentSettledDecoder, orderService def.OrderService) *service {
+	return &service{
+		paymentSettledConsumer: paymentSettledConsumer,
+		paymentSettledDecoder:  paymentSettledDecoder,
+		orderService:           orderService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 PaymentSettled Kafka consumer running")
+
+	err := s.paymentSettledConsumer.Consume(ctx, s.PaymentHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from payment.settled topic error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package payment_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+func (s *service) PaymentHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.paymentSettledDecoder.Decode(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode PaymentSettled", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Processing message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("transaction_uuid", event.TransactionUUID),
+		zap.String("status", event.Status),
+	)
+
+	// a conflict or a failed refund is handled by the consumer retry policy
+	if err := s.orderService.SettlePayment(ctx, event); err != nil {
+		logger.Error(ctx, "Failed to settle payment",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.String("transaction_uuid", event.TransactionUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+func (s *service) Begin(ctx context.Context, request model.IdempotentRequest) (*model.IdempotentResponse, error) {
+	record, err := s.idempotencyRepository.ClaimKey(ctx, request, s.keyTTL, s.lockTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// the key is new, the request is executed
+	if record == nil {
+		return nil, nil
+	}
+
+	if record.RequestHash != request.RequestHash {
+		return nil, model.ErrIdempotencyKeyMismatch
+	}
+
+	if record.Response == nil {
+		return nil, model.ErrIdempotencyKeyInProgress
+	}
+
+	return record.Response, nil
+}
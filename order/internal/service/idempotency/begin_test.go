@@ -0,0 +1,77 @@
+package idempotency
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+func (s *IdempotencyServiceSuite) TestBeginNewKey() {
+	s.idempotencyRepository.On("ClaimKey", s.ctx, s.request, testKeyTTL, testLockTimeout).
+		Return(nil, nil).Once()
+
+	response, err := s.service.Begin(s.ctx, s.request)
+
+	s.Require().NoError(err)
+	assert.Nil(s.T(), response)
+}
+
+func (s *IdempotencyServiceSuite) TestBeginReplay() {
+	stored := &model.IdempotentResponse{
+		StatusCode:  201,
+		ContentType: "application/json",
+		Body:        []byte(`{"order_uuid":"123e4567-e89b-12d3-a456-426614174000"}`),
+	}
+
+	s.idempotencyRepository.On("ClaimKey", s.ctx, s.request, testKeyTTL, testLockTimeout).
+		Return(&model.IdempotencyRecord{RequestHash: s.request.RequestHash, Response: stored}, nil).Once()
+
+	response, err := s.service.Begin(s.ctx, s.request)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), stored, response)
+}
+
+func (s *IdempotencyServiceSuite) TestBeginError() {
+	errDatabase := errors.New("database connection failed")
+
+	testCases := []struct {
+		name          string
+		record        *model.IdempotencyRecord
+		repoErr       error
+		expectedError error
+	}{
+		{
+			name: "Key used with a different request",
+			record: &model.IdempotencyRecord{
+				RequestHash: "another-request-hash",
+				Response:    &model.IdempotentResponse{StatusCode: 201},
+			},
+			expectedError: model.ErrIdempotencyKeyMismatch,
+		},
+		{
+			name:          "First request still in progress",
+			record:        &model.IdempotencyRecord{RequestHash: s.request.RequestHash},
+			expectedError: model.ErrIdempotencyKeyInProgress,
+		},
+		{
+			name:          "Repository error",
+			repoErr:       errDatabase,
+			expectedError: errDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.idempotencyRepository.On("ClaimKey", s.ctx, s.request, testKeyTTL, testLockTimeout).
+				Return(tc.record, tc.repoErr).Once()
+
+			response, err := s.service.Begin(s.ctx, s.request)
+
+			assert.ErrorIs(s.T(), err, tc.expectedError)
+			assert.Nil(s.T(), response)
+		})
+	}
+}
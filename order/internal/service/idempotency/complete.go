@@ -0,0 +1,29 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+func (s *service) Complete(ctx context.Context, request model.IdempotentRequest, response model.IdempotentResponse) error {
+	// the response is not final, a retry with the key has to run the request again
+	if isRetryable(response.StatusCode) {
+		return s.idempotencyRepository.ReleaseKey(ctx, request)
+	}
+
+	return s.idempotencyRepository.SaveResponse(ctx, request, response)
+}
+
+// isRetryable reports whether a retry of the request may succeed: server errors,
+// conflicts with the current state of the order and rate limiting
+func isRetryable(statusCode int) bool {
+	return statusCode >= http.StatusInternalServerError ||
+		statusCode == http.StatusConflict ||
+		statusCode == http.StatusTooManyRequests
+}
+
+func (s *service) Abort(ctx context.Context, request model.IdempotentRequest) error {
+	return s.idempotencyRepository.ReleaseKey(ctx, request)
+}
@@ -0,0 +1,68 @@
+package idempotency
+
+import (
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+func (s *IdempotencyServiceSuite) TestCompleteStoresResponse() {
+	testCases := []struct {
+		name     string
+		response model.IdempotentResponse
+	}{
+		{
+			name:     "Success",
+			response: model.IdempotentResponse{StatusCode: 201, ContentType: "application/json", Body: []byte(`{}`)},
+		},
+		{
+			name:     "Client error",
+			response: model.IdempotentResponse{StatusCode: 422, ContentType: "application/json", Body: []byte(`{}`)},
+		},
+		{
+			name:     "Payment declined",
+			response: model.IdempotentResponse{StatusCode: 402, ContentType: "application/json", Body: []byte(`{}`)},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.idempotencyRepository.On("SaveResponse", s.ctx, s.request, tc.response).
+				Return(nil).Once()
+
+			err := s.service.Complete(s.ctx, s.request, tc.response)
+
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *IdempotencyServiceSuite) TestCompleteRetryableResponseReleasesKey() {
+	testCases := []struct {
+		name       string
+		statusCode int
+	}{
+		{name: "Server error", statusCode: 500},
+		{name: "Payment awaiting confirmation", statusCode: 503},
+		{name: "Order modified concurrently", statusCode: 409},
+		{name: "Rate limited", statusCode: 429},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.idempotencyRepository.On("ReleaseKey", s.ctx, s.request).
+				Return(nil).Once()
+
+			err := s.service.Complete(s.ctx, s.request, model.IdempotentResponse{StatusCode: tc.statusCode})
+
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *IdempotencyServiceSuite) TestAbort() {
+	s.idempotencyRepository.On("ReleaseKey", s.ctx, s.request).
+		Return(nil).Once()
+
+	err := s.service.Abort(s.ctx, s.request)
+
+	s.Require().NoError(err)
+}
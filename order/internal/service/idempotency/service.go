@@ -0,0 +1,21 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/dexguitar/spacecraftory/order/internal/repository"
+)
+
+type service struct {
+	idempotencyRepository repository.IdempotencyRepository
+	keyTTL                time.Duration
+	lockTimeout           time.Duration
+}
+
+func NewService(idempotencyRepository repository.IdempotencyRepository, keyTTL, lockTimeout time.Duration) *service {
+	return &service{
+		idempotencyRepository: idempotencyRepository,
+		keyTTL:                keyTTL,
+		lockTimeout:           lockTimeout,
+	}
+}
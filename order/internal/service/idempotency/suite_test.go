@@ -0,0 +1,41 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/mocks"
+)
+
+const (
+	testKeyTTL      = 24 * time.Hour
+	testLockTimeout = time.Minute
+)
+
+type IdempotencyServiceSuite struct {
+	suite.Suite
+	ctx                   context.Context
+	request               model.IdempotentRequest
+	idempotencyRepository *mocks.IdempotencyRepository
+	service               *service
+}
+
+func (s *IdempotencyServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.request = model.IdempotentRequest{
+		UserUUID:    "123e4567-e89b-12d3-a456-426614174012",
+		Key:         "5f0c6a8e-3c1f-4a0e-9a43-2b9f1d7c8e21",
+		RequestHash: "request-hash",
+		ClaimUUID:   "0b7e4a1c-2f3d-4e5a-8b6c-7d8e9f0a1b2c",
+	}
+	s.idempotencyRepository = mocks.NewIdempotencyRepository(s.T())
+	s.service = NewService(s.idempotencyRepository, testKeyTTL, testLockTimeout)
+}
+
+func TestIdempotencyService(t *testing.T) {
+	suite.Run(t, new(IdempotencyServiceSuite))
+}
@@ -5,8 +5,10 @@ package mocks
 import (
 	context "context"
 
-	model "github.com/dexguitar/spacecraftory/order/internal/model"
+	decimal "github.com/shopspring/decimal"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dexguitar/spacecraftory/order/internal/model"
 )
 
 // OrderService is an autogenerated mock type for the OrderService type
@@ -22,17 +24,17 @@ func (_m *OrderService) EXPECT() *OrderService_Expecter {
 	return &OrderService_Expecter{mock: &_m.Mock}
 }
 
-// CancelOrder provides a mock function with given fields: ctx, orderUUID
-func (_m *OrderService) CancelOrder(ctx context.Context, orderUUID string) error {
-	ret := _m.Called(ctx, orderUUID)
+// CancelOrder provides a mock function with given fields: ctx, requester, orderUUID
+func (_m *OrderService) CancelOrder(ctx context.Context, requester model.Requester, orderUUID string) error {
+	ret := _m.Called(ctx, requester, orderUUID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CancelOrder")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, orderUUID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, string) error); ok {
+		r0 = rf(ctx, requester, orderUUID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -47,14 +49,15 @@ type OrderService_CancelOrder_Call struct {
 
 // CancelOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - requester model.Requester
 //   - orderUUID string
-func (_e *OrderService_Expecter) CancelOrder(ctx interface{}, orderUUID interface{}) *OrderService_CancelOrder_Call {
-	return &OrderService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, orderUUID)}
+func (_e *OrderService_Expecter) CancelOrder(ctx interface{}, requester interface{}, orderUUID interface{}) *OrderService_CancelOrder_Call {
+	return &OrderService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, requester, orderUUID)}
 }
 
-func (_c *OrderService_CancelOrder_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderService_CancelOrder_Call {
+func (_c *OrderService_CancelOrder_Call) Run(run func(ctx context.Context, requester model.Requester, orderUUID string)) *OrderService_CancelOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(model.Requester), args[2].(string))
 	})
 	return _c
 }
@@ -64,14 +67,14 @@ func (_c *OrderService_CancelOrder_Call) Return(_a0 error) *OrderService_CancelO
 	return _c
 }
 
-func (_c *OrderService_CancelOrder_Call) RunAndReturn(run func(context.Context, string) error) *OrderService_CancelOrder_Call {
+func (_c *OrderService_CancelOrder_Call) RunAndReturn(run func(context.Context, model.Requester, string) error) *OrderService_CancelOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateOrder provides a mock function with given fields: ctx, userUUID, partUUIDs
-func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, partUUIDs []string) (*model.Order, error) {
-	ret := _m.Called(ctx, userUUID, partUUIDs)
+// CreateOrder provides a mock function with given fields: ctx, userUUID, items
+func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, items []model.OrderItem) (*model.Order, error) {
+	ret := _m.Called(ctx, userUUID, items)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
@@ -79,19 +82,19 @@ func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, partUU
 
 	var r0 *model.Order
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (*model.Order, error)); ok {
-		return rf(ctx, userUUID, partUUIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.OrderItem) (*model.Order, error)); ok {
+		return rf(ctx, userUUID, items)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *model.Order); ok {
-		r0 = rf(ctx, userUUID, partUUIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.OrderItem) *model.Order); ok {
+		r0 = rf(ctx, userUUID, items)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Order)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
-		r1 = rf(ctx, userUUID, partUUIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.OrderItem) error); ok {
+		r1 = rf(ctx, userUUID, items)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -107,14 +110,14 @@ type OrderService_CreateOrder_Call struct {
 // CreateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userUUID string
-//   - partUUIDs []string
-func (_e *OrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, partUUIDs interface{}) *OrderService_CreateOrder_Call {
-	return &OrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, partUUIDs)}
+//   - items []model.OrderItem
+func (_e *OrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, items interface{}) *OrderService_CreateOrder_Call {
+	return &OrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, items)}
 }
 
-func (_c *OrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, partUUIDs []string)) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, items []model.OrderItem)) *OrderService_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.OrderItem))
 	})
 	return _c
 }
@@ -124,14 +127,14 @@ func (_c *OrderService_CreateOrder_Call) Return(_a0 *model.Order, _a1 error) *Or
 	return _c
 }
 
-func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, string, []string) (*model.Order, error)) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, string, []model.OrderItem) (*model.Order, error)) *OrderService_CreateOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrder provides a mock function with given fields: ctx, orderUUID
-func (_m *OrderService) GetOrder(ctx context.Context, orderUUID string) (*model.Order, error) {
-	ret := _m.Called(ctx, orderUUID)
+// GetOrder provides a mock function with given fields: ctx, requester, orderUUID
+func (_m *OrderService) GetOrder(ctx context.Context, requester model.Requester, orderUUID string) (*model.Order, error) {
+	ret := _m.Called(ctx, requester, orderUUID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetOrder")
@@ -139,19 +142,19 @@ func (_m *OrderService) GetOrder(ctx context.Context, orderUUID string) (*model.
 
 	var r0 *model.Order
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Order, error)); ok {
-		return rf(ctx, orderUUID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, string) (*model.Order, error)); ok {
+		return rf(ctx, requester, orderUUID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Order); ok {
-		r0 = rf(ctx, orderUUID)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, string) *model.Order); ok {
+		r0 = rf(ctx, requester, orderUUID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Order)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, orderUUID)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Requester, string) error); ok {
+		r1 = rf(ctx, requester, orderUUID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -166,14 +169,15 @@ type OrderService_GetOrder_Call struct {
 
 // GetOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - requester model.Requester
 //   - orderUUID string
-func (_e *OrderService_Expecter) GetOrder(ctx interface{}, orderUUID interface{}) *OrderService_GetOrder_Call {
-	return &OrderService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, orderUUID)}
+func (_e *OrderService_Expecter) GetOrder(ctx interface{}, requester interface{}, orderUUID interface{}) *OrderService_GetOrder_Call {
+	return &OrderService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, requester, orderUUID)}
 }
 
-func (_c *OrderService_GetOrder_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderService_GetOrder_Call {
+func (_c *OrderService_GetOrder_Call) Run(run func(ctx context.Context, requester model.Requester, orderUUID string)) *OrderService_GetOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(model.Requester), args[2].(string))
 	})
 	return _c
 }
@@ -183,14 +187,134 @@ func (_c *OrderService_GetOrder_Call) Return(_a0 *model.Order, _a1 error) *Order
 	return _c
 }
 
-func (_c *OrderService_GetOrder_Call) RunAndReturn(run func(context.Context, string) (*model.Order, error)) *OrderService_GetOrder_Call {
+func (_c *OrderService_GetOrder_Call) RunAndReturn(run func(context.Context, model.Requester, string) (*model.Order, error)) *OrderService_GetOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderHistory provides a mock function with given fields: ctx, requester, orderUUID
+func (_m *OrderService) GetOrderHistory(ctx context.Context, requester model.Requester, orderUUID string) ([]*model.OrderStatusTransition, error) {
+	ret := _m.Called(ctx, requester, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderHistory")
+	}
+
+	var r0 []*model.OrderStatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, string) ([]*model.OrderStatusTransition, error)); ok {
+		return rf(ctx, requester, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, string) []*model.OrderStatusTransition); ok {
+		r0 = rf(ctx, requester, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderStatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Requester, string) error); ok {
+		r1 = rf(ctx, requester, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_GetOrderHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderHistory'
+type OrderService_GetOrderHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requester model.Requester
+//   - orderUUID string
+func (_e *OrderService_Expecter) GetOrderHistory(ctx interface{}, requester interface{}, orderUUID interface{}) *OrderService_GetOrderHistory_Call {
+	return &OrderService_GetOrderHistory_Call{Call: _e.mock.On("GetOrderHistory", ctx, requester, orderUUID)}
+}
+
+func (_c *OrderService_GetOrderHistory_Call) Run(run func(ctx context.Context, requester model.Requester, orderUUID string)) *OrderService_GetOrderHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Requester), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_GetOrderHistory_Call) Return(_a0 []*model.OrderStatusTransition, _a1 error) *OrderService_GetOrderHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_GetOrderHistory_Call) RunAndReturn(run func(context.Context, model.Requester, string) ([]*model.OrderStatusTransition, error)) *OrderService_GetOrderHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PayOrder provides a mock function with given fields: ctx, orderUUID, paymentMethod
-func (_m *OrderService) PayOrder(ctx context.Context, orderUUID string, paymentMethod model.PaymentMethod) (string, error) {
-	ret := _m.Called(ctx, orderUUID, paymentMethod)
+// ListOrders provides a mock function with given fields: ctx, requester, filter
+func (_m *OrderService) ListOrders(ctx context.Context, requester model.Requester, filter model.OrderFilter) (*model.OrderPage, error) {
+	ret := _m.Called(ctx, requester, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 *model.OrderPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, model.OrderFilter) (*model.OrderPage, error)); ok {
+		return rf(ctx, requester, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, model.OrderFilter) *model.OrderPage); ok {
+		r0 = rf(ctx, requester, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Requester, model.OrderFilter) error); ok {
+		r1 = rf(ctx, requester, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderService_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requester model.Requester
+//   - filter model.OrderFilter
+func (_e *OrderService_Expecter) ListOrders(ctx interface{}, requester interface{}, filter interface{}) *OrderService_ListOrders_Call {
+	return &OrderService_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, requester, filter)}
+}
+
+func (_c *OrderService_ListOrders_Call) Run(run func(ctx context.Context, requester model.Requester, filter model.OrderFilter)) *OrderService_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Requester), args[2].(model.OrderFilter))
+	})
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) Return(_a0 *model.OrderPage, _a1 error) *OrderService_ListOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) RunAndReturn(run func(context.Context, model.Requester, model.OrderFilter) (*model.OrderPage, error)) *OrderService_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PayOrder provides a mock function with given fields: ctx, requester, orderUUID, paymentMethod
+func (_m *OrderService) PayOrder(ctx context.Context, requester model.Requester, orderUUID string, paymentMethod model.PaymentMethod) (string, error) {
+	ret := _m.Called(ctx, requester, orderUUID, paymentMethod)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -198,17 +322,17 @@ func (_m *OrderService) PayOrder(ctx context.Context, orderUUID string, paymentM
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, model.PaymentMethod) (string, error)); ok {
-		return rf(ctx, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, string, model.PaymentMethod) (string, error)); ok {
+		return rf(ctx, requester, orderUUID, paymentMethod)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, model.PaymentMethod) string); ok {
-		r0 = rf(ctx, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, string, model.PaymentMethod) string); ok {
+		r0 = rf(ctx, requester, orderUUID, paymentMethod)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, model.PaymentMethod) error); ok {
-		r1 = rf(ctx, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Requester, string, model.PaymentMethod) error); ok {
+		r1 = rf(ctx, requester, orderUUID, paymentMethod)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -223,15 +347,16 @@ type OrderService_PayOrder_Call struct {
 
 // PayOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - requester model.Requester
 //   - orderUUID string
 //   - paymentMethod model.PaymentMethod
-func (_e *OrderService_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, paymentMethod interface{}) *OrderService_PayOrder_Call {
-	return &OrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, paymentMethod)}
+func (_e *OrderService_Expecter) PayOrder(ctx interface{}, requester interface{}, orderUUID interface{}, paymentMethod interface{}) *OrderService_PayOrder_Call {
+	return &OrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, requester, orderUUID, paymentMethod)}
 }
 
-func (_c *OrderService_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, paymentMethod model.PaymentMethod)) *OrderService_PayOrder_Call {
+func (_c *OrderService_PayOrder_Call) Run(run func(ctx context.Context, requester model.Requester, orderUUID string, paymentMethod model.PaymentMethod)) *OrderService_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(model.PaymentMethod))
+		run(args[0].(context.Context), args[1].(model.Requester), args[2].(string), args[3].(model.PaymentMethod))
 	})
 	return _c
 }
@@ -241,7 +366,116 @@ func (_c *OrderService_PayOrder_Call) Return(_a0 string, _a1 error) *OrderServic
 	return _c
 }
 
-func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, string, model.PaymentMethod) (string, error)) *OrderService_PayOrder_Call {
+func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, model.Requester, string, model.PaymentMethod) (string, error)) *OrderService_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundOrder provides a mock function with given fields: ctx, requester, orderUUID, amount, reason
+func (_m *OrderService) RefundOrder(ctx context.Context, requester model.Requester, orderUUID string, amount decimal.Decimal, reason string) (*model.Refund, error) {
+	ret := _m.Called(ctx, requester, orderUUID, amount, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundOrder")
+	}
+
+	var r0 *model.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, string, decimal.Decimal, string) (*model.Refund, error)); ok {
+		return rf(ctx, requester, orderUUID, amount, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Requester, string, decimal.Decimal, string) *model.Refund); ok {
+		r0 = rf(ctx, requester, orderUUID, amount, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Refund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Requester, string, decimal.Decimal, string) error); ok {
+		r1 = rf(ctx, requester, orderUUID, amount, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_RefundOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundOrder'
+type OrderService_RefundOrder_Call struct {
+	*mock.Call
+}
+
+// RefundOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requester model.Requester
+//   - orderUUID string
+//   - amount decimal.Decimal
+//   - reason string
+func (_e *OrderService_Expecter) RefundOrder(ctx interface{}, requester interface{}, orderUUID interface{}, amount interface{}, reason interface{}) *OrderService_RefundOrder_Call {
+	return &OrderService_RefundOrder_Call{Call: _e.mock.On("RefundOrder", ctx, requester, orderUUID, amount, reason)}
+}
+
+func (_c *OrderService_RefundOrder_Call) Run(run func(ctx context.Context, requester model.Requester, orderUUID string, amount decimal.Decimal, reason string)) *OrderService_RefundOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Requester), args[2].(string), args[3].(decimal.Decimal), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_RefundOrder_Call) Return(_a0 *model.Refund, _a1 error) *OrderService_RefundOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_RefundOrder_Call) RunAndReturn(run func(context.Context, model.Requester, string, decimal.Decimal, string) (*model.Refund, error)) *OrderService_RefundOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SettlePayment provides a mock function with given fields: ctx, event
+func (_m *OrderService) SettlePayment(ctx context.Context, event model.PaymentSettledEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SettlePayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentSettledEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderService_SettlePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SettlePayment'
+type OrderService_SettlePayment_Call struct {
+	*mock.Call
+}
+
+// SettlePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.PaymentSettledEvent
+func (_e *OrderService_Expecter) SettlePayment(ctx interface{}, event interface{}) *OrderService_SettlePayment_Call {
+	return &OrderService_SettlePayment_Call{Call: _e.mock.On("SettlePayment", ctx, event)}
+}
+
+func (_c *OrderService_SettlePayment_Call) Run(run func(ctx context.Context, event model.PaymentSettledEvent)) *OrderService_SettlePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PaymentSettledEvent))
+	})
+	return _c
+}
+
+func (_c *OrderService_SettlePayment_Call) Return(_a0 error) *OrderService_SettlePayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderService_SettlePayment_Call) RunAndReturn(run func(context.Context, model.PaymentSettledEvent) error) *OrderService_SettlePayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,7 +5,6 @@ package mocks
 import (
 	context "context"
 
-	model "github.com/dexguitar/spacecraftory/order/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,17 +21,17 @@ func (_m *ProducerService) EXPECT() *ProducerService_Expecter {
 	return &ProducerService_Expecter{mock: &_m.Mock}
 }
 
-// ProduceOrderPaid provides a mock function with given fields: ctx, event
-func (_m *ProducerService) ProduceOrderPaid(ctx context.Context, event model.OrderPaidEvent) error {
-	ret := _m.Called(ctx, event)
+// RunRelay provides a mock function with given fields: ctx
+func (_m *ProducerService) RunRelay(ctx context.Context) error {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ProduceOrderPaid")
+		panic("no return value specified for RunRelay")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.OrderPaidEvent) error); ok {
-		r0 = rf(ctx, event)
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -40,31 +39,30 @@ func (_m *ProducerService) ProduceOrderPaid(ctx context.Context, event model.Ord
 	return r0
 }
 
-// ProducerService_ProduceOrderPaid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceOrderPaid'
-type ProducerService_ProduceOrderPaid_Call struct {
+// ProducerService_RunRelay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunRelay'
+type ProducerService_RunRelay_Call struct {
 	*mock.Call
 }
 
-// ProduceOrderPaid is a helper method to define mock.On call
+// RunRelay is a helper method to define mock.On call
 //   - ctx context.Context
-//   - event model.OrderPaidEvent
-func (_e *ProducerService_Expecter) ProduceOrderPaid(ctx interface{}, event interface{}) *ProducerService_ProduceOrderPaid_Call {
-	return &ProducerService_ProduceOrderPaid_Call{Call: _e.mock.On("ProduceOrderPaid", ctx, event)}
+func (_e *ProducerService_Expecter) RunRelay(ctx interface{}) *ProducerService_RunRelay_Call {
+	return &ProducerService_RunRelay_Call{Call: _e.mock.On("RunRelay", ctx)}
 }
 
-func (_c *ProducerService_ProduceOrderPaid_Call) Run(run func(ctx context.Context, event model.OrderPaidEvent)) *ProducerService_ProduceOrderPaid_Call {
+func (_c *ProducerService_RunRelay_Call) Run(run func(ctx context.Context)) *ProducerService_RunRelay_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.OrderPaidEvent))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *ProducerService_ProduceOrderPaid_Call) Return(_a0 error) *ProducerService_ProduceOrderPaid_Call {
+func (_c *ProducerService_RunRelay_Call) Return(_a0 error) *ProducerService_RunRelay_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ProducerService_ProduceOrderPaid_Call) RunAndReturn(run func(context.Context, model.OrderPaidEvent) error) *ProducerService_ProduceOrderPaid_Call {
+func (_c *ProducerService_RunRelay_Call) RunAndReturn(run func(context.Context) error) *ProducerService_RunRelay_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,24 +3,46 @@ package order
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/dexguitar/spacecraftory/order/internal/model"
 )
 
-func (s *service) CancelOrder(ctx context.Context, orderUUID string) error {
-	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
+func (s *service) CancelOrder(ctx context.Context, requester model.Requester, orderUUID string) error {
+	order, err := s.GetOrder(ctx, requester, orderUUID)
 	if err != nil {
 		return err
 	}
 
-	if order.OrderStatus == model.OrderStatusPAID || order.OrderStatus == model.OrderStatusCANCELLED {
-		return model.ErrInvalidOrderStatus
+	reason := model.CancelReasonUserRequest
+	if requester.UserUUID != order.UserUUID {
+		reason = model.CancelReasonAdminRequest
+	}
+
+	transition, err := order.TransitionTo(model.OrderStatusCANCELLED, requester.UserUUID, reason)
+	if err != nil {
+		return err
 	}
 
-	order.OrderStatus = model.OrderStatusCANCELLED
+	eventUUID := uuid.NewString()
+	payload, err := s.orderCancelledEncoder.Encode(model.OrderCancelledEvent{
+		EventUUID: eventUUID,
+		OrderUUID: orderUUID,
+		UserUUID:  order.UserUUID,
+		Reason:    reason,
+	})
+	if err != nil {
+		return err
+	}
 
-	if err := s.orderRepository.UpdateOrder(ctx, order); err != nil {
+	// OrderCancelled is published by the outbox relay once the order is stored as cancelled
+	err = s.orderRepository.UpdateOrderWithOutbox(ctx, order, transition,
+		outboxMessage(ctx, model.OutboxEventOrderCancelled, eventUUID, payload))
+	if err != nil {
 		return err
 	}
 
+	s.releaseReservation(ctx, orderUUID)
+
 	return nil
 }
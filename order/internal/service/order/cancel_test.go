@@ -2,8 +2,11 @@ package order
 
 import (
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/dexguitar/spacecraftory/order/internal/model"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
 )
 
 func (s *OrderServiceSuite) TestCancelOrderSuccess() {
@@ -20,15 +23,56 @@ func (s *OrderServiceSuite) TestCancelOrderSuccess() {
 	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
 		Return(order, nil).Once()
 
-	s.orderRepository.On("UpdateOrder", s.ctx, order).
+	transition := &model.OrderStatusTransition{
+		OrderUUID: order.OrderUUID,
+		From:      model.OrderStatusPENDINGPAYMENT,
+		To:        model.OrderStatusCANCELLED,
+		Actor:     s.requester.UserUUID,
+		Reason:    model.CancelReasonUserRequest,
+	}
+
+	s.orderRepository.On("UpdateOrderWithOutbox", s.ctx, order, transition, matchOrderCancelled(order.OrderUUID, model.CancelReasonUserRequest)).
+		Return(nil).Once()
+
+	s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).
 		Return(nil).Once()
 
-	err := s.service.CancelOrder(s.ctx, order.OrderUUID)
+	err := s.service.CancelOrder(s.ctx, s.requester, order.OrderUUID)
 
 	s.Require().NoError(err)
 	assert.Equal(s.T(), model.OrderStatusCANCELLED, order.OrderStatus)
 }
 
+func (s *OrderServiceSuite) TestCancelOrderByAdmin() {
+	admin := model.Requester{UserUUID: "123e4567-e89b-12d3-a456-426614174099", IsAdmin: true}
+	order := &model.Order{
+		OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+		UserUUID:    s.requester.UserUUID,
+		OrderStatus: model.OrderStatusPENDINGPAYMENT,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).Once()
+
+	transition := &model.OrderStatusTransition{
+		OrderUUID: order.OrderUUID,
+		From:      model.OrderStatusPENDINGPAYMENT,
+		To:        model.OrderStatusCANCELLED,
+		Actor:     admin.UserUUID,
+		Reason:    model.CancelReasonAdminRequest,
+	}
+
+	s.orderRepository.On("UpdateOrderWithOutbox", s.ctx, order, transition, matchOrderCancelled(order.OrderUUID, model.CancelReasonAdminRequest)).
+		Return(nil).Once()
+
+	s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).
+		Return(nil).Once()
+
+	err := s.service.CancelOrder(s.ctx, admin, order.OrderUUID)
+
+	s.Require().NoError(err)
+}
+
 func (s *OrderServiceSuite) TestCancelOrderError() {
 	testCases := []struct {
 		name          string
@@ -51,6 +95,7 @@ func (s *OrderServiceSuite) TestCancelOrderError() {
 			mockSetup: func() {
 				order := &model.Order{
 					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
 					OrderStatus: model.OrderStatusPAID,
 				}
 				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
@@ -64,6 +109,7 @@ func (s *OrderServiceSuite) TestCancelOrderError() {
 			mockSetup: func() {
 				order := &model.Order{
 					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
 					OrderStatus: model.OrderStatusCANCELLED,
 				}
 				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
@@ -77,25 +123,74 @@ func (s *OrderServiceSuite) TestCancelOrderError() {
 			mockSetup: func() {
 				order := &model.Order{
 					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
 					OrderStatus: model.OrderStatusPENDINGPAYMENT,
 				}
 				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
 					Return(order, nil).Once()
 
-				s.orderRepository.On("UpdateOrder", s.ctx, order).
+				s.orderRepository.On("UpdateOrderWithOutbox", s.ctx, order, mock.Anything, mock.Anything).
 					Return(model.ErrOrderNotFound).Once()
 			},
 			expectedError: model.ErrOrderNotFound,
 		},
+		{
+			name:      "Order modified concurrently",
+			orderUUID: "123e4567-e89b-12d3-a456-426614174000",
+			mockSetup: func() {
+				order := &model.Order{
+					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
+					OrderStatus: model.OrderStatusPENDINGPAYMENT,
+					Version:     1,
+				}
+				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
+					Return(order, nil).Once()
+
+				// the reservation is left alone, the order was not cancelled by this call
+				s.orderRepository.On("UpdateOrderWithOutbox", s.ctx, order, mock.Anything, mock.Anything).
+					Return(model.ErrOrderConflict).Once()
+			},
+			expectedError: model.ErrOrderConflict,
+		},
+		{
+			name:      "Order of another user",
+			orderUUID: "123e4567-e89b-12d3-a456-426614174000",
+			mockSetup: func() {
+				order := &model.Order{
+					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    "123e4567-e89b-12d3-a456-426614174099",
+					OrderStatus: model.OrderStatusPENDINGPAYMENT,
+				}
+				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
+					Return(order, nil).Once()
+			},
+			expectedError: model.ErrOrderNotFound,
+		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			tc.mockSetup()
 
-			err := s.service.CancelOrder(s.ctx, tc.orderUUID)
+			err := s.service.CancelOrder(s.ctx, s.requester, tc.orderUUID)
 
 			assert.ErrorIs(s.T(), err, tc.expectedError)
 		})
 	}
 }
+
+// matchOrderCancelled matches the outbox message announcing the cancellation
+func matchOrderCancelled(orderUUID, reason string) any {
+	return mock.MatchedBy(func(message *model.OutboxMessage) bool {
+		var event eventsV1.OrderCancelled
+		if err := proto.Unmarshal(message.Payload, &event); err != nil {
+			return false
+		}
+
+		return message.EventType == model.OutboxEventOrderCancelled &&
+			message.ID == event.EventUuid &&
+			event.OrderUuid == orderUUID &&
+			event.Reason == reason
+	})
+}
@@ -3,13 +3,22 @@ package order
 import (
 	"context"
 
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
 	"github.com/dexguitar/spacecraftory/order/internal/metrics"
 	"github.com/dexguitar/spacecraftory/order/internal/model"
 )
 
-func (s *service) CreateOrder(ctx context.Context, userUUID string, partUUIDs []string) (*model.Order, error) {
-	if len(partUUIDs) == 0 {
-		return nil, model.ErrBadRequest
+func (s *service) CreateOrder(ctx context.Context, userUUID string, items []model.OrderItem) (*model.Order, error) {
+	items, err := mergeOrderItems(items)
+	if err != nil {
+		return nil, err
+	}
+
+	partUUIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		partUUIDs = append(partUUIDs, item.PartUUID)
 	}
 
 	filter := &model.PartsFilter{
@@ -24,20 +33,57 @@ func (s *service) CreateOrder(ctx context.Context, userUUID string, partUUIDs []
 		return nil, model.ErrPartsNotFound
 	}
 
-	var totalPrice float64
+	partsByUUID := make(map[string]model.Part, len(parts))
 	for _, part := range parts {
-		totalPrice += part.Price
+		partsByUUID[part.UUID] = part
+	}
+
+	// snapshot current part data into order lines, later price changes must not affect the order
+	var totalPrice float64
+	for i := range items {
+		part, ok := partsByUUID[items[i].PartUUID]
+		if !ok {
+			return nil, model.ErrPartsNotFound
+		}
+
+		items[i].UnitPrice = part.Price
+		items[i].Name = part.Name
+		items[i].Category = part.Category
+		totalPrice += part.Price * float64(items[i].Quantity)
 	}
 
 	order := &model.Order{
+		OrderUUID:   uuid.NewString(),
 		UserUUID:    userUUID,
 		PartUUIDs:   partUUIDs,
+		Items:       items,
 		TotalPrice:  totalPrice,
 		OrderStatus: model.OrderStatusPENDINGPAYMENT,
 	}
 
-	createdOrder, err := s.orderRepository.CreateOrder(ctx, order)
+	eventUUID := uuid.NewString()
+	payload, err := s.orderCreatedEncoder.Encode(model.OrderCreatedEvent{
+		EventUUID:   eventUUID,
+		OrderUUID:   order.OrderUUID,
+		UserUUID:    userUUID,
+		Items:       items,
+		TotalAmount: decimal.NewFromFloat(totalPrice).Round(2),
+		Currency:    model.CurrencyRUB,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// stock is reserved before the order exists, so an order is never stored without it
+	if err = s.inventoryClient.ReserveParts(ctx, order.OrderUUID, items); err != nil {
+		return nil, err
+	}
+
+	// OrderCreated is published by the outbox relay once the order is stored
+	createdOrder, err := s.orderRepository.CreateOrderWithOutbox(ctx, order, order.InitialTransition(userUUID),
+		outboxMessage(ctx, model.OutboxEventOrderCreated, eventUUID, payload))
 	if err != nil {
+		s.releaseReservation(ctx, order.OrderUUID)
 		return nil, err
 	}
 
@@ -51,3 +97,29 @@ func (s *service) CreateOrder(ctx context.Context, userUUID string, partUUIDs []
 
 	return createdOrder, nil
 }
+
+// mergeOrderItems validates order lines and sums up quantities of repeated parts,
+// keeping the order in which parts first appear
+func mergeOrderItems(items []model.OrderItem) ([]model.OrderItem, error) {
+	if len(items) == 0 {
+		return nil, model.ErrBadRequest
+	}
+
+	merged := make([]model.OrderItem, 0, len(items))
+	positions := make(map[string]int, len(items))
+	for _, item := range items {
+		if item.PartUUID == "" || item.Quantity <= 0 {
+			return nil, model.ErrBadRequest
+		}
+
+		if i, ok := positions[item.PartUUID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+
+		positions[item.PartUUID] = len(merged)
+		merged = append(merged, item)
+	}
+
+	return merged, nil
+}
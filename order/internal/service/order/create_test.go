@@ -3,9 +3,13 @@ package order
 import (
 	"errors"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/dexguitar/spacecraftory/order/internal/model"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
 )
 
 var (
@@ -17,52 +21,102 @@ func (s *OrderServiceSuite) TestCreateOrderSuccess() {
 	testCases := []struct {
 		name          string
 		userUUID      string
-		partUUIDs     []string
+		items         []model.OrderItem
+		expectedItems []model.OrderItem
 		parts         []model.Part
 		expectedPrice float64
 	}{
 		{
-			name:      "Single part",
-			userUUID:  "123e4567-e89b-12d3-a456-426614174000",
-			partUUIDs: []string{"part-uuid-1"},
+			name:     "Single part",
+			userUUID: "123e4567-e89b-12d3-a456-426614174000",
+			items:    []model.OrderItem{{PartUUID: "part-uuid-1", Quantity: 1}},
+			expectedItems: []model.OrderItem{
+				{PartUUID: "part-uuid-1", Quantity: 1, UnitPrice: 100.50, Name: "Engine", Category: model.PartCategoryEngine},
+			},
 			parts: []model.Part{
 				{
-					UUID:  "part-uuid-1",
-					Name:  "Engine",
-					Price: 100.50,
+					UUID:     "part-uuid-1",
+					Name:     "Engine",
+					Price:    100.50,
+					Category: model.PartCategoryEngine,
 				},
 			},
 			expectedPrice: 100.50,
 		},
 		{
-			name:      "Multiple parts",
-			userUUID:  "123e4567-e89b-12d3-a456-426614174000",
-			partUUIDs: []string{"part-uuid-1", "part-uuid-2", "part-uuid-3"},
+			name:     "Multiple parts",
+			userUUID: "123e4567-e89b-12d3-a456-426614174000",
+			items: []model.OrderItem{
+				{PartUUID: "part-uuid-1", Quantity: 1},
+				{PartUUID: "part-uuid-2", Quantity: 1},
+				{PartUUID: "part-uuid-3", Quantity: 1},
+			},
+			expectedItems: []model.OrderItem{
+				{PartUUID: "part-uuid-1", Quantity: 1, UnitPrice: 100.50, Name: "Engine", Category: model.PartCategoryEngine},
+				{PartUUID: "part-uuid-2", Quantity: 1, UnitPrice: 75.25, Name: "Fuel Cell", Category: model.PartCategoryFuel},
+				{PartUUID: "part-uuid-3", Quantity: 1, UnitPrice: 50.00, Name: "Wing", Category: model.PartCategoryWing},
+			},
 			parts: []model.Part{
 				{
-					UUID:  "part-uuid-1",
-					Name:  "Engine",
-					Price: 100.50,
+					UUID:     "part-uuid-1",
+					Name:     "Engine",
+					Price:    100.50,
+					Category: model.PartCategoryEngine,
 				},
 				{
-					UUID:  "part-uuid-2",
-					Name:  "Fuel Cell",
-					Price: 75.25,
+					UUID:     "part-uuid-2",
+					Name:     "Fuel Cell",
+					Price:    75.25,
+					Category: model.PartCategoryFuel,
 				},
 				{
-					UUID:  "part-uuid-3",
-					Name:  "Wing",
-					Price: 50.00,
+					UUID:     "part-uuid-3",
+					Name:     "Wing",
+					Price:    50.00,
+					Category: model.PartCategoryWing,
 				},
 			},
 			expectedPrice: 225.75,
 		},
+		{
+			name:     "Quantities and repeated parts",
+			userUUID: "123e4567-e89b-12d3-a456-426614174000",
+			items: []model.OrderItem{
+				{PartUUID: "part-uuid-1", Quantity: 2},
+				{PartUUID: "part-uuid-2", Quantity: 1},
+				{PartUUID: "part-uuid-1", Quantity: 1},
+			},
+			expectedItems: []model.OrderItem{
+				{PartUUID: "part-uuid-1", Quantity: 3, UnitPrice: 50.00, Name: "Wing Panel", Category: model.PartCategoryWing},
+				{PartUUID: "part-uuid-2", Quantity: 1, UnitPrice: 75.25, Name: "Fuel Cell", Category: model.PartCategoryFuel},
+			},
+			parts: []model.Part{
+				{
+					UUID:     "part-uuid-2",
+					Name:     "Fuel Cell",
+					Price:    75.25,
+					Category: model.PartCategoryFuel,
+				},
+				{
+					UUID:     "part-uuid-1",
+					Name:     "Wing Panel",
+					Price:    50.00,
+					Category: model.PartCategoryWing,
+				},
+			},
+			expectedPrice: 225.25,
+		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
+			partUUIDs := make([]string, 0, len(tc.expectedItems))
+			for _, item := range tc.expectedItems {
+				partUUIDs = append(partUUIDs, item.PartUUID)
+			}
+
 			filter := &model.PartsFilter{
-				UUIDs: tc.partUUIDs,
+				UUIDs: partUUIDs,
 			}
 
 			s.inventoryClient.On("ListParts", s.ctx, filter).
@@ -70,7 +124,8 @@ func (s *OrderServiceSuite) TestCreateOrderSuccess() {
 
 			expectedOrder := &model.Order{
 				UserUUID:    tc.userUUID,
-				PartUUIDs:   tc.partUUIDs,
+				PartUUIDs:   partUUIDs,
+				Items:       tc.expectedItems,
 				TotalPrice:  tc.expectedPrice,
 				OrderStatus: model.OrderStatusPENDINGPAYMENT,
 			}
@@ -78,15 +133,19 @@ func (s *OrderServiceSuite) TestCreateOrderSuccess() {
 			createdOrder := &model.Order{
 				OrderUUID:   "created-order-uuid",
 				UserUUID:    tc.userUUID,
-				PartUUIDs:   tc.partUUIDs,
+				PartUUIDs:   partUUIDs,
+				Items:       tc.expectedItems,
 				TotalPrice:  tc.expectedPrice,
 				OrderStatus: model.OrderStatusPENDINGPAYMENT,
 			}
 
-			s.orderRepository.On("CreateOrder", s.ctx, expectedOrder).
+			s.inventoryClient.On("ReserveParts", s.ctx, mock.AnythingOfType("string"), tc.expectedItems).
+				Return(nil).Once()
+
+			s.orderRepository.On("CreateOrderWithOutbox", s.ctx, matchNewOrder(expectedOrder), matchInitialTransition(tc.userUUID), matchOrderCreated(tc.userUUID, tc.expectedItems, tc.expectedPrice)).
 				Return(createdOrder, nil).Once()
 
-			order, err := s.service.CreateOrder(s.ctx, tc.userUUID, tc.partUUIDs)
+			order, err := s.service.CreateOrder(s.ctx, tc.userUUID, tc.items)
 
 			s.Require().NoError(err)
 			assert.Equal(s.T(), createdOrder, order)
@@ -98,21 +157,28 @@ func (s *OrderServiceSuite) TestCreateOrderError() {
 	testCases := []struct {
 		name          string
 		userUUID      string
-		partUUIDs     []string
+		items         []model.OrderItem
 		mockSetup     func()
 		expectedError error
 	}{
 		{
-			name:          "Empty part UUIDs",
+			name:          "Empty items",
+			userUUID:      "123e4567-e89b-12d3-a456-426614174000",
+			items:         []model.OrderItem{},
+			mockSetup:     func() {},
+			expectedError: model.ErrBadRequest,
+		},
+		{
+			name:          "Non-positive quantity",
 			userUUID:      "123e4567-e89b-12d3-a456-426614174000",
-			partUUIDs:     []string{},
+			items:         []model.OrderItem{{PartUUID: "part-uuid-1", Quantity: 0}},
 			mockSetup:     func() {},
 			expectedError: model.ErrBadRequest,
 		},
 		{
-			name:      "Inventory client error",
-			userUUID:  "123e4567-e89b-12d3-a456-426614174000",
-			partUUIDs: []string{"part-uuid-1"},
+			name:     "Inventory client error",
+			userUUID: "123e4567-e89b-12d3-a456-426614174000",
+			items:    []model.OrderItem{{PartUUID: "part-uuid-1", Quantity: 1}},
 			mockSetup: func() {
 				filter := &model.PartsFilter{
 					UUIDs: []string{"part-uuid-1"},
@@ -123,9 +189,9 @@ func (s *OrderServiceSuite) TestCreateOrderError() {
 			expectedError: ErrInventoryServiceError,
 		},
 		{
-			name:      "No parts found",
-			userUUID:  "123e4567-e89b-12d3-a456-426614174000",
-			partUUIDs: []string{"part-uuid-1"},
+			name:     "No parts found",
+			userUUID: "123e4567-e89b-12d3-a456-426614174000",
+			items:    []model.OrderItem{{PartUUID: "part-uuid-1", Quantity: 1}},
 			mockSetup: func() {
 				filter := &model.PartsFilter{
 					UUIDs: []string{"part-uuid-1"},
@@ -136,9 +202,13 @@ func (s *OrderServiceSuite) TestCreateOrderError() {
 			expectedError: model.ErrPartsNotFound,
 		},
 		{
-			name:      "Not all parts found",
-			userUUID:  "123e4567-e89b-12d3-a456-426614174000",
-			partUUIDs: []string{"part-uuid-1", "part-uuid-2", "part-uuid-3"},
+			name:     "Not all parts found",
+			userUUID: "123e4567-e89b-12d3-a456-426614174000",
+			items: []model.OrderItem{
+				{PartUUID: "part-uuid-1", Quantity: 1},
+				{PartUUID: "part-uuid-2", Quantity: 1},
+				{PartUUID: "part-uuid-3", Quantity: 1},
+			},
 			mockSetup: func() {
 				filter := &model.PartsFilter{
 					UUIDs: []string{"part-uuid-1", "part-uuid-2", "part-uuid-3"},
@@ -153,9 +223,9 @@ func (s *OrderServiceSuite) TestCreateOrderError() {
 			expectedError: model.ErrPartsNotFound,
 		},
 		{
-			name:      "Repository create error",
-			userUUID:  "123e4567-e89b-12d3-a456-426614174000",
-			partUUIDs: []string{"part-uuid-1"},
+			name:     "Repository create error",
+			userUUID: "123e4567-e89b-12d3-a456-426614174000",
+			items:    []model.OrderItem{{PartUUID: "part-uuid-1", Quantity: 1}},
 			mockSetup: func() {
 				filter := &model.PartsFilter{
 					UUIDs: []string{"part-uuid-1"},
@@ -166,27 +236,111 @@ func (s *OrderServiceSuite) TestCreateOrderError() {
 				s.inventoryClient.On("ListParts", s.ctx, filter).
 					Return(parts, nil).Once()
 
+				s.inventoryClient.On("ReserveParts", s.ctx, mock.AnythingOfType("string"), mock.Anything).
+					Return(nil).Once()
+
 				expectedOrder := &model.Order{
 					UserUUID:    "123e4567-e89b-12d3-a456-426614174000",
 					PartUUIDs:   []string{"part-uuid-1"},
+					Items:       []model.OrderItem{{PartUUID: "part-uuid-1", Quantity: 1, UnitPrice: 100.00, Name: "Engine"}},
 					TotalPrice:  100.00,
 					OrderStatus: model.OrderStatusPENDINGPAYMENT,
 				}
-				s.orderRepository.On("CreateOrder", s.ctx, expectedOrder).
+				s.orderRepository.On("CreateOrderWithOutbox", s.ctx, matchNewOrder(expectedOrder), mock.Anything, mock.Anything).
 					Return(nil, ErrCreateOrderError).Once()
+
+				// the reservation must not outlive the failed order
+				s.inventoryClient.On("ReleaseReservation", s.ctx, mock.AnythingOfType("string")).
+					Return(nil).Once()
 			},
 			expectedError: ErrCreateOrderError,
 		},
+		{
+			name:     "Insufficient stock",
+			userUUID: "123e4567-e89b-12d3-a456-426614174000",
+			items:    []model.OrderItem{{PartUUID: "part-uuid-1", Quantity: 100}},
+			mockSetup: func() {
+				filter := &model.PartsFilter{
+					UUIDs: []string{"part-uuid-1"},
+				}
+				parts := []model.Part{
+					{UUID: "part-uuid-1", Name: "Engine", Price: 100.00},
+				}
+				s.inventoryClient.On("ListParts", s.ctx, filter).
+					Return(parts, nil).Once()
+
+				s.inventoryClient.On("ReserveParts", s.ctx, mock.AnythingOfType("string"), mock.Anything).
+					Return(model.ErrInsufficientStock).Once()
+			},
+			expectedError: model.ErrInsufficientStock,
+		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			tc.mockSetup()
 
-			order, err := s.service.CreateOrder(s.ctx, tc.userUUID, tc.partUUIDs)
+			order, err := s.service.CreateOrder(s.ctx, tc.userUUID, tc.items)
 
 			assert.ErrorIs(s.T(), err, tc.expectedError)
 			assert.Nil(s.T(), order)
 		})
 	}
 }
+
+// matchNewOrder matches an order to be created; its UUID is generated by the service
+func matchNewOrder(expected *model.Order) any {
+	return mock.MatchedBy(func(order *model.Order) bool {
+		if order.OrderUUID == "" {
+			return false
+		}
+		withoutUUID := *order
+		withoutUUID.OrderUUID = ""
+		return assert.ObjectsAreEqual(expected, &withoutUUID)
+	})
+}
+
+// matchOrderCreated matches the outbox message announcing the new order
+func matchOrderCreated(userUUID string, items []model.OrderItem, totalPrice float64) any {
+	expectedItems := make([]*eventsV1.OrderCreatedItem, 0, len(items))
+	for _, item := range items {
+		expectedItems = append(expectedItems, &eventsV1.OrderCreatedItem{
+			PartUuid:  item.PartUUID,
+			Quantity:  int64(item.Quantity),
+			UnitPrice: decimal.NewFromFloat(item.UnitPrice).StringFixed(2),
+		})
+	}
+
+	return mock.MatchedBy(func(message *model.OutboxMessage) bool {
+		var event eventsV1.OrderCreated
+		if err := proto.Unmarshal(message.Payload, &event); err != nil {
+			return false
+		}
+
+		if len(event.Items) != len(expectedItems) {
+			return false
+		}
+		for i := range expectedItems {
+			if !proto.Equal(expectedItems[i], event.Items[i]) {
+				return false
+			}
+		}
+
+		return message.EventType == model.OutboxEventOrderCreated &&
+			message.ID == event.EventUuid &&
+			event.OrderUuid != "" &&
+			event.UserUuid == userUUID &&
+			event.TotalAmount == decimal.NewFromFloat(totalPrice).StringFixed(2) &&
+			event.Currency == model.CurrencyRUB
+	})
+}
+
+// matchInitialTransition matches the history record of a new order created by the user
+func matchInitialTransition(userUUID string) any {
+	return mock.MatchedBy(func(transition *model.OrderStatusTransition) bool {
+		return transition.From == "" &&
+			transition.To == model.OrderStatusPENDINGPAYMENT &&
+			transition.Actor == userUUID &&
+			transition.Reason == model.StatusReasonCreated
+	})
+}
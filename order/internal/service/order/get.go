@@ -6,11 +6,16 @@ import (
 	"github.com/dexguitar/spacecraftory/order/internal/model"
 )
 
-func (s *service) GetOrder(ctx context.Context, orderUUID string) (*model.Order, error) {
+func (s *service) GetOrder(ctx context.Context, requester model.Requester, orderUUID string) (*model.Order, error) {
 	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
 	if err != nil {
 		return nil, err
 	}
 
+	// orders of other users are reported as missing to not leak their existence
+	if !requester.CanAccess(order) {
+		return nil, model.ErrOrderNotFound
+	}
+
 	return order, nil
 }
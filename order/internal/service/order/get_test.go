@@ -20,7 +20,7 @@ func (s *OrderServiceSuite) TestGetOrderSuccess() {
 	s.orderRepository.On("GetOrder", s.ctx, expectedOrder.OrderUUID).
 		Return(expectedOrder, nil).Once()
 
-	order, err := s.service.GetOrder(s.ctx, expectedOrder.OrderUUID)
+	order, err := s.service.GetOrder(s.ctx, s.requester, expectedOrder.OrderUUID)
 
 	s.Require().NoError(err)
 	assert.Equal(s.T(), expectedOrder, order)
@@ -32,8 +32,37 @@ func (s *OrderServiceSuite) TestGetOrderError() {
 	s.orderRepository.On("GetOrder", s.ctx, invalidOrderUUID).
 		Return(nil, model.ErrOrderNotFound).Once()
 
-	order, err := s.service.GetOrder(s.ctx, invalidOrderUUID)
+	order, err := s.service.GetOrder(s.ctx, s.requester, invalidOrderUUID)
 
 	assert.ErrorIs(s.T(), err, model.ErrOrderNotFound)
 	assert.Nil(s.T(), order)
 }
+
+func (s *OrderServiceSuite) TestGetOrderOwnership() {
+	order := &model.Order{
+		OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+		UserUUID:    "123e4567-e89b-12d3-a456-426614174099",
+		OrderStatus: model.OrderStatusPENDINGPAYMENT,
+	}
+
+	s.Run("Another user's order is not found", func() {
+		s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+			Return(order, nil).Once()
+
+		result, err := s.service.GetOrder(s.ctx, s.requester, order.OrderUUID)
+
+		assert.ErrorIs(s.T(), err, model.ErrOrderNotFound)
+		assert.Nil(s.T(), result)
+	})
+
+	s.Run("Admin can access any order", func() {
+		s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+			Return(order, nil).Once()
+
+		admin := model.Requester{UserUUID: s.requester.UserUUID, IsAdmin: true}
+		result, err := s.service.GetOrder(s.ctx, admin, order.OrderUUID)
+
+		s.Require().NoError(err)
+		assert.Equal(s.T(), order, result)
+	})
+}
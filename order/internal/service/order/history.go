@@ -0,0 +1,16 @@
+package order
+
+import (
+	"context"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+func (s *service) GetOrderHistory(ctx context.Context, requester model.Requester, orderUUID string) ([]*model.OrderStatusTransition, error) {
+	// resolves access the same way as reading the order itself
+	if _, err := s.GetOrder(ctx, requester, orderUUID); err != nil {
+		return nil, err
+	}
+
+	return s.orderRepository.GetOrderStatusHistory(ctx, orderUUID)
+}
@@ -0,0 +1,45 @@
+package order
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+func (s *OrderServiceSuite) TestGetOrderHistorySuccess() {
+	order := &model.Order{
+		OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+		UserUUID:    s.requester.UserUUID,
+		OrderStatus: model.OrderStatusPAID,
+	}
+	history := []*model.OrderStatusTransition{
+		{OrderUUID: order.OrderUUID, To: model.OrderStatusPENDINGPAYMENT, Actor: order.UserUUID, Reason: model.StatusReasonCreated},
+		{OrderUUID: order.OrderUUID, From: model.OrderStatusPENDINGPAYMENT, To: model.OrderStatusPAID, Actor: order.UserUUID, Reason: model.StatusReasonPaid},
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).Once()
+	s.orderRepository.On("GetOrderStatusHistory", s.ctx, order.OrderUUID).
+		Return(history, nil).Once()
+
+	result, err := s.service.GetOrderHistory(s.ctx, s.requester, order.OrderUUID)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), history, result)
+}
+
+func (s *OrderServiceSuite) TestGetOrderHistoryOfAnotherUser() {
+	order := &model.Order{
+		OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+		UserUUID:    "123e4567-e89b-12d3-a456-426614174099",
+		OrderStatus: model.OrderStatusPAID,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).Once()
+
+	result, err := s.service.GetOrderHistory(s.ctx, s.requester, order.OrderUUID)
+
+	assert.ErrorIs(s.T(), err, model.ErrOrderNotFound)
+	assert.Nil(s.T(), result)
+}
@@ -0,0 +1,55 @@
+package order
+
+import (
+	"context"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// ListOrders returns a page of orders. Regular users only ever see their own
+// orders; admins may list orders of any user (filter.UserUUID) or of all users (nil).
+func (s *service) ListOrders(ctx context.Context, requester model.Requester, filter model.OrderFilter) (*model.OrderPage, error) {
+	if !requester.IsAdmin {
+		if filter.UserUUID != nil && *filter.UserUUID != requester.UserUUID {
+			return nil, model.ErrForbidden
+		}
+		filter.UserUUID = &requester.UserUUID
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return nil, model.ErrBadRequest
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultListLimit
+	case filter.Limit > maxListLimit:
+		filter.Limit = maxListLimit
+	}
+
+	limit := filter.Limit
+	// fetch one extra order to find out whether there is a next page
+	filter.Limit++
+
+	orders, err := s.orderRepository.ListOrders(ctx, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.OrderPage{Orders: orders}
+	if len(orders) > limit {
+		page.Orders = orders[:limit]
+		last := page.Orders[limit-1]
+		page.Next = &model.OrderCursor{
+			CreatedAt: last.CreatedAt,
+			OrderUUID: last.OrderUUID,
+		}
+	}
+
+	return page, nil
+}
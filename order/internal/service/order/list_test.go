@@ -0,0 +1,88 @@
+package order
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+func (s *OrderServiceSuite) TestListOrdersSuccess() {
+	createdAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	orders := []*model.Order{
+		{OrderUUID: "123e4567-e89b-12d3-a456-426614174003", UserUUID: s.requester.UserUUID, CreatedAt: createdAt},
+		{OrderUUID: "123e4567-e89b-12d3-a456-426614174002", UserUUID: s.requester.UserUUID, CreatedAt: createdAt.Add(-time.Minute)},
+		{OrderUUID: "123e4567-e89b-12d3-a456-426614174001", UserUUID: s.requester.UserUUID, CreatedAt: createdAt.Add(-2 * time.Minute)},
+	}
+
+	s.Run("Next page exists", func() {
+		s.orderRepository.On("ListOrders", s.ctx, mock.MatchedBy(func(filter *model.OrderFilter) bool {
+			return filter.Limit == 3 && filter.UserUUID != nil && *filter.UserUUID == s.requester.UserUUID
+		})).Return(orders, nil).Once()
+
+		page, err := s.service.ListOrders(s.ctx, s.requester, model.OrderFilter{Limit: 2})
+
+		s.Require().NoError(err)
+		assert.Equal(s.T(), orders[:2], page.Orders)
+		s.Require().NotNil(page.Next)
+		assert.Equal(s.T(), orders[1].OrderUUID, page.Next.OrderUUID)
+		assert.Equal(s.T(), orders[1].CreatedAt, page.Next.CreatedAt)
+	})
+
+	s.Run("Last page", func() {
+		s.orderRepository.On("ListOrders", s.ctx, mock.MatchedBy(func(filter *model.OrderFilter) bool {
+			return filter.Limit == defaultListLimit+1
+		})).Return(orders, nil).Once()
+
+		page, err := s.service.ListOrders(s.ctx, s.requester, model.OrderFilter{})
+
+		s.Require().NoError(err)
+		assert.Equal(s.T(), orders, page.Orders)
+		assert.Nil(s.T(), page.Next)
+	})
+
+	s.Run("Admin lists all users", func() {
+		s.orderRepository.On("ListOrders", s.ctx, mock.MatchedBy(func(filter *model.OrderFilter) bool {
+			return filter.UserUUID == nil && filter.Limit == maxListLimit+1
+		})).Return(orders, nil).Once()
+
+		admin := model.Requester{UserUUID: s.requester.UserUUID, IsAdmin: true}
+		page, err := s.service.ListOrders(s.ctx, admin, model.OrderFilter{Limit: 1000})
+
+		s.Require().NoError(err)
+		assert.Len(s.T(), page.Orders, len(orders))
+	})
+}
+
+func (s *OrderServiceSuite) TestListOrdersError() {
+	s.Run("Another user's orders", func() {
+		otherUser := "123e4567-e89b-12d3-a456-426614174099"
+
+		page, err := s.service.ListOrders(s.ctx, s.requester, model.OrderFilter{UserUUID: &otherUser})
+
+		assert.ErrorIs(s.T(), err, model.ErrForbidden)
+		assert.Nil(s.T(), page)
+	})
+
+	s.Run("Empty created_at range", func() {
+		from := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+		to := from.Add(-time.Hour)
+
+		page, err := s.service.ListOrders(s.ctx, s.requester, model.OrderFilter{CreatedFrom: &from, CreatedTo: &to})
+
+		assert.ErrorIs(s.T(), err, model.ErrBadRequest)
+		assert.Nil(s.T(), page)
+	})
+
+	s.Run("Repository error", func() {
+		s.orderRepository.On("ListOrders", s.ctx, mock.Anything).
+			Return(nil, model.ErrInternalServerError).Once()
+
+		page, err := s.service.ListOrders(s.ctx, s.requester, model.OrderFilter{})
+
+		assert.ErrorIs(s.T(), err, model.ErrInternalServerError)
+		assert.Nil(s.T(), page)
+	})
+}
@@ -0,0 +1,33 @@
+package order
+
+import (
+	"context"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
+)
+
+// outboxMessage wraps an encoded event for the outbox, the trace context is kept
+// with it so the consumers continue the trace of the operation
+func outboxMessage(ctx context.Context, eventType, eventUUID string, payload []byte) *model.OutboxMessage {
+	return &model.OutboxMessage{
+		ID:        eventUUID,
+		EventType: eventType,
+		Key:       eventUUID,
+		Payload:   payload,
+		Headers:   traceHeaders(ctx),
+	}
+}
+
+// traceHeaders returns the Kafka headers carrying the trace context of ctx
+func traceHeaders(ctx context.Context) map[string]string {
+	carrier := make(map[string][]byte)
+	tracing.InjectKafkaHeaders(ctx, carrier)
+
+	headers := make(map[string]string, len(carrier))
+	for k, v := range carrier {
+		headers[k] = string(v)
+	}
+
+	return headers
+}
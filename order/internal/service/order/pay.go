@@ -2,16 +2,28 @@ package order
 
 import (
 	"context"
+	"errors"
+	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 
 	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
 	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
 )
 
-func (s *service) PayOrder(ctx context.Context, orderUUID string, paymentMethod model.PaymentMethod) (string, error) {
+// Refund of a charge whose order changed before it was stored as paid. The key differs
+// from the order versions RefundOrder uses, a transaction belongs to one attempt only.
+const (
+	conflictRefundKey    = "pay-conflict"
+	conflictRefundReason = "order was modified while it was being paid"
+)
+
+func (s *service) PayOrder(ctx context.Context, requester model.Requester, orderUUID string, paymentMethod model.PaymentMethod) (string, error) {
 	// Create root span for the payment operation
 	ctx, span := tracing.StartSpan(ctx, "order.PayOrder",
 		trace.WithAttributes(
@@ -21,13 +33,14 @@ func (s *service) PayOrder(ctx context.Context, orderUUID string, paymentMethod
 	)
 	defer span.End()
 
-	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
+	order, err := s.GetOrder(ctx, requester, orderUUID)
 	if err != nil {
 		span.RecordError(err)
 		return "", err
 	}
 
-	if order.OrderStatus == model.OrderStatusPAID || order.OrderStatus == model.OrderStatusCANCELLED {
+	// checked before charging, the transition itself happens once the payment succeeded
+	if !order.OrderStatus.CanTransitionTo(model.OrderStatusPAID) {
 		span.RecordError(model.ErrInvalidOrderStatus)
 		return "", model.ErrInvalidOrderStatus
 	}
@@ -38,30 +51,48 @@ func (s *service) PayOrder(ctx context.Context, orderUUID string, paymentMethod
 		attribute.Float64("order.total_price", order.TotalPrice),
 	)
 
-	transactionUUID, err := s.paymentClient.PayOrder(ctx, orderUUID, order.UserUUID, paymentMethod)
-	if err != nil {
-		span.RecordError(err)
-		return "", model.ErrPaymentFailed
-	}
+	// prices are stored as float64, the amount is rounded to kopecks before it leaves the service
+	amount := decimal.NewFromFloat(order.TotalPrice).Round(2)
 
-	order.OrderStatus = model.OrderStatusPAID
-	order.TransactionUUID = transactionUUID
-	order.PaymentMethod = paymentMethod
+	// the version stays the same until the order is stored as paid,
+	// so a retry after a lost response gets the transaction of the first charge
+	attemptKey := strconv.FormatInt(order.Version, 10)
 
-	if err := s.orderRepository.UpdateOrder(ctx, order); err != nil {
+	// stock is committed before charging, so an order is never paid without its parts.
+	// Committing twice is a no-op, a retry of a paid attempt passes here again.
+	if err := s.commitReservation(ctx, order); err != nil {
 		span.RecordError(err)
 		return "", err
 	}
 
-	err = s.producerService.ProduceOrderPaid(ctx, model.OrderPaidEvent{
-		EventUUID:       uuid.NewString(),
-		OrderUUID:       orderUUID,
-		UserUUID:        order.UserUUID,
-		PaymentMethod:   string(paymentMethod),
-		TransactionUUID: transactionUUID,
-	})
+	transactionUUID, err := s.paymentClient.PayOrder(ctx, orderUUID, attemptKey, order.UserUUID, amount, model.CurrencyRUB, paymentMethod)
+	if err != nil {
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, model.ErrPaymentAlreadyExists):
+			// the attempt was paid already with other details, the stock stays with the order
+			return "", err
+		case errors.Is(err, model.ErrPaymentPending), errors.Is(err, model.ErrPaymentTimeout):
+			// the charge may still go through, the stock stays with the order
+			// until a retry of the attempt settles it
+			return "", err
+		}
+
+		// nothing was charged, the stock goes back until the order is paid again
+		s.releaseReservation(ctx, orderUUID)
+
+		if errors.Is(err, model.ErrPaymentLimitExceeded) || errors.Is(err, model.ErrPaymentDeclined) {
+			return "", err
+		}
+		return "", model.ErrPaymentFailed
+	}
+
+	err = s.markPaid(ctx, order, requester.UserUUID, transactionUUID, paymentMethod, amount)
 	if err != nil {
 		span.RecordError(err)
+		if errors.Is(err, model.ErrOrderConflict) {
+			return s.resolvePaymentConflict(ctx, orderUUID, transactionUUID)
+		}
 		return "", err
 	}
 
@@ -73,3 +104,60 @@ func (s *service) PayOrder(ctx context.Context, orderUUID string, paymentMethod
 
 	return transactionUUID, nil
 }
+
+// markPaid stores the order as paid with the charge, OrderPaid is published by the
+// outbox relay once the order is stored
+func (s *service) markPaid(ctx context.Context, order *model.Order, actor, transactionUUID string, paymentMethod model.PaymentMethod, amount decimal.Decimal) error {
+	transition, err := order.TransitionTo(model.OrderStatusPAID, actor, model.StatusReasonPaid)
+	if err != nil {
+		return err
+	}
+	order.TransactionUUID = transactionUUID
+	order.PaymentMethod = paymentMethod
+
+	eventUUID := uuid.NewString()
+	payload, err := s.orderPaidEncoder.Encode(model.OrderPaidEvent{
+		EventUUID:       eventUUID,
+		OrderUUID:       order.OrderUUID,
+		UserUUID:        order.UserUUID,
+		PaymentMethod:   string(paymentMethod),
+		TransactionUUID: transactionUUID,
+		Amount:          amount,
+		Currency:        model.CurrencyRUB,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.orderRepository.UpdateOrderWithOutbox(ctx, order, transition, outboxMessage(ctx, model.OutboxEventOrderPaid, eventUUID, payload))
+}
+
+// resolvePaymentConflict handles a charge whose order changed before it was stored as paid.
+// A concurrent retry of the same attempt gets the same transaction and may have stored
+// it already. Otherwise the order was cancelled meanwhile and the charge is refunded.
+func (s *service) resolvePaymentConflict(ctx context.Context, orderUUID, transactionUUID string) (string, error) {
+	current, err := s.orderRepository.GetOrder(ctx, orderUUID)
+	if err != nil {
+		// without the current state a refund could return the money of a paid order
+		logger.Error(ctx, "failed to get order modified concurrently after payment, the charge has to be reconciled by operators",
+			zap.String("order_uuid", orderUUID),
+			zap.String("transaction_uuid", transactionUUID),
+			zap.Error(err),
+		)
+		return "", model.ErrOrderConflict
+	}
+
+	if current.TransactionUUID == transactionUUID {
+		return transactionUUID, nil
+	}
+
+	if _, err = s.paymentClient.RefundPayment(ctx, transactionUUID, conflictRefundKey, decimal.Zero, conflictRefundReason); err != nil {
+		logger.Error(ctx, "failed to refund payment of order modified concurrently, the charge has to be reconciled by operators",
+			zap.String("order_uuid", orderUUID),
+			zap.String("transaction_uuid", transactionUUID),
+			zap.Error(err),
+		)
+	}
+
+	return "", model.ErrOrderConflict
+}
@@ -0,0 +1,177 @@
+package order
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	paymentClient "github.com/dexguitar/spacecraftory/order/internal/client/grpc/payment/v1"
+	clientMocks "github.com/dexguitar/spacecraftory/order/internal/client/mocks"
+	"github.com/dexguitar/spacecraftory/order/internal/converter/kafka/encoder"
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/mocks"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+	paymentV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1"
+)
+
+// paymentServer answers PayOrder with the error the payment service returns for a failed charge
+type paymentServer struct {
+	paymentV1.UnimplementedPaymentServiceServer
+	err error
+}
+
+func (s *paymentServer) PayOrder(context.Context, *paymentV1.PayOrderRequest) (*paymentV1.PayOrderResponse, error) {
+	return nil, s.err
+}
+
+// PayOrderPaymentServiceSuite pays orders through the gRPC payment client against
+// a payment server, so the errors are mapped the way they travel in production.
+type PayOrderPaymentServiceSuite struct {
+	suite.Suite
+	ctx             context.Context
+	requester       model.Requester
+	orderRepository *mocks.OrderRepository
+	inventoryClient *clientMocks.InventoryClient
+	paymentServer   *paymentServer
+	conn            *grpc.ClientConn
+	grpcServer      *grpc.Server
+	service         *service
+}
+
+func (s *PayOrderPaymentServiceSuite) SetupTest() {
+	logger.SetNopLogger()
+
+	s.ctx = context.Background()
+	s.requester = model.Requester{UserUUID: "123e4567-e89b-12d3-a456-426614174012"}
+	s.orderRepository = mocks.NewOrderRepository(s.T())
+	s.inventoryClient = clientMocks.NewInventoryClient(s.T())
+
+	listener := bufconn.Listen(1024 * 1024)
+	s.paymentServer = &paymentServer{}
+	s.grpcServer = grpc.NewServer()
+	paymentV1.RegisterPaymentServiceServer(s.grpcServer, s.paymentServer)
+	go func() {
+		_ = s.grpcServer.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	s.Require().NoError(err)
+	s.conn = conn
+
+	s.service = NewService(
+		s.orderRepository,
+		s.inventoryClient,
+		paymentClient.NewPaymentClient(paymentV1.NewPaymentServiceClient(conn)),
+		nil,
+		encoder.NewOrderPaidEncoder(),
+		encoder.NewOrderCreatedEncoder(),
+		encoder.NewOrderCancelledEncoder(),
+	)
+}
+
+func (s *PayOrderPaymentServiceSuite) TearDownTest() {
+	_ = s.conn.Close()
+	s.grpcServer.Stop()
+}
+
+func TestPayOrderPaymentService(t *testing.T) {
+	suite.Run(t, new(PayOrderPaymentServiceSuite))
+}
+
+func (s *PayOrderPaymentServiceSuite) TestPayOrderFailedCharge() {
+	testCases := []struct {
+		name            string
+		paymentErr      error
+		releasesStock   bool
+		expectedError   error
+		unexpectedError error
+	}{
+		{
+			name:          "Charge declined",
+			paymentErr:    status.Error(codes.PermissionDenied, "Payment was declined by the provider"),
+			releasesStock: true,
+			expectedError: model.ErrPaymentDeclined,
+		},
+		{
+			name:          "Payment method limit exceeded",
+			paymentErr:    status.Error(codes.FailedPrecondition, "Amount exceeds the limit of the payment method"),
+			releasesStock: true,
+			expectedError: model.ErrPaymentLimitExceeded,
+		},
+		{
+			name:          "Payment service failure",
+			paymentErr:    status.Error(codes.Internal, "Failed to process payment"),
+			releasesStock: true,
+			expectedError: model.ErrPaymentFailed,
+		},
+		{
+			name:          "Payment service unreachable",
+			paymentErr:    status.Error(codes.Unknown, "connection reset"),
+			releasesStock: true,
+			expectedError: model.ErrPaymentFailed,
+		},
+		{
+			name:            "Charge awaiting confirmation",
+			paymentErr:      status.Error(codes.Unavailable, "Payment is awaiting confirmation, retry the request"),
+			expectedError:   model.ErrPaymentPending,
+			unexpectedError: model.ErrPaymentFailed,
+		},
+		{
+			name:            "Provider timeout",
+			paymentErr:      status.Error(codes.DeadlineExceeded, "Payment provider did not respond in time"),
+			expectedError:   model.ErrPaymentTimeout,
+			unexpectedError: model.ErrPaymentFailed,
+		},
+		{
+			name:          "Attempt paid with other details",
+			paymentErr:    status.Error(codes.AlreadyExists, "Payment attempt already exists"),
+			expectedError: model.ErrPaymentAlreadyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.paymentServer.err = tc.paymentErr
+
+			order := &model.Order{
+				OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+				UserUUID:    s.requester.UserUUID,
+				TotalPrice:  1500,
+				OrderStatus: model.OrderStatusPENDINGPAYMENT,
+				Version:     1,
+			}
+			s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).
+				Return(order, nil).Once()
+			s.inventoryClient.On("CommitReservation", mock.Anything, order.OrderUUID).
+				Return(nil).Once()
+			// the stock is kept unless the charge surely did not go through,
+			// the mock fails on a release nobody expected
+			if tc.releasesStock {
+				s.inventoryClient.On("ReleaseReservation", mock.Anything, order.OrderUUID).
+					Return(nil).Once()
+			}
+
+			transactionUUID, err := s.service.PayOrder(s.ctx, s.requester, order.OrderUUID, model.PaymentMethodCARD)
+
+			assert.ErrorIs(s.T(), err, tc.expectedError)
+			if tc.unexpectedError != nil {
+				assert.NotErrorIs(s.T(), err, tc.unexpectedError)
+			}
+			assert.Empty(s.T(), transactionUUID)
+		})
+	}
+}
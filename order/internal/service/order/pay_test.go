@@ -3,9 +3,13 @@ package order
 import (
 	"errors"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/dexguitar/spacecraftory/order/internal/model"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
 )
 
 var (
@@ -19,6 +23,7 @@ func (s *OrderServiceSuite) TestPayOrderSuccess() {
 		orderUUID       string
 		paymentMethod   model.PaymentMethod
 		order           *model.Order
+		expectedAmount  string
 		transactionUUID string
 	}{
 		{
@@ -28,8 +33,10 @@ func (s *OrderServiceSuite) TestPayOrderSuccess() {
 			order: &model.Order{
 				OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
 				UserUUID:    "123e4567-e89b-12d3-a456-426614174012",
+				TotalPrice:  150000.10,
 				OrderStatus: model.OrderStatusPENDINGPAYMENT,
 			},
+			expectedAmount:  "150000.10",
 			transactionUUID: "txn-card-123",
 		},
 		{
@@ -39,8 +46,10 @@ func (s *OrderServiceSuite) TestPayOrderSuccess() {
 			order: &model.Order{
 				OrderUUID:   "123e4567-e89b-12d3-a456-426614174001",
 				UserUUID:    "123e4567-e89b-12d3-a456-426614174013",
+				TotalPrice:  75000,
 				OrderStatus: model.OrderStatusPENDINGPAYMENT,
 			},
+			expectedAmount:  "75000.00",
 			transactionUUID: "txn-sbp-456",
 		},
 		{
@@ -50,8 +59,10 @@ func (s *OrderServiceSuite) TestPayOrderSuccess() {
 			order: &model.Order{
 				OrderUUID:   "123e4567-e89b-12d3-a456-426614174002",
 				UserUUID:    "123e4567-e89b-12d3-a456-426614174014",
+				TotalPrice:  45000.5,
 				OrderStatus: model.OrderStatusPENDINGPAYMENT,
 			},
+			expectedAmount:  "45000.50",
 			transactionUUID: "txn-credit-789",
 		},
 		{
@@ -61,8 +72,10 @@ func (s *OrderServiceSuite) TestPayOrderSuccess() {
 			order: &model.Order{
 				OrderUUID:   "123e4567-e89b-12d3-a456-426614174003",
 				UserUUID:    "123e4567-e89b-12d3-a456-426614174015",
+				TotalPrice:  1250000,
 				OrderStatus: model.OrderStatusPENDINGPAYMENT,
 			},
+			expectedAmount:  "1250000.00",
 			transactionUUID: "txn-investor-abc",
 		},
 		{
@@ -72,32 +85,47 @@ func (s *OrderServiceSuite) TestPayOrderSuccess() {
 			order: &model.Order{
 				OrderUUID:   "123e4567-e89b-12d3-a456-426614174003",
 				UserUUID:    "123e4567-e89b-12d3-a456-426614174015",
+				TotalPrice:  1000,
 				OrderStatus: model.OrderStatusPENDINGPAYMENT,
 			},
+			expectedAmount:  "1000.00",
 			transactionUUID: "txn-unknown-abc",
 		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			s.orderRepository.On("GetOrder", s.ctx, tc.orderUUID).
+			s.orderRepository.On("GetOrder", mock.Anything, tc.orderUUID).
 				Return(tc.order, nil).Once()
 
-			s.paymentClient.On("PayOrder", s.ctx, tc.orderUUID, tc.order.UserUUID, tc.paymentMethod).
+			s.inventoryClient.On("CommitReservation", mock.Anything, tc.orderUUID).
+				Return(nil).Once()
+
+			s.paymentClient.On("PayOrder", mock.Anything, tc.orderUUID, "0", tc.order.UserUUID, matchAmount(tc.expectedAmount), model.CurrencyRUB, tc.paymentMethod).
 				Return(tc.transactionUUID, nil).Once()
 
 			updatedOrder := &model.Order{
 				OrderUUID:       tc.order.OrderUUID,
 				UserUUID:        tc.order.UserUUID,
+				TotalPrice:      tc.order.TotalPrice,
 				OrderStatus:     model.OrderStatusPAID,
 				TransactionUUID: tc.transactionUUID,
 				PaymentMethod:   tc.paymentMethod,
 			}
 
-			s.orderRepository.On("UpdateOrder", s.ctx, updatedOrder).
+			transition := &model.OrderStatusTransition{
+				OrderUUID: tc.orderUUID,
+				From:      model.OrderStatusPENDINGPAYMENT,
+				To:        model.OrderStatusPAID,
+				Actor:     tc.order.UserUUID,
+				Reason:    model.StatusReasonPaid,
+			}
+
+			s.orderRepository.On("UpdateOrderWithOutbox", mock.Anything, updatedOrder, transition, matchOrderPaid(tc.orderUUID, tc.transactionUUID, tc.expectedAmount)).
 				Return(nil).Once()
 
-			transactionUUID, err := s.service.PayOrder(s.ctx, tc.orderUUID, tc.paymentMethod)
+			requester := model.Requester{UserUUID: tc.order.UserUUID}
+			transactionUUID, err := s.service.PayOrder(s.ctx, requester, tc.orderUUID, tc.paymentMethod)
 
 			s.Require().NoError(err)
 			assert.Equal(s.T(), tc.transactionUUID, transactionUUID)
@@ -105,6 +133,145 @@ func (s *OrderServiceSuite) TestPayOrderSuccess() {
 	}
 }
 
+func (s *OrderServiceSuite) TestPayOrderReservationGone() {
+	items := []model.OrderItem{{PartUUID: "part-uuid-1", Quantity: 2, UnitPrice: 50}}
+
+	testCases := []struct {
+		name          string
+		reserveErr    error
+		expectedError error
+	}{
+		{
+			name: "Parts reserved again",
+		},
+		{
+			name:       "Parts reserved again by a concurrent payment",
+			reserveErr: model.ErrReservationExists,
+		},
+		{
+			name:          "Parts sold out meanwhile",
+			reserveErr:    model.ErrInsufficientStock,
+			expectedError: model.ErrInsufficientStock,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			order := &model.Order{
+				OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+				UserUUID:    s.requester.UserUUID,
+				Items:       items,
+				TotalPrice:  100,
+				OrderStatus: model.OrderStatusPENDINGPAYMENT,
+			}
+
+			s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).
+				Return(order, nil).Once()
+			// the reservation expired or the order predates reservations
+			s.inventoryClient.On("CommitReservation", mock.Anything, order.OrderUUID).
+				Return(model.ErrReservationNotFound).Once()
+			s.inventoryClient.On("ReserveParts", mock.Anything, order.OrderUUID, items).
+				Return(tc.reserveErr).Once()
+			if tc.expectedError == nil {
+				s.inventoryClient.On("CommitReservation", mock.Anything, order.OrderUUID).
+					Return(nil).Once()
+				s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID, "0", order.UserUUID, matchAmount("100.00"), model.CurrencyRUB, model.PaymentMethodCARD).
+					Return("txn-123", nil).Once()
+				s.orderRepository.On("UpdateOrderWithOutbox", mock.Anything, order, mock.Anything, matchOrderPaid(order.OrderUUID, "txn-123", "100.00")).
+					Return(nil).Once()
+			}
+
+			transactionUUID, err := s.service.PayOrder(s.ctx, s.requester, order.OrderUUID, model.PaymentMethodCARD)
+
+			if tc.expectedError != nil {
+				// the stock may be sold to someone else already, so nothing is charged
+				assert.ErrorIs(s.T(), err, tc.expectedError)
+				assert.Empty(s.T(), transactionUUID)
+				assert.Equal(s.T(), model.OrderStatusPENDINGPAYMENT, order.OrderStatus)
+				return
+			}
+			s.Require().NoError(err)
+			assert.Equal(s.T(), "txn-123", transactionUUID)
+		})
+	}
+}
+
+func (s *OrderServiceSuite) TestPayOrderAfterDecline() {
+	items := []model.OrderItem{{PartUUID: "part-uuid-1", Quantity: 1, UnitPrice: 100}}
+	order := &model.Order{
+		OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+		UserUUID:    s.requester.UserUUID,
+		Items:       items,
+		TotalPrice:  100,
+		OrderStatus: model.OrderStatusPENDINGPAYMENT,
+	}
+
+	// the first attempt is declined and gives the stock back
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).
+		Return(order, nil).Once()
+	s.inventoryClient.On("CommitReservation", mock.Anything, order.OrderUUID).
+		Return(nil).Once()
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID, "0", order.UserUUID, matchAmount("100.00"), model.CurrencyRUB, model.PaymentMethodCARD).
+		Return("", model.ErrPaymentDeclined).Once()
+	s.inventoryClient.On("ReleaseReservation", mock.Anything, order.OrderUUID).
+		Return(nil).Once()
+
+	_, err := s.service.PayOrder(s.ctx, s.requester, order.OrderUUID, model.PaymentMethodCARD)
+	assert.ErrorIs(s.T(), err, model.ErrPaymentDeclined)
+
+	// the retry with another method reserves the parts again and is charged
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).
+		Return(order, nil).Once()
+	s.inventoryClient.On("CommitReservation", mock.Anything, order.OrderUUID).
+		Return(model.ErrReservationNotFound).Once()
+	s.inventoryClient.On("ReserveParts", mock.Anything, order.OrderUUID, items).
+		Return(nil).Once()
+	s.inventoryClient.On("CommitReservation", mock.Anything, order.OrderUUID).
+		Return(nil).Once()
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID, "0", order.UserUUID, matchAmount("100.00"), model.CurrencyRUB, model.PaymentMethodSBP).
+		Return("txn-sbp", nil).Once()
+	s.orderRepository.On("UpdateOrderWithOutbox", mock.Anything, order, mock.Anything, matchOrderPaid(order.OrderUUID, "txn-sbp", "100.00")).
+		Return(nil).Once()
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, s.requester, order.OrderUUID, model.PaymentMethodSBP)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "txn-sbp", transactionUUID)
+	assert.Equal(s.T(), model.OrderStatusPAID, order.OrderStatus)
+}
+
+func (s *OrderServiceSuite) TestPayOrderConcurrentRetry() {
+	order := &model.Order{
+		OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+		UserUUID:    s.requester.UserUUID,
+		OrderStatus: model.OrderStatusPENDINGPAYMENT,
+		Version:     1,
+	}
+
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).
+		Return(order, nil).Once()
+	s.inventoryClient.On("CommitReservation", mock.Anything, order.OrderUUID).
+		Return(nil).Once()
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID, "1", order.UserUUID, mock.Anything, model.CurrencyRUB, model.PaymentMethodCARD).
+		Return("txn-123", nil).Once()
+	s.orderRepository.On("UpdateOrderWithOutbox", mock.Anything, order, mock.Anything, mock.Anything).
+		Return(model.ErrOrderConflict).Once()
+	// a retry of the same attempt stored the same transaction first, nothing is refunded
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).
+		Return(&model.Order{
+			OrderUUID:       order.OrderUUID,
+			UserUUID:        order.UserUUID,
+			OrderStatus:     model.OrderStatusPAID,
+			TransactionUUID: "txn-123",
+			Version:         2,
+		}, nil).Once()
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, s.requester, order.OrderUUID, model.PaymentMethodCARD)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "txn-123", transactionUUID)
+}
+
 func (s *OrderServiceSuite) TestPayOrderError() {
 	testCases := []struct {
 		name          string
@@ -118,7 +285,7 @@ func (s *OrderServiceSuite) TestPayOrderError() {
 			orderUUID:     "non-existent-uuid",
 			paymentMethod: model.PaymentMethodCARD,
 			mockSetup: func() {
-				s.orderRepository.On("GetOrder", s.ctx, "non-existent-uuid").
+				s.orderRepository.On("GetOrder", mock.Anything, "non-existent-uuid").
 					Return(nil, model.ErrOrderNotFound).Once()
 			},
 			expectedError: model.ErrOrderNotFound,
@@ -130,9 +297,10 @@ func (s *OrderServiceSuite) TestPayOrderError() {
 			mockSetup: func() {
 				order := &model.Order{
 					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
 					OrderStatus: model.OrderStatusPAID,
 				}
-				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
 					Return(order, nil).Once()
 			},
 			expectedError: model.ErrInvalidOrderStatus,
@@ -144,9 +312,25 @@ func (s *OrderServiceSuite) TestPayOrderError() {
 			mockSetup: func() {
 				order := &model.Order{
 					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
 					OrderStatus: model.OrderStatusCANCELLED,
 				}
-				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(order, nil).Once()
+			},
+			expectedError: model.ErrInvalidOrderStatus,
+		},
+		{
+			name:          "Order already assembled",
+			orderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+			paymentMethod: model.PaymentMethodCARD,
+			mockSetup: func() {
+				order := &model.Order{
+					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
+					OrderStatus: model.OrderStatusASSEMBLED,
+				}
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
 					Return(order, nil).Once()
 			},
 			expectedError: model.ErrInvalidOrderStatus,
@@ -161,14 +345,130 @@ func (s *OrderServiceSuite) TestPayOrderError() {
 					UserUUID:    "123e4567-e89b-12d3-a456-426614174012",
 					OrderStatus: model.OrderStatusPENDINGPAYMENT,
 				}
-				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
 					Return(order, nil).Once()
 
-				s.paymentClient.On("PayOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000", order.UserUUID, model.PaymentMethodCARD).
+				s.inventoryClient.On("CommitReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
+				s.paymentClient.On("PayOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000", "0", order.UserUUID, mock.Anything, model.CurrencyRUB, model.PaymentMethodCARD).
 					Return("", ErrPaymentClientError).Once()
+				// nothing was charged, the stock goes back
+				s.inventoryClient.On("ReleaseReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
 			},
 			expectedError: model.ErrPaymentFailed,
 		},
+		{
+			name:          "Payment method limit exceeded",
+			orderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+			paymentMethod: model.PaymentMethodCARD,
+			mockSetup: func() {
+				order := &model.Order{
+					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
+					TotalPrice:  2000000,
+					OrderStatus: model.OrderStatusPENDINGPAYMENT,
+				}
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(order, nil).Once()
+
+				s.inventoryClient.On("CommitReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
+				s.paymentClient.On("PayOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000", "0", order.UserUUID, matchAmount("2000000.00"), model.CurrencyRUB, model.PaymentMethodCARD).
+					Return("", model.ErrPaymentLimitExceeded).Once()
+				s.inventoryClient.On("ReleaseReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
+			},
+			expectedError: model.ErrPaymentLimitExceeded,
+		},
+		{
+			name:          "Payment declined",
+			orderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+			paymentMethod: model.PaymentMethodCARD,
+			mockSetup: func() {
+				order := &model.Order{
+					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
+					OrderStatus: model.OrderStatusPENDINGPAYMENT,
+				}
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(order, nil).Once()
+
+				s.inventoryClient.On("CommitReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
+				s.paymentClient.On("PayOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000", "0", order.UserUUID, mock.Anything, model.CurrencyRUB, model.PaymentMethodCARD).
+					Return("", model.ErrPaymentDeclined).Once()
+				s.inventoryClient.On("ReleaseReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
+			},
+			expectedError: model.ErrPaymentDeclined,
+		},
+		{
+			name:          "Payment awaiting confirmation",
+			orderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+			paymentMethod: model.PaymentMethodCARD,
+			mockSetup: func() {
+				order := &model.Order{
+					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
+					OrderStatus: model.OrderStatusPENDINGPAYMENT,
+				}
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(order, nil).Once()
+
+				s.inventoryClient.On("CommitReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
+				s.paymentClient.On("PayOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000", "0", order.UserUUID, mock.Anything, model.CurrencyRUB, model.PaymentMethodCARD).
+					Return("", model.ErrPaymentPending).Once()
+				// the charge may still go through, the stock is kept for a retry
+			},
+			expectedError: model.ErrPaymentPending,
+		},
+		{
+			name:          "Payment provider timeout",
+			orderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+			paymentMethod: model.PaymentMethodCARD,
+			mockSetup: func() {
+				order := &model.Order{
+					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
+					OrderStatus: model.OrderStatusPENDINGPAYMENT,
+				}
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(order, nil).Once()
+
+				s.inventoryClient.On("CommitReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
+				s.paymentClient.On("PayOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000", "0", order.UserUUID, mock.Anything, model.CurrencyRUB, model.PaymentMethodCARD).
+					Return("", model.ErrPaymentTimeout).Once()
+				// the charge may still go through, the stock is kept for a retry
+			},
+			expectedError: model.ErrPaymentTimeout,
+		},
+		{
+			name:          "Order already paid with different details",
+			orderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+			paymentMethod: model.PaymentMethodSBP,
+			mockSetup: func() {
+				order := &model.Order{
+					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
+					TotalPrice:  1000,
+					OrderStatus: model.OrderStatusPENDINGPAYMENT,
+					Version:     3,
+				}
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(order, nil).Once()
+
+				s.inventoryClient.On("CommitReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
+				// the lost response of a CARD payment for the same version is retried with SBP,
+				// the first charge went through so the stock is kept
+				s.paymentClient.On("PayOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000", "3", order.UserUUID, matchAmount("1000.00"), model.CurrencyRUB, model.PaymentMethodSBP).
+					Return("", model.ErrPaymentAlreadyExists).Once()
+			},
+			expectedError: model.ErrPaymentAlreadyExists,
+		},
 		{
 			name:          "Repository update error",
 			orderUUID:     "123e4567-e89b-12d3-a456-426614174000",
@@ -179,11 +479,14 @@ func (s *OrderServiceSuite) TestPayOrderError() {
 					UserUUID:    "123e4567-e89b-12d3-a456-426614174012",
 					OrderStatus: model.OrderStatusPENDINGPAYMENT,
 				}
-				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
 					Return(order, nil).Once()
 
+				s.inventoryClient.On("CommitReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
+
 				transactionUUID := "txn-123"
-				s.paymentClient.On("PayOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000", order.UserUUID, model.PaymentMethodCARD).
+				s.paymentClient.On("PayOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000", "0", order.UserUUID, mock.Anything, model.CurrencyRUB, model.PaymentMethodCARD).
 					Return(transactionUUID, nil).Once()
 
 				updatedOrder := &model.Order{
@@ -193,21 +496,78 @@ func (s *OrderServiceSuite) TestPayOrderError() {
 					TransactionUUID: transactionUUID,
 					PaymentMethod:   model.PaymentMethodCARD,
 				}
-				s.orderRepository.On("UpdateOrder", s.ctx, updatedOrder).
+				s.orderRepository.On("UpdateOrderWithOutbox", mock.Anything, updatedOrder, mock.Anything, mock.Anything).
 					Return(ErrUpdateOrderError).Once()
 			},
 			expectedError: ErrUpdateOrderError,
 		},
+		{
+			name:          "Order modified concurrently",
+			orderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+			paymentMethod: model.PaymentMethodCARD,
+			mockSetup: func() {
+				order := &model.Order{
+					OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+					UserUUID:    s.requester.UserUUID,
+					OrderStatus: model.OrderStatusPENDINGPAYMENT,
+					Version:     1,
+				}
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(order, nil).Once()
+				s.inventoryClient.On("CommitReservation", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil).Once()
+				s.paymentClient.On("PayOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000", "1", order.UserUUID, mock.Anything, model.CurrencyRUB, model.PaymentMethodCARD).
+					Return("txn-123", nil).Once()
+				// the stale version loses, the order was cancelled meanwhile and the charge goes back
+				s.orderRepository.On("UpdateOrderWithOutbox", mock.Anything, order, mock.Anything, mock.Anything).
+					Return(model.ErrOrderConflict).Once()
+				s.orderRepository.On("GetOrder", mock.Anything, "123e4567-e89b-12d3-a456-426614174000").
+					Return(&model.Order{
+						OrderUUID:   order.OrderUUID,
+						UserUUID:    order.UserUUID,
+						OrderStatus: model.OrderStatusCANCELLED,
+						Version:     2,
+					}, nil).Once()
+				s.paymentClient.On("RefundPayment", mock.Anything, "txn-123", conflictRefundKey, decimal.Zero, conflictRefundReason).
+					Return(&model.Refund{RefundUUID: "refund-123"}, nil).Once()
+			},
+			expectedError: model.ErrOrderConflict,
+		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			tc.mockSetup()
 
-			transactionUUID, err := s.service.PayOrder(s.ctx, tc.orderUUID, tc.paymentMethod)
+			transactionUUID, err := s.service.PayOrder(s.ctx, s.requester, tc.orderUUID, tc.paymentMethod)
 
 			assert.ErrorIs(s.T(), err, tc.expectedError)
 			assert.Empty(s.T(), transactionUUID)
 		})
 	}
 }
+
+// matchAmount matches a decimal amount equal to the expected decimal string
+func matchAmount(expected string) any {
+	return mock.MatchedBy(func(amount decimal.Decimal) bool {
+		return amount.Equal(decimal.RequireFromString(expected))
+	})
+}
+
+// matchOrderPaid matches an outbox message carrying OrderPaid for the order, transaction and amount
+func matchOrderPaid(orderUUID, transactionUUID, amount string) any {
+	return mock.MatchedBy(func(message *model.OutboxMessage) bool {
+		var event eventsV1.OrderPaid
+		if err := proto.Unmarshal(message.Payload, &event); err != nil {
+			return false
+		}
+
+		return message.EventType == model.OutboxEventOrderPaid &&
+			message.ID == event.EventUuid &&
+			message.Key == event.EventUuid &&
+			event.OrderUuid == orderUUID &&
+			event.TransactionUuid == transactionUUID &&
+			event.Amount == amount &&
+			event.Currency == model.CurrencyRUB
+	})
+}
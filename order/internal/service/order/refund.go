@@ -0,0 +1,112 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+// RefundOrder cancels a paid order that has not been assembled yet and refunds its payment,
+// a zero amount refunds everything left on it. A partial refund leaves the order
+// PARTIALLY_REFUNDED, it can be refunded further until nothing is left.
+func (s *service) RefundOrder(ctx context.Context, requester model.Requester, orderUUID string, amount decimal.Decimal, reason string) (*model.Refund, error) {
+	if amount.IsNegative() {
+		return nil, model.ErrBadRequest
+	}
+
+	order, err := s.GetOrder(ctx, requester, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// checked before refunding, the transition itself happens once the refund succeeded
+	if !order.OrderStatus.CanTransitionTo(model.OrderStatusREFUNDED) {
+		return nil, model.ErrInvalidOrderStatus
+	}
+
+	if reason == "" {
+		reason = model.StatusReasonRefunded
+	}
+
+	// the version stays the same until the refund is stored on the order, so a retry
+	// after a failed update gets the refund of the first request instead of a second one
+	refundKey := strconv.FormatInt(order.Version, 10)
+
+	refund, err := s.paymentClient.RefundPayment(ctx, order.TransactionUUID, refundKey, amount, reason)
+	if err != nil {
+		if errors.Is(err, model.ErrRefundExceedsPayment) || errors.Is(err, model.ErrOrderConflict) {
+			return nil, err
+		}
+		return nil, model.ErrRefundFailed
+	}
+
+	// the first refund cancels the order, later ones only return more of the payment
+	cancelled := order.OrderStatus == model.OrderStatusPAID
+
+	transition, err := order.TransitionTo(refund.OrderStatus(), requester.UserUUID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if cancelled {
+		err = s.updateCancelledOrder(ctx, order, transition, reason)
+	} else {
+		err = s.orderRepository.UpdateOrder(ctx, order, transition)
+	}
+	if err != nil {
+		if errors.Is(err, model.ErrOrderConflict) {
+			return s.resolveRefundConflict(ctx, orderUUID, refund)
+		}
+		return nil, err
+	}
+
+	// a cancelled order gives its committed stock back
+	if cancelled {
+		s.releaseReservation(ctx, orderUUID)
+	}
+
+	return refund, nil
+}
+
+// resolveRefundConflict handles a refund whose order changed before the refund was stored
+// on it. Every refund of the same order version uses the same key, so an order refunded
+// meanwhile got exactly this refund from a concurrent request. Otherwise the order was
+// assembled while it was being refunded.
+func (s *service) resolveRefundConflict(ctx context.Context, orderUUID string, refund *model.Refund) (*model.Refund, error) {
+	current, err := s.orderRepository.GetOrder(ctx, orderUUID)
+	if err == nil && (current.OrderStatus == model.OrderStatusPARTIALLYREFUNDED || current.OrderStatus == model.OrderStatusREFUNDED) {
+		return refund, nil
+	}
+
+	logger.Error(ctx, "order was modified concurrently after refund, the refund has to be reconciled by operators",
+		zap.String("order_uuid", orderUUID),
+		zap.String("refund_uuid", refund.RefundUUID),
+		zap.Error(err),
+	)
+
+	return nil, model.ErrOrderConflict
+}
+
+// updateCancelledOrder stores the refunded order together with the OrderCancelled event
+func (s *service) updateCancelledOrder(ctx context.Context, order *model.Order, transition *model.OrderStatusTransition, reason string) error {
+	eventUUID := uuid.NewString()
+	payload, err := s.orderCancelledEncoder.Encode(model.OrderCancelledEvent{
+		EventUUID: eventUUID,
+		OrderUUID: order.OrderUUID,
+		UserUUID:  order.UserUUID,
+		Reason:    reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.orderRepository.UpdateOrderWithOutbox(ctx, order, transition,
+		outboxMessage(ctx, model.OutboxEventOrderCancelled, eventUUID, payload))
+}
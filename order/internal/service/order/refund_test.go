@@ -0,0 +1,257 @@
+package order
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+var ErrRefundClientError = errors.New("refund client error")
+
+func (s *OrderServiceSuite) refundableOrder(status model.OrderStatus) *model.Order {
+	return &model.Order{
+		OrderUUID:       "123e4567-e89b-12d3-a456-426614174000",
+		UserUUID:        s.requester.UserUUID,
+		TotalPrice:      1000,
+		OrderStatus:     status,
+		TransactionUUID: "123e4567-e89b-12d3-a456-426614174003",
+		PaymentMethod:   model.PaymentMethodCARD,
+	}
+}
+
+func (s *OrderServiceSuite) TestRefundOrderSuccess() {
+	testCases := []struct {
+		name           string
+		status         model.OrderStatus
+		amount         decimal.Decimal
+		reason         string
+		refund         *model.Refund
+		expectedStatus model.OrderStatus
+		expectedReason string
+		cancels        bool
+	}{
+		{
+			name:   "Full refund of a paid order",
+			status: model.OrderStatusPAID,
+			amount: decimal.Zero,
+			refund: &model.Refund{
+				RefundUUID:      "refund-123",
+				Amount:          decimal.NewFromInt(1000),
+				Currency:        model.CurrencyRUB,
+				PaymentRefunded: true,
+			},
+			expectedStatus: model.OrderStatusREFUNDED,
+			expectedReason: model.StatusReasonRefunded,
+			cancels:        true,
+		},
+		{
+			name:   "Partial refund of a paid order",
+			status: model.OrderStatusPAID,
+			amount: decimal.RequireFromString("900.00"),
+			reason: "restocking fee withheld",
+			refund: &model.Refund{
+				RefundUUID: "refund-456",
+				Amount:     decimal.RequireFromString("900.00"),
+				Currency:   model.CurrencyRUB,
+			},
+			expectedStatus: model.OrderStatusPARTIALLYREFUNDED,
+			expectedReason: "restocking fee withheld",
+			cancels:        true,
+		},
+		{
+			name:   "Rest of a partially refunded order",
+			status: model.OrderStatusPARTIALLYREFUNDED,
+			amount: decimal.Zero,
+			refund: &model.Refund{
+				RefundUUID:      "refund-789",
+				Amount:          decimal.RequireFromString("100.00"),
+				Currency:        model.CurrencyRUB,
+				PaymentRefunded: true,
+			},
+			expectedStatus: model.OrderStatusREFUNDED,
+			expectedReason: model.StatusReasonRefunded,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			order := s.refundableOrder(tc.status)
+
+			s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+				Return(order, nil).Once()
+
+			s.paymentClient.On("RefundPayment", s.ctx, order.TransactionUUID, "0", tc.amount, tc.expectedReason).
+				Return(tc.refund, nil).Once()
+
+			transition := &model.OrderStatusTransition{
+				OrderUUID: order.OrderUUID,
+				From:      tc.status,
+				To:        tc.expectedStatus,
+				Actor:     s.requester.UserUUID,
+				Reason:    tc.expectedReason,
+			}
+
+			// only the refund that takes the order out of PAID cancels it and returns the stock
+			if tc.cancels {
+				s.orderRepository.On("UpdateOrderWithOutbox", s.ctx, order, transition, matchOrderCancelled(order.OrderUUID, tc.expectedReason)).
+					Return(nil).Once()
+				s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).
+					Return(nil).Once()
+			} else {
+				s.orderRepository.On("UpdateOrder", s.ctx, order, transition).
+					Return(nil).Once()
+			}
+
+			refund, err := s.service.RefundOrder(s.ctx, s.requester, order.OrderUUID, tc.amount, tc.reason)
+
+			s.Require().NoError(err)
+			assert.Equal(s.T(), tc.refund, refund)
+			assert.Equal(s.T(), tc.expectedStatus, order.OrderStatus)
+		})
+	}
+}
+
+func (s *OrderServiceSuite) TestRefundOrderConcurrentRequest() {
+	order := s.refundableOrder(model.OrderStatusPAID)
+	order.Version = 4
+	refund := &model.Refund{RefundUUID: "refund-123", Amount: decimal.NewFromInt(1000), PaymentRefunded: true}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).Once()
+	s.paymentClient.On("RefundPayment", s.ctx, order.TransactionUUID, "4", decimal.Zero, model.StatusReasonRefunded).
+		Return(refund, nil).Once()
+	s.orderRepository.On("UpdateOrderWithOutbox", s.ctx, order, mock.Anything, mock.Anything).
+		Return(model.ErrOrderConflict).Once()
+	// a concurrent request of the same version got the same refund and stored it first
+	refunded := s.refundableOrder(model.OrderStatusREFUNDED)
+	refunded.Version = 5
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+		Return(refunded, nil).Once()
+
+	result, err := s.service.RefundOrder(s.ctx, s.requester, order.OrderUUID, decimal.Zero, "")
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), refund, result)
+}
+
+func (s *OrderServiceSuite) TestRefundOrderError() {
+	testCases := []struct {
+		name          string
+		amount        decimal.Decimal
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:          "Negative amount",
+			amount:        decimal.RequireFromString("-1.00"),
+			mockSetup:     func() {},
+			expectedError: model.ErrBadRequest,
+		},
+		{
+			name:   "Order not found",
+			amount: decimal.Zero,
+			mockSetup: func() {
+				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
+					Return(nil, model.ErrOrderNotFound).Once()
+			},
+			expectedError: model.ErrOrderNotFound,
+		},
+		{
+			name:   "Order not paid",
+			amount: decimal.Zero,
+			mockSetup: func() {
+				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
+					Return(s.refundableOrder(model.OrderStatusPENDINGPAYMENT), nil).Once()
+			},
+			expectedError: model.ErrInvalidOrderStatus,
+		},
+		{
+			name:   "Order already assembled",
+			amount: decimal.Zero,
+			mockSetup: func() {
+				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
+					Return(s.refundableOrder(model.OrderStatusASSEMBLED), nil).Once()
+			},
+			expectedError: model.ErrInvalidOrderStatus,
+		},
+		{
+			name:   "Order already refunded",
+			amount: decimal.Zero,
+			mockSetup: func() {
+				s.orderRepository.On("GetOrder", s.ctx, "123e4567-e89b-12d3-a456-426614174000").
+					Return(s.refundableOrder(model.OrderStatusREFUNDED), nil).Once()
+			},
+			expectedError: model.ErrInvalidOrderStatus,
+		},
+		{
+			name:   "Refund exceeds payment",
+			amount: decimal.NewFromInt(5000),
+			mockSetup: func() {
+				order := s.refundableOrder(model.OrderStatusPAID)
+				s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+					Return(order, nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, order.TransactionUUID, "0", decimal.NewFromInt(5000), model.StatusReasonRefunded).
+					Return(nil, model.ErrRefundExceedsPayment).Once()
+			},
+			expectedError: model.ErrRefundExceedsPayment,
+		},
+		{
+			name:   "Payment client error",
+			amount: decimal.Zero,
+			mockSetup: func() {
+				order := s.refundableOrder(model.OrderStatusPAID)
+				s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+					Return(order, nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, order.TransactionUUID, "0", decimal.Zero, model.StatusReasonRefunded).
+					Return(nil, ErrRefundClientError).Once()
+			},
+			expectedError: model.ErrRefundFailed,
+		},
+		{
+			name:   "Order modified concurrently",
+			amount: decimal.Zero,
+			mockSetup: func() {
+				order := s.refundableOrder(model.OrderStatusPAID)
+				s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+					Return(order, nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, order.TransactionUUID, "0", decimal.Zero, model.StatusReasonRefunded).
+					Return(&model.Refund{RefundUUID: "refund-123", PaymentRefunded: true}, nil).Once()
+				s.orderRepository.On("UpdateOrderWithOutbox", s.ctx, order, mock.Anything, mock.Anything).
+					Return(model.ErrOrderConflict).Once()
+				// the order was assembled while it was being refunded
+				assembled := s.refundableOrder(model.OrderStatusASSEMBLED)
+				assembled.Version = 1
+				s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+					Return(assembled, nil).Once()
+			},
+			expectedError: model.ErrOrderConflict,
+		},
+		{
+			name:   "Refund of the same version with another amount",
+			amount: decimal.RequireFromString("100.00"),
+			mockSetup: func() {
+				order := s.refundableOrder(model.OrderStatusPAID)
+				s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+					Return(order, nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, order.TransactionUUID, "0", decimal.RequireFromString("100.00"), model.StatusReasonRefunded).
+					Return(nil, model.ErrOrderConflict).Once()
+			},
+			expectedError: model.ErrOrderConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.mockSetup()
+
+			refund, err := s.service.RefundOrder(s.ctx, s.requester, "123e4567-e89b-12d3-a456-426614174000", tc.amount, "")
+
+			assert.ErrorIs(s.T(), err, tc.expectedError)
+			assert.Nil(s.T(), refund)
+		})
+	}
+}
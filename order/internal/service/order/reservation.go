@@ -0,0 +1,42 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+// releaseReservation gives reserved or committed stock back. Orders created before
+// reservations were introduced have none, and expired ones are already reclaimed by
+// inventory, so a missing reservation is not an error.
+func (s *service) releaseReservation(ctx context.Context, orderUUID string) {
+	err := s.inventoryClient.ReleaseReservation(ctx, orderUUID)
+	if err != nil && !errors.Is(err, model.ErrReservationNotFound) {
+		logger.Error(ctx, "failed to release stock reservation",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+	}
+}
+
+// commitReservation commits the stock of an order that is about to be charged. Stock
+// of an order created before reservations were introduced, of an expired reservation
+// or of a failed charge was given back, it is reserved again first.
+func (s *service) commitReservation(ctx context.Context, order *model.Order) error {
+	err := s.inventoryClient.CommitReservation(ctx, order.OrderUUID)
+	if !errors.Is(err, model.ErrReservationNotFound) {
+		return err
+	}
+
+	err = s.inventoryClient.ReserveParts(ctx, order.OrderUUID, order.Items)
+	if err != nil && !errors.Is(err, model.ErrReservationExists) {
+		return err
+	}
+
+	// a concurrent payment of the order may have reserved the stock meanwhile
+	return s.inventoryClient.CommitReservation(ctx, order.OrderUUID)
+}
@@ -2,16 +2,19 @@ package order
 
 import (
 	client "github.com/dexguitar/spacecraftory/order/internal/client"
+	kafkaConverter "github.com/dexguitar/spacecraftory/order/internal/converter/kafka"
 	"github.com/dexguitar/spacecraftory/order/internal/repository"
-	def "github.com/dexguitar/spacecraftory/order/internal/service"
 )
 
 type service struct {
-	orderRepository repository.OrderRepository
-	inventoryClient client.InventoryClient
-	paymentClient   client.PaymentClient
-	iamClient       client.IAMClient
-	producerService def.ProducerService
+	orderRepository  repository.OrderRepository
+	inventoryClient  client.InventoryClient
+	paymentClient    client.PaymentClient
+	iamClient        client.IAMClient
+	orderPaidEncoder kafkaConverter.OrderPaidEncoder
+
+	orderCreatedEncoder   kafkaConverter.OrderCreatedEncoder
+	orderCancelledEncoder kafkaConverter.OrderCancelledEncoder
 }
 
 func NewService(
@@ -19,13 +22,18 @@ func NewService(
 	inventoryClient client.InventoryClient,
 	paymentClient client.PaymentClient,
 	iamClient client.IAMClient,
-	producerService def.ProducerService,
+	orderPaidEncoder kafkaConverter.OrderPaidEncoder,
+	orderCreatedEncoder kafkaConverter.OrderCreatedEncoder,
+	orderCancelledEncoder kafkaConverter.OrderCancelledEncoder,
 ) *service {
 	return &service{
-		orderRepository: orderRepository,
-		inventoryClient: inventoryClient,
-		paymentClient:   paymentClient,
-		iamClient:       iamClient,
-		producerService: producerService,
+		orderRepository:  orderRepository,
+		inventoryClient:  inventoryClient,
+		paymentClient:    paymentClient,
+		iamClient:        iamClient,
+		orderPaidEncoder: orderPaidEncoder,
+
+		orderCreatedEncoder:   orderCreatedEncoder,
+		orderCancelledEncoder: orderCancelledEncoder,
 	}
 }
@@ -0,0 +1,73 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+// settledRefundReason explains the refund of a charge that settled after its order could
+// no longer be paid with it. It uses the key of the conflict refund in PayOrder, so a
+// charge refunded by both is refunded once.
+const settledRefundReason = "payment settled after the order could no longer be paid"
+
+// SettlePayment applies a charge the payment service confirmed or declined after the
+// order was paid. A confirmed charge pays the order if it is still awaiting payment and
+// is refunded otherwise, so a cancelled order never keeps the money. A declined charge
+// changes nothing, the stock stays reserved until the order is paid again or expires.
+func (s *service) SettlePayment(ctx context.Context, event model.PaymentSettledEvent) error {
+	if event.Status != model.PaymentStatusSUCCEEDED {
+		logger.Info(ctx, "pending payment of order was declined",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.String("transaction_uuid", event.TransactionUUID),
+		)
+		return nil
+	}
+
+	order, err := s.orderRepository.GetOrder(ctx, event.OrderUUID)
+	if errors.Is(err, model.ErrOrderNotFound) {
+		return s.refundSettled(ctx, event)
+	}
+	if err != nil {
+		return err
+	}
+
+	// a retry of the attempt got the confirmation first and stored it
+	if order.TransactionUUID == event.TransactionUUID {
+		return nil
+	}
+
+	if order.TransactionUUID != "" || !order.OrderStatus.CanTransitionTo(model.OrderStatusPAID) {
+		return s.refundSettled(ctx, event)
+	}
+
+	// the reservation may have expired while the charge was pending
+	err = s.commitReservation(ctx, order)
+	if errors.Is(err, model.ErrInsufficientStock) || errors.Is(err, model.ErrPartsNotFound) {
+		return s.refundSettled(ctx, event)
+	}
+	if err != nil {
+		return err
+	}
+
+	// a conflict is retried by the consumer, the next attempt reads the order again
+	return s.markPaid(ctx, order, model.ActorPayment, event.TransactionUUID, event.PaymentMethod, event.Amount)
+}
+
+// refundSettled returns the whole charge of an order that cannot be paid with it. The event
+// is published before the payment service records the settlement, a refund that finds the
+// charge still pending fails and is retried by the consumer.
+func (s *service) refundSettled(ctx context.Context, event model.PaymentSettledEvent) error {
+	logger.Warn(ctx, "payment settled for an order that cannot be paid with it, refunding",
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("transaction_uuid", event.TransactionUUID),
+	)
+
+	_, err := s.paymentClient.RefundPayment(ctx, event.TransactionUUID, conflictRefundKey, decimal.Zero, settledRefundReason)
+	return err
+}
@@ -0,0 +1,175 @@
+package order
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+)
+
+func settledEvent(status string) model.PaymentSettledEvent {
+	return model.PaymentSettledEvent{
+		EventUUID:       "txn-123",
+		OrderUUID:       "123e4567-e89b-12d3-a456-426614174000",
+		TransactionUUID: "txn-123",
+		AttemptKey:      "0",
+		Status:          status,
+		PaymentMethod:   model.PaymentMethodSBP,
+		Amount:          decimal.RequireFromString("100.00"),
+		Currency:        model.CurrencyRUB,
+	}
+}
+
+func (s *OrderServiceSuite) TestSettlePaymentPaysPendingOrder() {
+	event := settledEvent(model.PaymentStatusSUCCEEDED)
+	order := &model.Order{
+		OrderUUID:   event.OrderUUID,
+		UserUUID:    s.requester.UserUUID,
+		TotalPrice:  100,
+		OrderStatus: model.OrderStatusPENDINGPAYMENT,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, event.OrderUUID).
+		Return(order, nil).Once()
+	s.inventoryClient.On("CommitReservation", s.ctx, event.OrderUUID).
+		Return(nil).Once()
+	s.orderRepository.On("UpdateOrderWithOutbox", s.ctx, order,
+		mock.MatchedBy(func(transition *model.OrderStatusTransition) bool {
+			return transition.To == model.OrderStatusPAID && transition.Actor == model.ActorPayment
+		}),
+		matchOrderPaid(event.OrderUUID, event.TransactionUUID, "100.00"),
+	).Return(nil).Once()
+
+	err := s.service.SettlePayment(s.ctx, event)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), model.OrderStatusPAID, order.OrderStatus)
+	assert.Equal(s.T(), event.TransactionUUID, order.TransactionUUID)
+	assert.Equal(s.T(), model.PaymentMethodSBP, order.PaymentMethod)
+}
+
+func (s *OrderServiceSuite) TestSettlePaymentAlreadyStored() {
+	event := settledEvent(model.PaymentStatusSUCCEEDED)
+	order := &model.Order{
+		OrderUUID:       event.OrderUUID,
+		OrderStatus:     model.OrderStatusPAID,
+		TransactionUUID: event.TransactionUUID,
+	}
+
+	// a retry of the attempt stored the transaction first, nothing is refunded
+	s.orderRepository.On("GetOrder", s.ctx, event.OrderUUID).
+		Return(order, nil).Once()
+
+	err := s.service.SettlePayment(s.ctx, event)
+
+	s.Require().NoError(err)
+}
+
+func (s *OrderServiceSuite) TestSettlePaymentRefundsUnpayableOrder() {
+	testCases := []struct {
+		name       string
+		order      *model.Order
+		getErr     error
+		reserveErr error
+	}{
+		{
+			name: "Order cancelled while the charge was pending",
+			order: &model.Order{
+				OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+				OrderStatus: model.OrderStatusCANCELLED,
+			},
+		},
+		{
+			name: "Order paid with another transaction",
+			order: &model.Order{
+				OrderUUID:       "123e4567-e89b-12d3-a456-426614174000",
+				OrderStatus:     model.OrderStatusPAID,
+				TransactionUUID: "txn-other",
+			},
+		},
+		{
+			name:   "Order not found",
+			getErr: model.ErrOrderNotFound,
+		},
+		{
+			name: "Parts sold out while the charge was pending",
+			order: &model.Order{
+				OrderUUID:   "123e4567-e89b-12d3-a456-426614174000",
+				OrderStatus: model.OrderStatusPENDINGPAYMENT,
+			},
+			reserveErr: model.ErrInsufficientStock,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			event := settledEvent(model.PaymentStatusSUCCEEDED)
+
+			s.orderRepository.On("GetOrder", s.ctx, event.OrderUUID).
+				Return(tc.order, tc.getErr).Once()
+			if tc.reserveErr != nil {
+				s.inventoryClient.On("CommitReservation", s.ctx, event.OrderUUID).
+					Return(model.ErrReservationNotFound).Once()
+				s.inventoryClient.On("ReserveParts", s.ctx, event.OrderUUID, tc.order.Items).
+					Return(tc.reserveErr).Once()
+			}
+			s.paymentClient.On("RefundPayment", s.ctx, event.TransactionUUID, conflictRefundKey, decimal.Zero, settledRefundReason).
+				Return(&model.Refund{}, nil).Once()
+
+			err := s.service.SettlePayment(s.ctx, event)
+
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *OrderServiceSuite) TestSettlePaymentRefundFailure() {
+	event := settledEvent(model.PaymentStatusSUCCEEDED)
+	order := &model.Order{
+		OrderUUID:   event.OrderUUID,
+		OrderStatus: model.OrderStatusCANCELLED,
+	}
+	refundErr := errors.New("payment service unavailable")
+
+	s.orderRepository.On("GetOrder", s.ctx, event.OrderUUID).
+		Return(order, nil).Once()
+	s.paymentClient.On("RefundPayment", s.ctx, event.TransactionUUID, conflictRefundKey, decimal.Zero, settledRefundReason).
+		Return(nil, refundErr).Once()
+
+	err := s.service.SettlePayment(s.ctx, event)
+
+	// the event is redelivered until the charge is refunded
+	assert.ErrorIs(s.T(), err, refundErr)
+}
+
+func (s *OrderServiceSuite) TestSettlePaymentConflict() {
+	event := settledEvent(model.PaymentStatusSUCCEEDED)
+	order := &model.Order{
+		OrderUUID:   event.OrderUUID,
+		TotalPrice:  100,
+		OrderStatus: model.OrderStatusPENDINGPAYMENT,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, event.OrderUUID).
+		Return(order, nil).Once()
+	s.inventoryClient.On("CommitReservation", s.ctx, event.OrderUUID).
+		Return(nil).Once()
+	s.orderRepository.On("UpdateOrderWithOutbox", s.ctx, order, mock.Anything, mock.Anything).
+		Return(model.ErrOrderConflict).Once()
+
+	err := s.service.SettlePayment(s.ctx, event)
+
+	// retried by the consumer, the next attempt reads the order again
+	assert.ErrorIs(s.T(), err, model.ErrOrderConflict)
+}
+
+func (s *OrderServiceSuite) TestSettlePaymentDeclined() {
+	event := settledEvent(model.PaymentStatusDECLINED)
+
+	err := s.service.SettlePayment(s.ctx, event)
+
+	s.Require().NoError(err)
+}
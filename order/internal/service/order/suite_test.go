@@ -7,33 +7,39 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	clientMocks "github.com/dexguitar/spacecraftory/order/internal/client/mocks"
+	"github.com/dexguitar/spacecraftory/order/internal/converter/kafka/encoder"
+	"github.com/dexguitar/spacecraftory/order/internal/model"
 	"github.com/dexguitar/spacecraftory/order/internal/repository/mocks"
-	serviceMocks "github.com/dexguitar/spacecraftory/order/internal/service/mocks"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
 )
 
 type OrderServiceSuite struct {
 	suite.Suite
 	ctx             context.Context
+	requester       model.Requester
 	orderRepository *mocks.OrderRepository
 	inventoryClient *clientMocks.InventoryClient
 	paymentClient   *clientMocks.PaymentClient
 	iamClient       *clientMocks.IAMClient
-	producerService *serviceMocks.ProducerService
 	service         *service
 }
 
 func (s *OrderServiceSuite) SetupTest() {
+	logger.SetNopLogger()
+
 	s.ctx = context.Background()
+	s.requester = model.Requester{UserUUID: "123e4567-e89b-12d3-a456-426614174012"}
 	s.orderRepository = mocks.NewOrderRepository(s.T())
 	s.inventoryClient = clientMocks.NewInventoryClient(s.T())
 	s.paymentClient = clientMocks.NewPaymentClient(s.T())
-	s.producerService = serviceMocks.NewProducerService(s.T())
 	s.service = NewService(
 		s.orderRepository,
 		s.inventoryClient,
 		s.paymentClient,
 		s.iamClient,
-		s.producerService,
+		encoder.NewOrderPaidEncoder(),
+		encoder.NewOrderCreatedEncoder(),
+		encoder.NewOrderCancelledEncoder(),
 	)
 }
 
@@ -1,47 +1,37 @@
 package ufo_producer
 
 import (
-	"context"
+	"time"
 
-	"go.uber.org/zap"
-	"google.golang.org/protobuf/proto"
-
-	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/order/internal/repository"
 	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
-	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
-	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
 )
 
+// outboxLease is how long a claimed message stays hidden from other relays,
+// it has to outlast a Kafka send so a message is not published twice in a row
+const outboxLease = 30 * time.Second
+
+// service publishes outbox messages with the producer registered for their event type
 type service struct {
-	orderPaidProducer kafka.Producer
+	outboxRepository repository.OutboxRepository
+	producers        map[string]kafka.Producer
+	pollInterval     time.Duration
+	batchSize        int
+	maxRetryDelay    time.Duration
 }
 
-func NewService(orderPaidProducer kafka.Producer) *service {
+func NewService(
+	outboxRepository repository.OutboxRepository,
+	producers map[string]kafka.Producer,
+	pollInterval time.Duration,
+	batchSize int,
+	maxRetryDelay time.Duration,
+) *service {
 	return &service{
-		orderPaidProducer: orderPaidProducer,
-	}
-}
-
-func (p *service) ProduceOrderPaid(ctx context.Context, event model.OrderPaidEvent) error {
-	msg := &eventsV1.OrderPaid{
-		EventUuid:       event.EventUUID,
-		OrderUuid:       event.OrderUUID,
-		UserUuid:        event.UserUUID,
-		PaymentMethod:   event.PaymentMethod,
-		TransactionUuid: event.TransactionUUID,
+		outboxRepository: outboxRepository,
+		producers:        producers,
+		pollInterval:     pollInterval,
+		batchSize:        batchSize,
+		maxRetryDelay:    maxRetryDelay,
 	}
-
-	payload, err := proto.Marshal(msg)
-	if err != nil {
-		logger.Error(ctx, "failed to marshal OrderPaid", zap.Error(err))
-		return err
-	}
-
-	err = p.orderPaidProducer.Send(ctx, []byte(event.EventUUID), payload)
-	if err != nil {
-		logger.Error(ctx, "failed to publish OrderPaid", zap.Error(err))
-		return err
-	}
-
-	return nil
 }
@@ -0,0 +1,121 @@
+package ufo_producer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
+)
+
+// RunRelay publishes pending outbox messages until the context is cancelled.
+// A message is marked sent only after Kafka acknowledged it, so delivery is at least once
+func (s *service) RunRelay(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Outbox relay running")
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.relayPending(ctx)
+		}
+	}
+}
+
+func (s *service) relayPending(ctx context.Context) {
+	for ctx.Err() == nil {
+		messages, err := s.outboxRepository.ClaimPendingMessages(ctx, s.batchSize, outboxLease)
+		if err != nil {
+			logger.Error(ctx, "failed to claim outbox messages", zap.Error(err))
+			return
+		}
+
+		for _, message := range messages {
+			s.relay(ctx, message)
+		}
+
+		if len(messages) < s.batchSize {
+			return
+		}
+	}
+}
+
+func (s *service) relay(ctx context.Context, message *model.OutboxMessage) {
+	err := s.publish(ctx, message)
+	if err != nil {
+		retryDelay := s.retryDelay(message.Attempts)
+		logger.Error(ctx, "failed to publish outbox message",
+			zap.String("id", message.ID),
+			zap.String("event_type", message.EventType),
+			zap.Int("attempts", message.Attempts+1),
+			zap.Duration("retry_in", retryDelay),
+			zap.Error(err),
+		)
+
+		if markErr := s.outboxRepository.MarkFailed(ctx, message.ID, err.Error(), retryDelay); markErr != nil {
+			logger.Error(ctx, "failed to mark outbox message as failed", zap.String("id", message.ID), zap.Error(markErr))
+		}
+		return
+	}
+
+	// the message is published already, if this fails it is sent once more after the lease
+	if err := s.outboxRepository.MarkSent(ctx, message.ID); err != nil {
+		logger.Error(ctx, "failed to mark outbox message as sent", zap.String("id", message.ID), zap.Error(err))
+	}
+}
+
+func (s *service) publish(ctx context.Context, message *model.OutboxMessage) error {
+	producer, ok := s.producers[message.EventType]
+	if !ok {
+		return fmt.Errorf("%w: %s", model.ErrUnknownEventType, message.EventType)
+	}
+
+	// continue the trace of the operation that wrote the message
+	headers := make(map[string][]byte, len(message.Headers))
+	for k, v := range message.Headers {
+		headers[k] = []byte(v)
+	}
+	ctx = tracing.ExtractKafkaHeaders(ctx, headers)
+
+	ctx, span := tracing.StartSpan(ctx, "outbox.publish "+message.EventType,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attribute.String("outbox.id", message.ID)),
+	)
+	defer span.End()
+
+	// the event occurred when the outbox row was written, not when it is relayed
+	err := producer.SendMessage(ctx, kafka.ProducerMessage{
+		Key:   []byte(message.Key),
+		Value: message.Payload,
+		Headers: map[string][]byte{
+			kafka.OccurredAtHeader: kafka.FormatOccurredAt(message.CreatedAt),
+		},
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// retryDelay doubles with every failed attempt starting from the poll interval
+func (s *service) retryDelay(attempts int) time.Duration {
+	delay := s.pollInterval
+	for i := 0; i < attempts && delay < s.maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, s.maxRetryDelay)
+}
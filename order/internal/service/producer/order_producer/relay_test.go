@@ -0,0 +1,141 @@
+package ufo_producer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/dexguitar/spacecraftory/order/internal/model"
+	"github.com/dexguitar/spacecraftory/order/internal/repository/mocks"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
+)
+
+var errKafkaUnavailable = errors.New("kafka unavailable")
+
+type fakeProducer struct {
+	err            error
+	sent           []string
+	correlationIDs []string
+}
+
+func (p *fakeProducer) Send(ctx context.Context, key, _ []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.sent = append(p.sent, string(key))
+	p.correlationIDs = append(p.correlationIDs, tracing.CorrelationIDFromContext(ctx))
+	return nil
+}
+
+func (p *fakeProducer) SendMessage(ctx context.Context, msg kafka.ProducerMessage) error {
+	return p.Send(ctx, msg.Key, msg.Value)
+}
+
+func (p *fakeProducer) SendBatch(ctx context.Context, msgs []kafka.ProducerMessage) error {
+	for _, msg := range msgs {
+		if err := p.SendMessage(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type RelaySuite struct {
+	suite.Suite
+	ctx              context.Context
+	outboxRepository *mocks.OutboxRepository
+	producer         *fakeProducer
+	service          *service
+}
+
+func (s *RelaySuite) SetupTest() {
+	logger.SetNopLogger()
+
+	s.ctx = context.Background()
+	s.outboxRepository = mocks.NewOutboxRepository(s.T())
+	s.producer = &fakeProducer{}
+	s.service = NewService(s.outboxRepository, map[string]kafka.Producer{model.OutboxEventOrderPaid: s.producer}, time.Second, 2, 10*time.Second)
+}
+
+func TestRelay(t *testing.T) {
+	suite.Run(t, new(RelaySuite))
+}
+
+func (s *RelaySuite) TestRelayPendingMarksSent() {
+	first := []*model.OutboxMessage{
+		{ID: "event-1", EventType: model.OutboxEventOrderPaid, Key: "event-1"},
+		{ID: "event-2", EventType: model.OutboxEventOrderPaid, Key: "event-2"},
+	}
+	second := []*model.OutboxMessage{
+		{ID: "event-3", EventType: model.OutboxEventOrderPaid, Key: "event-3"},
+	}
+
+	// a full batch means more messages may be pending
+	s.outboxRepository.On("ClaimPendingMessages", mock.Anything, 2, outboxLease).Return(first, nil).Once()
+	s.outboxRepository.On("ClaimPendingMessages", mock.Anything, 2, outboxLease).Return(second, nil).Once()
+	s.outboxRepository.On("MarkSent", mock.Anything, "event-1").Return(nil).Once()
+	s.outboxRepository.On("MarkSent", mock.Anything, "event-2").Return(nil).Once()
+	s.outboxRepository.On("MarkSent", mock.Anything, "event-3").Return(nil).Once()
+
+	s.service.relayPending(s.ctx)
+
+	assert.Equal(s.T(), []string{"event-1", "event-2", "event-3"}, s.producer.sent)
+}
+
+func (s *RelaySuite) TestRelayPendingRestoresTraceHeaders() {
+	message := &model.OutboxMessage{
+		ID:        "event-1",
+		EventType: model.OutboxEventOrderPaid,
+		Key:       "event-1",
+		Headers:   map[string]string{tracing.CorrelationIDHeader: "correlation-1"},
+	}
+
+	s.outboxRepository.On("ClaimPendingMessages", mock.Anything, 2, outboxLease).
+		Return([]*model.OutboxMessage{message}, nil).Once()
+	s.outboxRepository.On("MarkSent", mock.Anything, "event-1").Return(nil).Once()
+
+	s.service.relayPending(s.ctx)
+
+	assert.Equal(s.T(), []string{"correlation-1"}, s.producer.correlationIDs)
+}
+
+func (s *RelaySuite) TestRelayPendingSendFailure() {
+	s.producer.err = errKafkaUnavailable
+	message := &model.OutboxMessage{ID: "event-1", EventType: model.OutboxEventOrderPaid, Key: "event-1", Attempts: 2}
+
+	s.outboxRepository.On("ClaimPendingMessages", mock.Anything, 2, outboxLease).
+		Return([]*model.OutboxMessage{message}, nil).Once()
+	s.outboxRepository.On("MarkFailed", mock.Anything, "event-1", errKafkaUnavailable.Error(), 4*time.Second).
+		Return(nil).Once()
+
+	s.service.relayPending(s.ctx)
+}
+
+func (s *RelaySuite) TestRelayPendingUnknownEventType() {
+	message := &model.OutboxMessage{ID: "event-1", EventType: "Unknown", Key: "event-1"}
+
+	s.outboxRepository.On("ClaimPendingMessages", mock.Anything, 2, outboxLease).
+		Return([]*model.OutboxMessage{message}, nil).Once()
+	s.outboxRepository.On("MarkFailed", mock.Anything, "event-1", mock.Anything, time.Second).
+		Return(nil).Once()
+
+	s.service.relayPending(s.ctx)
+
+	assert.Empty(s.T(), s.producer.sent)
+}
+
+func (s *RelaySuite) TestRetryDelay() {
+	assert.Equal(s.T(), time.Second, s.service.retryDelay(0))
+	assert.Equal(s.T(), 2*time.Second, s.service.retryDelay(1))
+	assert.Equal(s.T(), 8*time.Second, s.service.retryDelay(3))
+	assert.Equal(s.T(), 10*time.Second, s.service.retryDelay(4))
+	assert.Equal(s.T(), 10*time.Second, s.service.retryDelay(100))
+}
@@ -3,14 +3,21 @@ package service
 import (
 	"context"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/dexguitar/spacecraftory/order/internal/model"
 )
 
 type OrderService interface {
-	CreateOrder(ctx context.Context, userUUID string, partUUIDs []string) (*model.Order, error)
-	GetOrder(ctx context.Context, orderUUID string) (*model.Order, error)
-	PayOrder(ctx context.Context, orderUUID string, paymentMethod model.PaymentMethod) (string, error)
-	CancelOrder(ctx context.Context, orderUUID string) error
+	CreateOrder(ctx context.Context, userUUID string, items []model.OrderItem) (*model.Order, error)
+	GetOrder(ctx context.Context, requester model.Requester, orderUUID string) (*model.Order, error)
+	PayOrder(ctx context.Context, requester model.Requester, orderUUID string, paymentMethod model.PaymentMethod) (string, error)
+	CancelOrder(ctx context.Context, requester model.Requester, orderUUID string) error
+	RefundOrder(ctx context.Context, requester model.Requester, orderUUID string, amount decimal.Decimal, reason string) (*model.Refund, error)
+	ListOrders(ctx context.Context, requester model.Requester, filter model.OrderFilter) (*model.OrderPage, error)
+	GetOrderHistory(ctx context.Context, requester model.Requester, orderUUID string) ([]*model.OrderStatusTransition, error)
+	// SettlePayment applies a charge that settled after the order was paid
+	SettlePayment(ctx context.Context, event model.PaymentSettledEvent) error
 }
 
 type ConsumerService interface {
@@ -18,5 +25,14 @@ type ConsumerService interface {
 }
 
 type ProducerService interface {
-	ProduceOrderPaid(ctx context.Context, event model.OrderPaidEvent) error
+	RunRelay(ctx context.Context) error
+}
+
+// IdempotencyService makes retries of requests with an Idempotency-Key safe
+type IdempotencyService interface {
+	// Begin claims the key for the request. It returns the stored response if the request
+	// was completed already and nil if the request has to be executed.
+	Begin(ctx context.Context, request model.IdempotentRequest) (*model.IdempotentResponse, error)
+	Complete(ctx context.Context, request model.IdempotentRequest, response model.IdempotentResponse) error
+	Abort(ctx context.Context, request model.IdempotentRequest) error
 }
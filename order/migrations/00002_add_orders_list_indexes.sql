@@ -0,0 +1,7 @@
+-- +goose Up
+create index if not exists idx_orders_user_uuid_created_at_id on orders(user_uuid, created_at desc, id desc);
+create index if not exists idx_orders_created_at_id on orders(created_at desc, id desc);
+
+-- +goose Down
+drop index if exists idx_orders_created_at_id;
+drop index if exists idx_orders_user_uuid_created_at_id;
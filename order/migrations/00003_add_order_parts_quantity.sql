@@ -0,0 +1,7 @@
+-- +goose Up
+alter table order_parts add column if not exists quantity integer not null default 1;
+alter table order_parts add constraint order_parts_quantity_positive check (quantity > 0);
+
+-- +goose Down
+alter table order_parts drop constraint if exists order_parts_quantity_positive;
+alter table order_parts drop column if exists quantity;
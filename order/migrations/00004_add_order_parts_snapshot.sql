@@ -0,0 +1,10 @@
+-- +goose Up
+-- snapshot of the part at order creation time, null for orders created earlier
+alter table order_parts add column if not exists unit_price decimal(10, 2);
+alter table order_parts add column if not exists name text;
+alter table order_parts add column if not exists category text;
+
+-- +goose Down
+alter table order_parts drop column if exists category;
+alter table order_parts drop column if exists name;
+alter table order_parts drop column if exists unit_price;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- events written in the same transaction as the order change, published by the outbox relay
+create table if not exists outbox (
+    id uuid primary key,
+    event_type text not null,
+    event_key text not null,
+    payload bytea not null,
+    attempts int not null default 0,
+    last_error text,
+    available_at timestamp not null default now(),
+    created_at timestamp not null default now(),
+    sent_at timestamp
+);
+
+create index if not exists idx_outbox_pending on outbox(available_at) where sent_at is null;
+
+-- +goose Down
+drop index if exists idx_outbox_pending;
+drop table if exists outbox;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- events already handled by a kafka consumer group, used to skip redelivered messages
+create table if not exists processed_events (
+    consumer_group text not null,
+    event_uuid text not null,
+    processed_at timestamp not null default now(),
+    primary key (consumer_group, event_uuid)
+);
+
+-- +goose Down
+drop table if exists processed_events;
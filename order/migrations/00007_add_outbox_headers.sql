@@ -0,0 +1,6 @@
+-- +goose Up
+-- trace context and correlation id of the operation that wrote the event
+alter table outbox add column if not exists headers jsonb not null default '{}'::jsonb;
+
+-- +goose Down
+alter table outbox drop column if exists headers;
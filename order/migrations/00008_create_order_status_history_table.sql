@@ -0,0 +1,17 @@
+-- +goose Up
+-- every status change of an order, written in the same transaction as the change
+create table if not exists order_status_history (
+    id bigserial primary key,
+    order_id uuid not null references orders(id) on delete cascade,
+    from_status text,
+    to_status text not null,
+    actor text not null,
+    reason text not null default '',
+    created_at timestamp not null default now()
+);
+
+create index if not exists idx_order_status_history_order_id on order_status_history(order_id, id);
+
+-- +goose Down
+drop index if exists idx_order_status_history_order_id;
+drop table if exists order_status_history;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- incremented on every update, updates compare it to detect concurrent modifications
+alter table orders add column if not exists version bigint not null default 1;
+
+-- +goose Down
+alter table orders drop column if exists version;
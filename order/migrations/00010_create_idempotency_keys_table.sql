@@ -0,0 +1,17 @@
+-- +goose Up
+-- responses of requests made with an Idempotency-Key, replayed to retries until expires_at.
+-- status_code is null while the first request is in progress
+create table if not exists idempotency_keys (
+    user_uuid text not null,
+    key text not null,
+    request_hash text not null,
+    status_code integer,
+    content_type text,
+    response_body bytea,
+    created_at timestamp not null default now(),
+    expires_at timestamp not null,
+    primary key (user_uuid, key)
+);
+
+-- +goose Down
+drop table if exists idempotency_keys;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- claim_uuid identifies the request holding the key, claimed_at starts its lease.
+-- A key whose request did not finish within the lock timeout is taken over by a retry
+alter table idempotency_keys
+    add column if not exists claim_uuid text,
+    add column if not exists claimed_at timestamp not null default now();
+
+-- +goose Down
+alter table idempotency_keys
+    drop column if exists claimed_at,
+    drop column if exists claim_uuid;
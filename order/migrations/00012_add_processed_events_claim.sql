@@ -0,0 +1,11 @@
+-- +goose Up
+-- claimed_until is set while a consumer processes the event and cleared once it is processed,
+-- a claim that expired belongs to a crashed consumer and is taken over by the redelivery
+alter table processed_events add column if not exists claimed_until timestamp;
+
+-- processed events are deleted once they are older than the retention period
+create index if not exists processed_events_processed_at_idx on processed_events (processed_at);
+
+-- +goose Down
+drop index if exists processed_events_processed_at_idx;
+alter table processed_events drop column if exists claimed_until;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- sent messages are deleted once they are older than the retention period
+create index if not exists outbox_sent_at_idx on outbox (sent_at) where sent_at is not null;
+
+-- +goose Down
+drop index if exists outbox_sent_at_idx;
@@ -52,6 +52,8 @@ func main() {
 		OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
 		UserUuid:      "550e8400-e29b-41d4-a716-446655440000",
 		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+		Amount:        "295000.00",
+		Currency:      "RUB",
 	})
 	if err != nil {
 		log.Printf("Error paying an order: %v\n", err)
@@ -66,6 +68,8 @@ func main() {
 		OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
 		UserUuid:      "550e8400-e29b-41d4-a716-446655440000",
 		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_SBP,
+		Amount:        "75000.00",
+		Currency:      "RUB",
 	})
 	if err != nil {
 		log.Printf("Error paying an order: %v\n", err)
@@ -80,6 +84,8 @@ func main() {
 		OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
 		UserUuid:      "550e8400-e29b-41d4-a716-446655440000",
 		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD,
+		Amount:        "45000.50",
+		Currency:      "RUB",
 	})
 	if err != nil {
 		log.Printf("Error paying an order: %v\n", err)
@@ -94,6 +100,8 @@ func main() {
 		OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
 		UserUuid:      "550e8400-e29b-41d4-a716-446655440000",
 		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY,
+		Amount:        "1250000.00",
+		Currency:      "RUB",
 	})
 	if err != nil {
 		log.Printf("Error paying an order: %v\n", err)
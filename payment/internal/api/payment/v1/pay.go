@@ -22,6 +22,21 @@ func (a *api) PayOrder(ctx context.Context, req *paymentV1.PayOrderRequest) (*pa
 		if errors.Is(err, model.ErrBadRequest) {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid request details")
 		}
+		if errors.Is(err, model.ErrAmountLimitExceeded) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Amount exceeds the payment method limit")
+		}
+		if errors.Is(err, model.ErrPaymentDeclined) {
+			return nil, status.Errorf(codes.PermissionDenied, "Payment was declined by the provider")
+		}
+		if errors.Is(err, model.ErrProviderTimeout) {
+			return nil, status.Errorf(codes.DeadlineExceeded, "Payment provider did not respond in time")
+		}
+		if errors.Is(err, model.ErrPaymentPending) {
+			return nil, status.Errorf(codes.Unavailable, "Payment is awaiting confirmation, retry the request")
+		}
+		if errors.Is(err, model.ErrPaymentAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "Order was already paid with different details")
+		}
 		return nil, status.Errorf(codes.Internal, "Internal server error")
 	}
 
@@ -3,6 +3,7 @@ package v1
 import (
 	"errors"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,6 +24,8 @@ func (s *APISuite) TestPayOrderSuccess() {
 				OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
 				UserUuid:      "123e4567-e89b-12d3-a456-426614174012",
 				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+				Amount:        "150000.00",
+				Currency:      "RUB",
 			},
 			serviceTxnUUID: "txn-card-123",
 		},
@@ -32,6 +35,8 @@ func (s *APISuite) TestPayOrderSuccess() {
 				OrderUuid:     "123e4567-e89b-12d3-a456-426614174001",
 				UserUuid:      "123e4567-e89b-12d3-a456-426614174013",
 				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_SBP,
+				Amount:        "150000.00",
+				Currency:      "RUB",
 			},
 			serviceTxnUUID: "txn-sbp-456",
 		},
@@ -41,6 +46,8 @@ func (s *APISuite) TestPayOrderSuccess() {
 				OrderUuid:     "123e4567-e89b-12d3-a456-426614174002",
 				UserUuid:      "123e4567-e89b-12d3-a456-426614174014",
 				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD,
+				Amount:        "150000.00",
+				Currency:      "RUB",
 			},
 			serviceTxnUUID: "txn-credit-789",
 		},
@@ -50,6 +57,8 @@ func (s *APISuite) TestPayOrderSuccess() {
 				OrderUuid:     "123e4567-e89b-12d3-a456-426614174003",
 				UserUuid:      "123e4567-e89b-12d3-a456-426614174015",
 				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY,
+				Amount:        "150000.00",
+				Currency:      "RUB",
 			},
 			serviceTxnUUID: "txn-investor-abc",
 		},
@@ -59,6 +68,8 @@ func (s *APISuite) TestPayOrderSuccess() {
 				OrderUuid:     "123e4567-e89b-12d3-a456-426614174004",
 				UserUuid:      "123e4567-e89b-12d3-a456-426614174016",
 				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_UNKNOWN_UNSPECIFIED,
+				Amount:        "150000.00",
+				Currency:      "RUB",
 			},
 			serviceTxnUUID: "txn-unknown-xyz",
 		},
@@ -69,6 +80,8 @@ func (s *APISuite) TestPayOrderSuccess() {
 			expectedPayment := &model.Payment{
 				OrderUUID:     tc.request.OrderUuid,
 				UserUUID:      tc.request.UserUuid,
+				Amount:        decimal.RequireFromString(tc.request.Amount),
+				Currency:      tc.request.Currency,
 				PaymentMethod: model.PaymentMethodMap[tc.request.PaymentMethod],
 			}
 
@@ -97,6 +110,8 @@ func (s *APISuite) TestPayOrderError() {
 				OrderUuid:     "invalid-uuid",
 				UserUuid:      "123e4567-e89b-12d3-a456-426614174012",
 				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+				Amount:        "150000.00",
+				Currency:      "RUB",
 			},
 			expectedCode:     codes.InvalidArgument,
 			expectedMsgParts: []string{"Invalid request details"},
@@ -107,6 +122,20 @@ func (s *APISuite) TestPayOrderError() {
 				OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
 				UserUuid:      "invalid-uuid",
 				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+				Amount:        "150000.00",
+				Currency:      "RUB",
+			},
+			expectedCode:     codes.InvalidArgument,
+			expectedMsgParts: []string{"Invalid request details"},
+		},
+		{
+			name: "Invalid amount",
+			request: &paymentV1.PayOrderRequest{
+				OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
+				UserUuid:      "123e4567-e89b-12d3-a456-426614174012",
+				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+				Amount:        "not-a-number",
+				Currency:      "RUB",
 			},
 			expectedCode:     codes.InvalidArgument,
 			expectedMsgParts: []string{"Invalid request details"},
@@ -117,17 +146,90 @@ func (s *APISuite) TestPayOrderError() {
 				OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
 				UserUuid:      "123e4567-e89b-12d3-a456-426614174012",
 				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+				Amount:        "150000.00",
+				Currency:      "RUB",
 			},
 			serviceError:     model.ErrBadRequest,
 			expectedCode:     codes.InvalidArgument,
 			expectedMsgParts: []string{"Invalid request details"},
 		},
+		{
+			name: "Service returns ErrAmountLimitExceeded",
+			request: &paymentV1.PayOrderRequest{
+				OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
+				UserUuid:      "123e4567-e89b-12d3-a456-426614174012",
+				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD,
+				Amount:        "750000.00",
+				Currency:      "RUB",
+			},
+			serviceError:     model.ErrAmountLimitExceeded,
+			expectedCode:     codes.FailedPrecondition,
+			expectedMsgParts: []string{"Amount exceeds the payment method limit"},
+		},
+		{
+			name: "Payment declined by the provider",
+			request: &paymentV1.PayOrderRequest{
+				OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
+				UserUuid:      "123e4567-e89b-12d3-a456-426614174012",
+				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+				Amount:        "150000.00",
+				Currency:      "RUB",
+				AttemptKey:    "3",
+			},
+			serviceError:     model.ErrPaymentDeclined,
+			expectedCode:     codes.PermissionDenied,
+			expectedMsgParts: []string{"Payment was declined by the provider"},
+		},
+		{
+			name: "Payment provider timed out",
+			request: &paymentV1.PayOrderRequest{
+				OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
+				UserUuid:      "123e4567-e89b-12d3-a456-426614174012",
+				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+				Amount:        "150000.00",
+				Currency:      "RUB",
+				AttemptKey:    "3",
+			},
+			serviceError:     model.ErrProviderTimeout,
+			expectedCode:     codes.DeadlineExceeded,
+			expectedMsgParts: []string{"Payment provider did not respond in time"},
+		},
+		{
+			name: "Payment awaiting confirmation",
+			request: &paymentV1.PayOrderRequest{
+				OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
+				UserUuid:      "123e4567-e89b-12d3-a456-426614174012",
+				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+				Amount:        "150000.00",
+				Currency:      "RUB",
+				AttemptKey:    "3",
+			},
+			serviceError:     model.ErrPaymentPending,
+			expectedCode:     codes.Unavailable,
+			expectedMsgParts: []string{"Payment is awaiting confirmation"},
+		},
+		{
+			name: "Attempt already paid with different details",
+			request: &paymentV1.PayOrderRequest{
+				OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
+				UserUuid:      "123e4567-e89b-12d3-a456-426614174012",
+				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_SBP,
+				Amount:        "150000.00",
+				Currency:      "RUB",
+				AttemptKey:    "3",
+			},
+			serviceError:     model.ErrPaymentAlreadyExists,
+			expectedCode:     codes.AlreadyExists,
+			expectedMsgParts: []string{"Order was already paid with different details"},
+		},
 		{
 			name: "Service internal error",
 			request: &paymentV1.PayOrderRequest{
 				OrderUuid:     "123e4567-e89b-12d3-a456-426614174000",
 				UserUuid:      "123e4567-e89b-12d3-a456-426614174012",
 				PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+				Amount:        "150000.00",
+				Currency:      "RUB",
 			},
 			serviceError:     errors.New("database connection failed"),
 			expectedCode:     codes.Internal,
@@ -140,7 +242,10 @@ func (s *APISuite) TestPayOrderError() {
 			if tc.serviceError != nil {
 				expectedPayment := &model.Payment{
 					OrderUUID:     tc.request.OrderUuid,
+					AttemptKey:    tc.request.AttemptKey,
 					UserUUID:      tc.request.UserUuid,
+					Amount:        decimal.RequireFromString(tc.request.Amount),
+					Currency:      tc.request.Currency,
 					PaymentMethod: model.PaymentMethodMap[tc.request.PaymentMethod],
 				}
 
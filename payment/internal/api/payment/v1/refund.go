@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/converter"
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	paymentV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) RefundPayment(ctx context.Context, req *paymentV1.RefundPaymentRequest) (*paymentV1.RefundPaymentResponse, error) {
+	refund, err := converter.ToModelRefund(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request details")
+	}
+
+	payment, err := a.paymentService.RefundPayment(ctx, refund)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrBadRequest):
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request details")
+		case errors.Is(err, model.ErrPaymentNotFound):
+			return nil, status.Errorf(codes.NotFound, "Payment not found")
+		case errors.Is(err, model.ErrPaymentNotRefundable), errors.Is(err, model.ErrRefundExceedsPayment):
+			return nil, status.Errorf(codes.FailedPrecondition, "Refund exceeds the amount left on the payment")
+		case errors.Is(err, model.ErrRefundKeyMismatch):
+			return nil, status.Errorf(codes.AlreadyExists, "Refund key was already used for another amount")
+		case errors.Is(err, model.ErrPaymentConflict):
+			return nil, status.Errorf(codes.Aborted, "Payment was modified concurrently, retry the request")
+		case errors.Is(err, model.ErrProviderTimeout):
+			return nil, status.Errorf(codes.DeadlineExceeded, "Payment provider did not respond in time")
+		}
+		return nil, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return converter.ToProtoRefundResponse(refund, payment), nil
+}
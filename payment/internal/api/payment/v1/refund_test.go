@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	paymentV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1"
+)
+
+const refundTransactionUUID = "123e4567-e89b-12d3-a456-426614174100"
+
+func (s *APISuite) TestRefundPaymentSuccess() {
+	testCases := []struct {
+		name           string
+		amount         string
+		expectedAmount decimal.Decimal
+		paymentStatus  model.PaymentStatus
+		expectedStatus paymentV1.PaymentStatus
+	}{
+		{
+			name:           "Partial refund",
+			amount:         "250.50",
+			expectedAmount: decimal.RequireFromString("250.50"),
+			paymentStatus:  model.PaymentStatusPARTIALLY_REFUNDED,
+			expectedStatus: paymentV1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED,
+		},
+		{
+			name:           "Empty amount refunds the rest",
+			amount:         "",
+			expectedAmount: decimal.Zero,
+			paymentStatus:  model.PaymentStatusREFUNDED,
+			expectedStatus: paymentV1.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.paymentService.On("RefundPayment", s.ctx, mock.MatchedBy(func(refund *model.Refund) bool {
+				return refund.TransactionUUID == refundTransactionUUID &&
+					refund.RefundKey == "3" &&
+					refund.Amount.Equal(tc.expectedAmount) &&
+					refund.Reason == "customer request"
+			})).
+				Run(func(args mock.Arguments) {
+					refund := args.Get(1).(*model.Refund)
+					refund.RefundUUID = "refund-123"
+					refund.Amount = decimal.RequireFromString("250.50")
+					refund.Currency = model.CurrencyRUB
+				}).
+				Return(&model.Payment{
+					TransactionUUID: refundTransactionUUID,
+					RefundedAmount:  decimal.RequireFromString("1000"),
+					Status:          tc.paymentStatus,
+				}, nil).Once()
+
+			resp, err := s.api.RefundPayment(s.ctx, &paymentV1.RefundPaymentRequest{
+				TransactionUuid: refundTransactionUUID,
+				Amount:          tc.amount,
+				Reason:          "customer request",
+				RefundKey:       "3",
+			})
+
+			s.Require().NoError(err)
+			assert.Equal(s.T(), "refund-123", resp.RefundUuid)
+			assert.Equal(s.T(), "250.50", resp.Amount)
+			assert.Equal(s.T(), model.CurrencyRUB, resp.Currency)
+			assert.Equal(s.T(), "1000.00", resp.RefundedTotal)
+			assert.Equal(s.T(), tc.expectedStatus, resp.PaymentStatus)
+		})
+	}
+}
+
+func (s *APISuite) TestRefundPaymentError() {
+	testCases := []struct {
+		name            string
+		request         *paymentV1.RefundPaymentRequest
+		serviceError    error
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "Invalid transaction UUID",
+			request: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: "invalid-uuid",
+				Amount:          "100.00",
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "Invalid request details",
+		},
+		{
+			name: "Invalid amount",
+			request: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: refundTransactionUUID,
+				Amount:          "not-a-number",
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "Invalid request details",
+		},
+		{
+			name: "Payment not found",
+			request: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: refundTransactionUUID,
+				Amount:          "100.00",
+			},
+			serviceError:    model.ErrPaymentNotFound,
+			expectedCode:    codes.NotFound,
+			expectedMessage: "Payment not found",
+		},
+		{
+			name: "Refund exceeds payment",
+			request: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: refundTransactionUUID,
+				Amount:          "100000.00",
+			},
+			serviceError:    model.ErrRefundExceedsPayment,
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: "Refund exceeds the amount left on the payment",
+		},
+		{
+			name: "Payment already refunded",
+			request: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: refundTransactionUUID,
+			},
+			serviceError:    model.ErrPaymentNotRefundable,
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: "Refund exceeds the amount left on the payment",
+		},
+		{
+			name: "Refund key used for another amount",
+			request: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: refundTransactionUUID,
+				Amount:          "100.00",
+				RefundKey:       "3",
+			},
+			serviceError:    model.ErrRefundKeyMismatch,
+			expectedCode:    codes.AlreadyExists,
+			expectedMessage: "Refund key was already used for another amount",
+		},
+		{
+			name: "Payment modified concurrently",
+			request: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: refundTransactionUUID,
+				Amount:          "100.00",
+			},
+			serviceError:    model.ErrPaymentConflict,
+			expectedCode:    codes.Aborted,
+			expectedMessage: "Payment was modified concurrently, retry the request",
+		},
+		{
+			name: "Provider timeout",
+			request: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: refundTransactionUUID,
+				Amount:          "100.00",
+			},
+			serviceError:    model.ErrProviderTimeout,
+			expectedCode:    codes.DeadlineExceeded,
+			expectedMessage: "Payment provider did not respond in time",
+		},
+		{
+			name: "Service internal error",
+			request: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: refundTransactionUUID,
+				Amount:          "100.00",
+			},
+			serviceError:    errors.New("database connection failed"),
+			expectedCode:    codes.Internal,
+			expectedMessage: "Internal server error",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.serviceError != nil {
+				s.paymentService.On("RefundPayment", s.ctx, mock.Anything).
+					Return(nil, tc.serviceError).Once()
+			}
+
+			resp, err := s.api.RefundPayment(s.ctx, tc.request)
+
+			s.Require().Error(err)
+			s.Require().Nil(resp)
+
+			st, ok := status.FromError(err)
+			s.Require().True(ok)
+			assert.Equal(s.T(), tc.expectedCode, st.Code())
+			assert.Equal(s.T(), tc.expectedMessage, st.Message())
+		})
+	}
+}
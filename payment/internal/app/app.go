@@ -5,7 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -15,6 +19,8 @@ import (
 	"github.com/dexguitar/spacecraftory/platform/pkg/closer"
 	"github.com/dexguitar/spacecraftory/platform/pkg/grpc/health"
 	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+	"github.com/dexguitar/spacecraftory/platform/pkg/migrator"
+	pgMigrator "github.com/dexguitar/spacecraftory/platform/pkg/migrator/pg"
 	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
 	paymentV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1"
 )
@@ -22,6 +28,7 @@ import (
 type App struct {
 	diContainer *diContainer
 	grpcServer  *grpc.Server
+	migrator    migrator.Migrator
 	listener    net.Listener
 }
 
@@ -37,6 +44,8 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
+	go a.runPaymentSettler(ctx)
+
 	return a.runGRPCServer(ctx)
 }
 
@@ -48,6 +57,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initCloser,
 		a.initListener,
 		a.initGRPCServer,
+		a.initMigrator,
 	}
 
 	for _, f := range inits {
@@ -146,6 +156,70 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) initMigrator(ctx context.Context) error {
+	dbURI := config.AppConfig().Postgres.Address()
+
+	conn, err := pgx.Connect(ctx, dbURI)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	err = conn.Ping(ctx)
+	if err != nil {
+		if closeErr := conn.Close(ctx); closeErr != nil {
+			logger.Error(ctx, "❌ failed to close database connection", zap.Error(closeErr))
+		}
+		return fmt.Errorf("database is unavailable: %w", err)
+	}
+
+	migrationsDir := config.AppConfig().Postgres.MigrationDirectory()
+	sqlDB := stdlib.OpenDB(*conn.Config().Copy())
+	a.migrator = pgMigrator.NewMigrator(sqlDB, migrationsDir)
+
+	logger.Info(ctx, "🔄 Running database migrations...")
+	err = a.migrator.Up(ctx)
+	if err != nil {
+		if closeErr := conn.Close(ctx); closeErr != nil {
+			logger.Error(ctx, "❌ failed to close database connection", zap.Error(closeErr))
+		}
+		if closeErr := sqlDB.Close(); closeErr != nil {
+			logger.Error(ctx, "❌ failed to close database connection", zap.Error(closeErr))
+		}
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+	logger.Info(ctx, "✅ Database migrations completed")
+
+	if closeErr := sqlDB.Close(); closeErr != nil {
+		logger.Error(ctx, "❌ failed to close database connection", zap.Error(closeErr))
+	}
+	if closeErr := conn.Close(ctx); closeErr != nil {
+		logger.Error(ctx, "❌ failed to close database connection", zap.Error(closeErr))
+	}
+
+	return nil
+}
+
+// runPaymentSettler periodically settles pending payments confirmed or declined by their providers
+func (a *App) runPaymentSettler(ctx context.Context) {
+	ticker := time.NewTicker(config.AppConfig().PaymentProvider.SettleInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			settled, err := a.diContainer.PaymentService(ctx).SettlePendingPayments(ctx)
+			if err != nil {
+				logger.Error(ctx, "failed to settle pending payments", zap.Error(err))
+			}
+			if settled > 0 {
+				logger.Info(ctx, "settled pending payments", zap.Int("count", settled))
+			}
+		}
+	}
+}
+
 func (a *App) runGRPCServer(ctx context.Context) error {
 	logger.Info(ctx, fmt.Sprintf("🚀 gRPC PaymentService server listening on %s", config.AppConfig().PaymentGRPC.Address()))
 
@@ -2,12 +2,31 @@ package app
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
 
 	paymentV1API "github.com/dexguitar/spacecraftory/payment/internal/api/payment/v1"
+	"github.com/dexguitar/spacecraftory/payment/internal/config"
+	kafkaConverter "github.com/dexguitar/spacecraftory/payment/internal/converter/kafka"
+	"github.com/dexguitar/spacecraftory/payment/internal/converter/kafka/encoder"
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	"github.com/dexguitar/spacecraftory/payment/internal/provider"
+	cardProvider "github.com/dexguitar/spacecraftory/payment/internal/provider/card"
+	creditCardProvider "github.com/dexguitar/spacecraftory/payment/internal/provider/credit_card"
+	investorMoneyProvider "github.com/dexguitar/spacecraftory/payment/internal/provider/investor_money"
+	sbpProvider "github.com/dexguitar/spacecraftory/payment/internal/provider/sbp"
+	simulatorProvider "github.com/dexguitar/spacecraftory/payment/internal/provider/simulator"
 	"github.com/dexguitar/spacecraftory/payment/internal/repository"
 	paymentRepository "github.com/dexguitar/spacecraftory/payment/internal/repository/payment"
 	"github.com/dexguitar/spacecraftory/payment/internal/service"
 	paymentService "github.com/dexguitar/spacecraftory/payment/internal/service/payment"
+	"github.com/dexguitar/spacecraftory/platform/pkg/closer"
+	wrappedKafka "github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	wrappedKafkaProducer "github.com/dexguitar/spacecraftory/platform/pkg/kafka/producer"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
 	paymentV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1"
 )
 
@@ -16,7 +35,15 @@ type diContainer struct {
 
 	paymentService service.PaymentService
 
+	paymentProviders map[model.PaymentMethod]provider.PaymentProvider
+
 	paymentRepository repository.PaymentRepository
+
+	pgPool *pgxpool.Pool
+
+	syncProducer           sarama.SyncProducer
+	paymentSettledProducer wrappedKafka.Producer
+	paymentSettledEncoder  kafkaConverter.PaymentSettledEncoder
 }
 
 func NewDiContainer() *diContainer {
@@ -33,16 +60,128 @@ func (d *diContainer) PaymentV1API(ctx context.Context) paymentV1.PaymentService
 
 func (d *diContainer) PaymentService(ctx context.Context) service.PaymentService {
 	if d.paymentService == nil {
-		d.paymentService = paymentService.NewService(d.PaymentRepository(ctx))
+		d.paymentService = paymentService.NewService(
+			d.PaymentRepository(ctx),
+			d.PaymentProviders(),
+			config.AppConfig().PaymentProvider.Timeout(),
+			paymentLimits(),
+			d.PaymentSettledProducer(),
+			d.PaymentSettledEncoder(),
+		)
 	}
 
 	return d.paymentService
 }
 
-func (d *diContainer) PaymentRepository(_ context.Context) repository.PaymentRepository {
+// PaymentProviders returns the provider of every payment method, methods listed
+// in the provider config are charged through the gateway simulator instead.
+func (d *diContainer) PaymentProviders() map[model.PaymentMethod]provider.PaymentProvider {
+	if d.paymentProviders == nil {
+		providers := map[model.PaymentMethod]provider.PaymentProvider{
+			model.PaymentMethodCARD:           cardProvider.NewProvider(),
+			model.PaymentMethodSBP:            sbpProvider.NewProvider(),
+			model.PaymentMethodCREDIT_CARD:    creditCardProvider.NewProvider(),
+			model.PaymentMethodINVESTOR_MONEY: investorMoneyProvider.NewProvider(),
+		}
+
+		if simulatedMethods := config.AppConfig().PaymentProvider.SimulatedMethods(); len(simulatedMethods) > 0 {
+			simulatorCfg := config.AppConfig().PaymentSimulator
+			simulator, err := simulatorProvider.NewProvider(simulatorCfg.Script(), simulatorCfg.Outcome(), simulatorCfg.ConfirmAfter())
+			if err != nil {
+				panic(fmt.Sprintf("failed to create payment simulator: %s", err.Error()))
+			}
+
+			for _, method := range simulatedMethods {
+				if _, ok := providers[model.PaymentMethod(method)]; !ok {
+					panic(fmt.Sprintf("unknown simulated payment method: %s", method))
+				}
+				providers[model.PaymentMethod(method)] = simulator
+			}
+		}
+
+		d.paymentProviders = providers
+	}
+
+	return d.paymentProviders
+}
+
+func (d *diContainer) PaymentRepository(ctx context.Context) repository.PaymentRepository {
 	if d.paymentRepository == nil {
-		d.paymentRepository = paymentRepository.NewPaymentRepository()
+		d.paymentRepository = paymentRepository.NewPaymentRepository(d.PgPool(ctx))
 	}
 
 	return d.paymentRepository
 }
+
+func (d *diContainer) PgPool(ctx context.Context) *pgxpool.Pool {
+	if d.pgPool == nil {
+		dbURI := config.AppConfig().Postgres.Address()
+
+		pool, err := pgxpool.New(ctx, dbURI)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create connection pool: %s", err.Error()))
+		}
+
+		closer.AddNamed("PostgreSQL connection pool", func(ctx context.Context) error {
+			pool.Close()
+			return nil
+		})
+
+		d.pgPool = pool
+	}
+
+	return d.pgPool
+}
+
+func (d *diContainer) SyncProducer() sarama.SyncProducer {
+	if d.syncProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().PaymentSettled.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create sync producer: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka sync producer", func(ctx context.Context) error {
+			return p.Close()
+		})
+
+		d.syncProducer = p
+	}
+
+	return d.syncProducer
+}
+
+func (d *diContainer) PaymentSettledProducer() wrappedKafka.Producer {
+	if d.paymentSettledProducer == nil {
+		d.paymentSettledProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().PaymentSettled.Topic(),
+			logger.Logger(),
+			wrappedKafkaProducer.WithEventType(model.EventTypePaymentSettled),
+			wrappedKafkaProducer.WithSchemaVersion(model.PaymentSettledSchemaVersion),
+			wrappedKafkaProducer.WithProducerService(config.AppConfig().Tracing.ServiceName()),
+		)
+	}
+
+	return d.paymentSettledProducer
+}
+
+func (d *diContainer) PaymentSettledEncoder() kafkaConverter.PaymentSettledEncoder {
+	if d.paymentSettledEncoder == nil {
+		d.paymentSettledEncoder = encoder.NewPaymentSettledEncoder()
+	}
+
+	return d.paymentSettledEncoder
+}
+
+func paymentLimits() map[model.PaymentMethod]decimal.Decimal {
+	cfg := config.AppConfig().PaymentLimits
+
+	return map[model.PaymentMethod]decimal.Decimal{
+		model.PaymentMethodCARD:           cfg.Card(),
+		model.PaymentMethodSBP:            cfg.SBP(),
+		model.PaymentMethodCREDIT_CARD:    cfg.CreditCard(),
+		model.PaymentMethodINVESTOR_MONEY: cfg.InvestorMoney(),
+	}
+}
@@ -11,9 +11,15 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger      LoggerConfig
-	Tracing     TracingConfig
-	PaymentGRPC PaymentGRPCConfig
+	Logger           LoggerConfig
+	Tracing          TracingConfig
+	PaymentGRPC      PaymentGRPCConfig
+	PaymentLimits    PaymentLimitsConfig
+	PaymentProvider  PaymentProviderConfig
+	PaymentSimulator PaymentSimulatorConfig
+	Postgres         PostgresConfig
+	Kafka            KafkaConfig
+	PaymentSettled   PaymentSettledProducerConfig
 }
 
 func Load(path ...string) error {
@@ -37,10 +43,46 @@ func Load(path ...string) error {
 		return err
 	}
 
+	paymentLimitsCfg, err := env.NewPaymentLimitsConfig()
+	if err != nil {
+		return err
+	}
+
+	paymentProviderCfg, err := env.NewPaymentProviderConfig()
+	if err != nil {
+		return err
+	}
+
+	paymentSimulatorCfg, err := env.NewPaymentSimulatorConfig()
+	if err != nil {
+		return err
+	}
+
+	postgresCfg, err := env.NewPaymentPostgresConfig()
+	if err != nil {
+		return err
+	}
+
+	kafkaCfg, err := env.NewPaymentKafkaConfig()
+	if err != nil {
+		return err
+	}
+
+	paymentSettledCfg, err := env.NewPaymentSettledProducerConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Logger:      loggerCfg,
-		Tracing:     tracingCfg,
-		PaymentGRPC: paymentGRPCCfg,
+		Logger:           loggerCfg,
+		Tracing:          tracingCfg,
+		PaymentGRPC:      paymentGRPCCfg,
+		PaymentLimits:    paymentLimitsCfg,
+		PaymentProvider:  paymentProviderCfg,
+		PaymentSimulator: paymentSimulatorCfg,
+		Postgres:         postgresCfg,
+		Kafka:            kafkaCfg,
+		PaymentSettled:   paymentSettledCfg,
 	}
 
 	return nil
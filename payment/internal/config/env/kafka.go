@@ -0,0 +1,26 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentKafkaEnvConfig struct {
+	Brokers []string `env:"PAYMENT_KAFKA_BROKERS,required"`
+}
+
+type paymentKafkaConfig struct {
+	raw paymentKafkaEnvConfig
+}
+
+func NewPaymentKafkaConfig() (*paymentKafkaConfig, error) {
+	var raw paymentKafkaEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentKafkaConfig{raw: raw}, nil
+}
+
+func (cfg *paymentKafkaConfig) Brokers() []string {
+	return cfg.raw.Brokers
+}
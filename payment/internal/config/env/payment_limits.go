@@ -0,0 +1,42 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+	"github.com/shopspring/decimal"
+)
+
+type paymentLimitsEnvConfig struct {
+	Card          decimal.Decimal `env:"PAYMENT_LIMIT_CARD" envDefault:"1000000"`
+	SBP           decimal.Decimal `env:"PAYMENT_LIMIT_SBP" envDefault:"1000000"`
+	CreditCard    decimal.Decimal `env:"PAYMENT_LIMIT_CREDIT_CARD" envDefault:"500000"`
+	InvestorMoney decimal.Decimal `env:"PAYMENT_LIMIT_INVESTOR_MONEY" envDefault:"100000000"`
+}
+
+type paymentLimitsConfig struct {
+	raw paymentLimitsEnvConfig
+}
+
+func NewPaymentLimitsConfig() (*paymentLimitsConfig, error) {
+	var raw paymentLimitsEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentLimitsConfig{raw: raw}, nil
+}
+
+func (cfg *paymentLimitsConfig) Card() decimal.Decimal {
+	return cfg.raw.Card
+}
+
+func (cfg *paymentLimitsConfig) SBP() decimal.Decimal {
+	return cfg.raw.SBP
+}
+
+func (cfg *paymentLimitsConfig) CreditCard() decimal.Decimal {
+	return cfg.raw.CreditCard
+}
+
+func (cfg *paymentLimitsConfig) InvestorMoney() decimal.Decimal {
+	return cfg.raw.InvestorMoney
+}
@@ -0,0 +1,39 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentProviderEnvConfig struct {
+	Timeout          time.Duration `env:"PAYMENT_PROVIDER_TIMEOUT" envDefault:"10s"`
+	SimulatedMethods []string      `env:"PAYMENT_PROVIDER_SIMULATED_METHODS"`
+	SettleInterval   time.Duration `env:"PAYMENT_PROVIDER_SETTLE_INTERVAL" envDefault:"30s"`
+}
+
+type paymentProviderConfig struct {
+	raw paymentProviderEnvConfig
+}
+
+func NewPaymentProviderConfig() (*paymentProviderConfig, error) {
+	var raw paymentProviderEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentProviderConfig{raw: raw}, nil
+}
+
+func (cfg *paymentProviderConfig) Timeout() time.Duration {
+	return cfg.raw.Timeout
+}
+
+func (cfg *paymentProviderConfig) SimulatedMethods() []string {
+	return cfg.raw.SimulatedMethods
+}
+
+// SettleInterval is how often pending payments are checked with their providers
+func (cfg *paymentProviderConfig) SettleInterval() time.Duration {
+	return cfg.raw.SettleInterval
+}
@@ -0,0 +1,38 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentSettledProducerEnvConfig struct {
+	TopicName string `env:"PAYMENT_SETTLED_TOPIC_NAME,required"`
+}
+
+type paymentSettledProducerConfig struct {
+	raw paymentSettledProducerEnvConfig
+}
+
+func NewPaymentSettledProducerConfig() (*paymentSettledProducerConfig, error) {
+	var raw paymentSettledProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentSettledProducerConfig{raw: raw}, nil
+}
+
+func (cfg *paymentSettledProducerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+// Config returns the sarama config of the producer, the settler waits for the broker
+// to acknowledge the event before the payment is recorded as settled
+func (cfg *paymentSettledProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+
+	return config
+}
@@ -0,0 +1,38 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentSimulatorEnvConfig struct {
+	Script       []string      `env:"PAYMENT_SIMULATOR_SCRIPT"`
+	Outcome      string        `env:"PAYMENT_SIMULATOR_OUTCOME" envDefault:"approve"`
+	ConfirmAfter time.Duration `env:"PAYMENT_SIMULATOR_CONFIRM_AFTER" envDefault:"2s"`
+}
+
+type paymentSimulatorConfig struct {
+	raw paymentSimulatorEnvConfig
+}
+
+func NewPaymentSimulatorConfig() (*paymentSimulatorConfig, error) {
+	var raw paymentSimulatorEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentSimulatorConfig{raw: raw}, nil
+}
+
+func (cfg *paymentSimulatorConfig) Script() []string {
+	return cfg.raw.Script
+}
+
+func (cfg *paymentSimulatorConfig) Outcome() string {
+	return cfg.raw.Outcome
+}
+
+func (cfg *paymentSimulatorConfig) ConfirmAfter() time.Duration {
+	return cfg.raw.ConfirmAfter
+}
@@ -0,0 +1,38 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentPostgresEnvConfig struct {
+	Host               string `env:"PAYMENT_POSTGRES_HOST,required"`
+	Port               string `env:"PAYMENT_EXTERNAL_POSTGRES_PORT,required"`
+	User               string `env:"PAYMENT_POSTGRES_USER,required"`
+	Password           string `env:"PAYMENT_POSTGRES_PASSWORD,required"`
+	Database           string `env:"PAYMENT_POSTGRES_DB,required"`
+	SSLMode            string `env:"PAYMENT_POSTGRES_SSL_MODE,required"`
+	MigrationDirectory string `env:"PAYMENT_POSTGRES_MIGRATION_DIRECTORY,required"`
+}
+
+type paymentPostgresConfig struct {
+	raw paymentPostgresEnvConfig
+}
+
+func NewPaymentPostgresConfig() (*paymentPostgresConfig, error) {
+	var raw paymentPostgresEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentPostgresConfig{raw: raw}, nil
+}
+
+func (cfg *paymentPostgresConfig) Address() string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s", cfg.raw.Host, cfg.raw.Port, cfg.raw.User, cfg.raw.Password, cfg.raw.Database, cfg.raw.SSLMode)
+}
+
+func (cfg *paymentPostgresConfig) MigrationDirectory() string {
+	return cfg.raw.MigrationDirectory
+}
@@ -1,5 +1,12 @@
 package config
 
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/shopspring/decimal"
+)
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
@@ -11,6 +18,46 @@ type PaymentGRPCConfig interface {
 	Address() string
 }
 
+// PaymentLimitsConfig holds the maximum amount a single payment may charge per method, in RUB.
+type PaymentLimitsConfig interface {
+	Card() decimal.Decimal
+	SBP() decimal.Decimal
+	CreditCard() decimal.Decimal
+	InvestorMoney() decimal.Decimal
+}
+
+// PaymentProviderConfig holds how long a payment provider may take to answer,
+// which payment methods are charged through the gateway simulator and how often
+// pending payments are settled.
+type PaymentProviderConfig interface {
+	Timeout() time.Duration
+	SimulatedMethods() []string
+	SettleInterval() time.Duration
+}
+
+// PaymentSimulatorConfig scripts the gateway simulator: charges get the outcomes
+// of Script in order and Outcome afterwards, pending charges are resolved after ConfirmAfter.
+type PaymentSimulatorConfig interface {
+	Script() []string
+	Outcome() string
+	ConfirmAfter() time.Duration
+}
+
+type KafkaConfig interface {
+	Brokers() []string
+}
+
+// PaymentSettledProducerConfig holds the topic pending payments are announced to once they settle
+type PaymentSettledProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
+}
+
+type PostgresConfig interface {
+	Address() string
+	MigrationDirectory() string
+}
+
 type TracingConfig interface {
 	CollectorEndpoint() string
 	ServiceName() string
@@ -0,0 +1,34 @@
+package encoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
+)
+
+type paymentSettledEncoder struct{}
+
+func NewPaymentSettledEncoder() *paymentSettledEncoder {
+	return &paymentSettledEncoder{}
+}
+
+func (e *paymentSettledEncoder) Encode(event model.PaymentSettledEvent) ([]byte, error) {
+	payload, err := proto.Marshal(&eventsV1.PaymentSettled{
+		EventUuid:       event.EventUUID,
+		OrderUuid:       event.OrderUUID,
+		TransactionUuid: event.TransactionUUID,
+		AttemptKey:      event.AttemptKey,
+		Status:          string(event.Status),
+		PaymentMethod:   string(event.PaymentMethod),
+		Amount:          event.Amount.StringFixed(2),
+		Currency:        event.Currency,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	return payload, nil
+}
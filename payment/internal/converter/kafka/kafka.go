@@ -0,0 +1,7 @@
+package kafka
+
+import "github.com/dexguitar/spacecraftory/payment/internal/model"
+
+type PaymentSettledEncoder interface {
+	Encode(event model.PaymentSettledEvent) ([]byte, error)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
 	"github.com/dexguitar/spacecraftory/payment/internal/model"
 	paymentV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1"
@@ -18,13 +19,20 @@ func ToModelPayment(paymentDto *paymentV1.PayOrderRequest) (*model.Payment, erro
 	if err != nil {
 		return nil, errors.New("invalid user UUID")
 	}
+	amount, err := decimal.NewFromString(paymentDto.Amount)
+	if err != nil {
+		return nil, errors.New("invalid amount")
+	}
 	paymentMethod, ok := model.PaymentMethodMap[paymentDto.PaymentMethod]
 	if !ok {
 		return nil, errors.New("invalid payment method")
 	}
 	return &model.Payment{
 		OrderUUID:     orderUUID.String(),
+		AttemptKey:    paymentDto.AttemptKey,
 		UserUUID:      userUUID.String(),
+		Amount:        amount,
+		Currency:      paymentDto.Currency,
 		PaymentMethod: paymentMethod,
 	}, nil
 }
@@ -34,6 +42,9 @@ func ToProtoPayment(paymentServiceModel *model.Payment) *paymentV1.PayOrderReque
 		OrderUuid:     paymentServiceModel.OrderUUID,
 		UserUuid:      paymentServiceModel.UserUUID,
 		PaymentMethod: toProtoPaymentMethod(paymentServiceModel.PaymentMethod),
+		Amount:        paymentServiceModel.Amount.StringFixed(2),
+		Currency:      paymentServiceModel.Currency,
+		AttemptKey:    paymentServiceModel.AttemptKey,
 	}
 }
 
@@ -51,3 +62,47 @@ func toProtoPaymentMethod(paymentMethod model.PaymentMethod) paymentV1.PaymentMe
 		return paymentV1.PaymentMethod_PAYMENT_METHOD_UNKNOWN_UNSPECIFIED
 	}
 }
+
+func ToModelRefund(refundDto *paymentV1.RefundPaymentRequest) (*model.Refund, error) {
+	transactionUUID, err := uuid.Parse(refundDto.TransactionUuid)
+	if err != nil {
+		return nil, errors.New("invalid transaction UUID")
+	}
+	// an empty amount refunds everything left on the payment
+	amount := decimal.Zero
+	if refundDto.Amount != "" {
+		amount, err = decimal.NewFromString(refundDto.Amount)
+		if err != nil {
+			return nil, errors.New("invalid amount")
+		}
+	}
+	return &model.Refund{
+		TransactionUUID: transactionUUID.String(),
+		RefundKey:       refundDto.RefundKey,
+		Amount:          amount,
+		Reason:          refundDto.Reason,
+	}, nil
+}
+
+func ToProtoRefundResponse(refund *model.Refund, payment *model.Payment) *paymentV1.RefundPaymentResponse {
+	return &paymentV1.RefundPaymentResponse{
+		RefundUuid:    refund.RefundUUID,
+		Amount:        refund.Amount.StringFixed(2),
+		Currency:      refund.Currency,
+		RefundedTotal: payment.RefundedAmount.StringFixed(2),
+		PaymentStatus: toProtoPaymentStatus(payment.Status),
+	}
+}
+
+func toProtoPaymentStatus(status model.PaymentStatus) paymentV1.PaymentStatus {
+	switch status {
+	case model.PaymentStatusSUCCEEDED:
+		return paymentV1.PaymentStatus_PAYMENT_STATUS_SUCCEEDED
+	case model.PaymentStatusPARTIALLY_REFUNDED:
+		return paymentV1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED
+	case model.PaymentStatusREFUNDED:
+		return paymentV1.PaymentStatus_PAYMENT_STATUS_REFUNDED
+	default:
+		return paymentV1.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	}
+}
@@ -2,4 +2,18 @@ package model
 
 import "errors"
 
-var ErrBadRequest = errors.New("bad request")
+var (
+	ErrBadRequest           = errors.New("bad request")
+	ErrAmountLimitExceeded  = errors.New("amount exceeds payment method limit")
+	ErrPaymentDeclined      = errors.New("payment declined by the provider")
+	ErrPaymentPending       = errors.New("payment is awaiting confirmation")
+	ErrProviderTimeout      = errors.New("payment provider timed out")
+	ErrChargeNotFound       = errors.New("charge not found by the provider")
+	ErrPaymentNotFound      = errors.New("payment not found")
+	ErrPaymentAlreadyExists = errors.New("payment attempt already exists")
+	ErrPaymentConflict      = errors.New("payment was modified concurrently")
+	ErrPaymentNotRefundable = errors.New("payment cannot be refunded")
+	ErrRefundExceedsPayment = errors.New("refund exceeds the amount left on the payment")
+	ErrRefundNotFound       = errors.New("refund not found")
+	ErrRefundKeyMismatch    = errors.New("refund key was used for another amount")
+)
@@ -0,0 +1,23 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+// Event types and schema versions carried in the Kafka event envelope
+const (
+	EventTypePaymentSettled = "PaymentSettled"
+
+	PaymentSettledSchemaVersion = 1
+)
+
+// PaymentSettledEvent announces the final status of a payment that was pending.
+// Every payment settles once, so the transaction UUID identifies the event.
+type PaymentSettledEvent struct {
+	EventUUID       string
+	OrderUUID       string
+	TransactionUUID string
+	AttemptKey      string
+	Status          PaymentStatus
+	PaymentMethod   PaymentMethod
+	Amount          decimal.Decimal
+	Currency        string
+}
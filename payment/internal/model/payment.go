@@ -1,15 +1,33 @@
 package model
 
 import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
 	paymentV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1"
 )
 
 type PaymentMethod string
 
+type PaymentStatus string
+
+// Payment is a payment transaction as recorded in the ledger.
+// TransactionUUID, Status and timestamps are set when the payment is stored.
+// AttemptKey identifies the payment attempt of the order, an order is charged at most
+// once per attempt key. RefundedAmount is the part of Amount returned by refunds so far.
 type Payment struct {
-	OrderUUID     string
-	UserUUID      string
-	PaymentMethod PaymentMethod
+	TransactionUUID string
+	OrderUUID       string
+	AttemptKey      string
+	UserUUID        string
+	Amount          decimal.Decimal
+	Currency        string
+	RefundedAmount  decimal.Decimal
+	PaymentMethod   PaymentMethod
+	Status          PaymentStatus
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 const (
@@ -20,6 +38,29 @@ const (
 	PaymentMethodUNKNOWN        PaymentMethod = "UNKNOWN"
 )
 
+// CurrencyRUB is the only currency payments are accepted in for now.
+const CurrencyRUB = "RUB"
+
+const (
+	// PaymentStatusPENDING is a charge the provider has not confirmed yet
+	PaymentStatusPENDING            PaymentStatus = "PENDING"
+	PaymentStatusSUCCEEDED          PaymentStatus = "SUCCEEDED"
+	PaymentStatusDECLINED           PaymentStatus = "DECLINED"
+	PaymentStatusPARTIALLY_REFUNDED PaymentStatus = "PARTIALLY_REFUNDED"
+	PaymentStatusREFUNDED           PaymentStatus = "REFUNDED"
+)
+
+// SameAttempt reports whether the payment repeats the other one: it is made for the same
+// order attempt by the same user with the same amount and method
+func (p *Payment) SameAttempt(other *Payment) bool {
+	return p.OrderUUID == other.OrderUUID &&
+		p.AttemptKey == other.AttemptKey &&
+		p.UserUUID == other.UserUUID &&
+		p.Amount.Equal(other.Amount) &&
+		p.Currency == other.Currency &&
+		p.PaymentMethod == other.PaymentMethod
+}
+
 var PaymentMethodMap = map[paymentV1.PaymentMethod]PaymentMethod{
 	paymentV1.PaymentMethod_PAYMENT_METHOD_CARD:                PaymentMethodCARD,
 	paymentV1.PaymentMethod_PAYMENT_METHOD_SBP:                 PaymentMethodSBP,
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Refund returns money of a payment, a payment may be refunded by several refunds
+// until nothing is left on it. Currency is the currency of the refunded payment.
+// RefundKey is chosen by the caller, a payment is refunded at most once per key.
+type Refund struct {
+	RefundUUID      string
+	TransactionUUID string
+	RefundKey       string
+	Amount          decimal.Decimal
+	Currency        string
+	Reason          string
+	CreatedAt       time.Time
+}
+
+// RefundableAmount is the part of the payment that has not been refunded yet
+func (p *Payment) RefundableAmount() decimal.Decimal {
+	return p.Amount.Sub(p.RefundedAmount)
+}
+
+// ApplyRefund records the refund on the payment and moves it to PARTIALLY_REFUNDED
+// or REFUNDED. A zero refund amount refunds everything left on the payment.
+// It fails with ErrRefundExceedsPayment when the refund is larger than what is left.
+func (p *Payment) ApplyRefund(refund *Refund) error {
+	if p.Status != PaymentStatusSUCCEEDED && p.Status != PaymentStatusPARTIALLY_REFUNDED {
+		return ErrPaymentNotRefundable
+	}
+
+	refundable := p.RefundableAmount()
+	if refund.Amount.IsZero() {
+		refund.Amount = refundable
+	}
+	if !refund.Amount.IsPositive() || refund.Amount.GreaterThan(refundable) {
+		return ErrRefundExceedsPayment
+	}
+
+	refund.Currency = p.Currency
+	p.RefundedAmount = p.RefundedAmount.Add(refund.Amount)
+	if p.RefundedAmount.Equal(p.Amount) {
+		p.Status = PaymentStatusREFUNDED
+	} else {
+		p.Status = PaymentStatusPARTIALLY_REFUNDED
+	}
+
+	return nil
+}
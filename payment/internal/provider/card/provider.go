@@ -0,0 +1,27 @@
+package card
+
+import (
+	"context"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+)
+
+// provider charges bank cards. No acquiring gateway is connected yet,
+// every charge and refund is approved right away.
+type provider struct{}
+
+func NewProvider() *provider {
+	return &provider{}
+}
+
+func (p *provider) Charge(ctx context.Context, payment *model.Payment) (model.PaymentStatus, error) {
+	return model.PaymentStatusSUCCEEDED, nil
+}
+
+func (p *provider) ChargeStatus(ctx context.Context, payment *model.Payment) (model.PaymentStatus, error) {
+	return model.PaymentStatusSUCCEEDED, nil
+}
+
+func (p *provider) Refund(ctx context.Context, payment *model.Payment, refund *model.Refund) error {
+	return nil
+}
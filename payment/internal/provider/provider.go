@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+)
+
+// PaymentProvider charges payments through the gateway of a payment method.
+// Gateways identify a charge by the order UUID and attempt key of the payment,
+// so charging the same attempt twice does not charge the customer twice.
+type PaymentProvider interface {
+	// Charge charges the payment. A charge the gateway confirms asynchronously returns
+	// PaymentStatusPENDING, a declined charge fails with ErrPaymentDeclined.
+	Charge(ctx context.Context, payment *model.Payment) (model.PaymentStatus, error)
+	// ChargeStatus reports the status of a pending charge, a charge declined
+	// on confirmation fails with ErrPaymentDeclined and a charge the gateway
+	// has no record of fails with ErrChargeNotFound.
+	ChargeStatus(ctx context.Context, payment *model.Payment) (model.PaymentStatus, error)
+	// Refund returns the refund amount of a charged payment to the customer. Gateways
+	// identify a refund by the transaction and refund key, so repeating a refund
+	// returns the money once.
+	Refund(ctx context.Context, payment *model.Payment, refund *model.Refund) error
+}
@@ -0,0 +1,27 @@
+package sbp
+
+import (
+	"context"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+)
+
+// provider charges through the Fast Payment System (SBP). No bank is connected yet,
+// every transfer and refund is approved right away.
+type provider struct{}
+
+func NewProvider() *provider {
+	return &provider{}
+}
+
+func (p *provider) Charge(ctx context.Context, payment *model.Payment) (model.PaymentStatus, error) {
+	return model.PaymentStatusSUCCEEDED, nil
+}
+
+func (p *provider) ChargeStatus(ctx context.Context, payment *model.Payment) (model.PaymentStatus, error) {
+	return model.PaymentStatusSUCCEEDED, nil
+}
+
+func (p *provider) Refund(ctx context.Context, payment *model.Payment, refund *model.Refund) error {
+	return nil
+}
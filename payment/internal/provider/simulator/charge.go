@@ -0,0 +1,66 @@
+package simulator
+
+import (
+	"context"
+	"time"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+)
+
+func (p *provider) Charge(ctx context.Context, payment *model.Payment) (model.PaymentStatus, error) {
+	switch outcome := p.nextOutcome(); outcome {
+	case OutcomeDecline:
+		return "", model.ErrPaymentDeclined
+	case OutcomeTimeout:
+		<-ctx.Done()
+		return "", ctx.Err()
+	case OutcomeAsync, OutcomeAsyncDecline:
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.pending[chargeKey(payment)] = pendingCharge{
+			confirmAt: time.Now().Add(p.confirmAfter),
+			declined:  outcome == OutcomeAsyncDecline,
+		}
+		return model.PaymentStatusPENDING, nil
+	default:
+		return model.PaymentStatusSUCCEEDED, nil
+	}
+}
+
+func (p *provider) ChargeStatus(ctx context.Context, payment *model.Payment) (model.PaymentStatus, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// charges pending before a restart are forgotten, nothing was charged for them
+	charge, ok := p.pending[chargeKey(payment)]
+	if !ok {
+		return "", model.ErrChargeNotFound
+	}
+	if time.Now().Before(charge.confirmAt) {
+		return model.PaymentStatusPENDING, nil
+	}
+	if charge.declined {
+		return "", model.ErrPaymentDeclined
+	}
+
+	return model.PaymentStatusSUCCEEDED, nil
+}
+
+// chargeKey identifies the charge the way gateways do, by the order attempt
+func chargeKey(payment *model.Payment) string {
+	return payment.OrderUUID + "/" + payment.AttemptKey
+}
+
+// Refund approves the refund right away. A charge still pending or declined on
+// confirmation took no money, refunding it fails with ErrPaymentNotRefundable.
+func (p *provider) Refund(ctx context.Context, payment *model.Payment, refund *model.Refund) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	charge, ok := p.pending[chargeKey(payment)]
+	if ok && (charge.declined || time.Now().Before(charge.confirmAt)) {
+		return model.ErrPaymentNotRefundable
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package simulator
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Outcome is how the simulator answers a charge
+type Outcome string
+
+const (
+	// OutcomeApprove approves the charge right away
+	OutcomeApprove Outcome = "approve"
+	// OutcomeDecline declines the charge
+	OutcomeDecline Outcome = "decline"
+	// OutcomeTimeout never answers, the charge fails once the context is done
+	OutcomeTimeout Outcome = "timeout"
+	// OutcomeAsync leaves the charge pending, it is approved after the confirmation delay
+	OutcomeAsync Outcome = "async"
+	// OutcomeAsyncDecline leaves the charge pending, it is declined after the confirmation delay
+	OutcomeAsyncDecline Outcome = "async_decline"
+)
+
+// pendingCharge is a charge waiting for asynchronous confirmation
+type pendingCharge struct {
+	confirmAt time.Time
+	declined  bool
+}
+
+// provider is a payment gateway simulator for local runs and integration tests.
+// Charges get the outcomes of the script in order, once the script is used up
+// every charge gets the fallback outcome.
+type provider struct {
+	mu           sync.Mutex
+	script       []Outcome
+	fallback     Outcome
+	confirmAfter time.Duration
+	pending      map[string]pendingCharge
+}
+
+func NewProvider(script []string, fallback string, confirmAfter time.Duration) (*provider, error) {
+	outcomes := make([]Outcome, 0, len(script))
+	for _, s := range script {
+		outcome, err := parseOutcome(s)
+		if err != nil {
+			return nil, err
+		}
+		outcomes = append(outcomes, outcome)
+	}
+
+	fallbackOutcome, err := parseOutcome(fallback)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider{
+		script:       outcomes,
+		fallback:     fallbackOutcome,
+		confirmAfter: confirmAfter,
+		pending:      make(map[string]pendingCharge),
+	}, nil
+}
+
+func parseOutcome(s string) (Outcome, error) {
+	switch outcome := Outcome(s); outcome {
+	case OutcomeApprove, OutcomeDecline, OutcomeTimeout, OutcomeAsync, OutcomeAsyncDecline:
+		return outcome, nil
+	default:
+		return "", fmt.Errorf("unknown simulator outcome %q", s)
+	}
+}
+
+// nextOutcome takes the next outcome of the script
+func (p *provider) nextOutcome() Outcome {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.script) == 0 {
+		return p.fallback
+	}
+	outcome := p.script[0]
+	p.script = p.script[1:]
+
+	return outcome
+}
@@ -0,0 +1,181 @@
+package simulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+)
+
+func newPayment(attemptKey string) *model.Payment {
+	return &model.Payment{
+		OrderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+		AttemptKey:    attemptKey,
+		PaymentMethod: model.PaymentMethodCARD,
+	}
+}
+
+func TestNewProviderUnknownOutcome(t *testing.T) {
+	_, err := NewProvider([]string{"approve", "explode"}, "approve", time.Second)
+	assert.Error(t, err)
+
+	_, err = NewProvider(nil, "explode", time.Second)
+	assert.Error(t, err)
+}
+
+func TestChargeFollowsScript(t *testing.T) {
+	p, err := NewProvider([]string{"decline", "approve", "async"}, "decline", time.Hour)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = p.Charge(ctx, newPayment("0"))
+	assert.ErrorIs(t, err, model.ErrPaymentDeclined)
+
+	status, err := p.Charge(ctx, newPayment("1"))
+	require.NoError(t, err)
+	assert.Equal(t, model.PaymentStatusSUCCEEDED, status)
+
+	status, err = p.Charge(ctx, newPayment("2"))
+	require.NoError(t, err)
+	assert.Equal(t, model.PaymentStatusPENDING, status)
+
+	// the script is used up, the rest get the fallback outcome
+	for _, attemptKey := range []string{"3", "4"} {
+		_, err = p.Charge(ctx, newPayment(attemptKey))
+		assert.ErrorIs(t, err, model.ErrPaymentDeclined)
+	}
+}
+
+func TestChargeTimeout(t *testing.T) {
+	p, err := NewProvider([]string{"timeout"}, "approve", time.Second)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = p.Charge(ctx, newPayment("0"))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestChargeStatus(t *testing.T) {
+	testCases := []struct {
+		name           string
+		outcome        string
+		confirmAfter   time.Duration
+		expectedStatus model.PaymentStatus
+		expectedError  error
+	}{
+		{
+			name:           "Async charge before confirmation",
+			outcome:        "async",
+			confirmAfter:   time.Hour,
+			expectedStatus: model.PaymentStatusPENDING,
+		},
+		{
+			name:           "Async charge confirmed",
+			outcome:        "async",
+			expectedStatus: model.PaymentStatusSUCCEEDED,
+		},
+		{
+			name:          "Async charge declined on confirmation",
+			outcome:       "async_decline",
+			expectedError: model.ErrPaymentDeclined,
+		},
+		{
+			name:           "Async decline before confirmation",
+			outcome:        "async_decline",
+			confirmAfter:   time.Hour,
+			expectedStatus: model.PaymentStatusPENDING,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewProvider([]string{tc.outcome}, "approve", tc.confirmAfter)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			payment := newPayment("0")
+
+			status, err := p.Charge(ctx, payment)
+			require.NoError(t, err)
+			require.Equal(t, model.PaymentStatusPENDING, status)
+
+			status, err = p.ChargeStatus(ctx, payment)
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, status)
+		})
+	}
+}
+
+func TestChargeStatusUnknownCharge(t *testing.T) {
+	p, err := NewProvider(nil, "async", time.Hour)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = p.Charge(ctx, newPayment("0"))
+	require.NoError(t, err)
+
+	// another attempt of the same order was never charged
+	_, err = p.ChargeStatus(ctx, newPayment("1"))
+	assert.ErrorIs(t, err, model.ErrChargeNotFound)
+}
+
+func TestRefund(t *testing.T) {
+	testCases := []struct {
+		name          string
+		outcome       string
+		confirmAfter  time.Duration
+		expectedError error
+	}{
+		{
+			name:    "Approved charge",
+			outcome: "approve",
+		},
+		{
+			name:    "Async charge confirmed",
+			outcome: "async",
+		},
+		{
+			name:          "Async charge before confirmation",
+			outcome:       "async",
+			confirmAfter:  time.Hour,
+			expectedError: model.ErrPaymentNotRefundable,
+		},
+		{
+			name:          "Async charge declined on confirmation",
+			outcome:       "async_decline",
+			expectedError: model.ErrPaymentNotRefundable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewProvider([]string{tc.outcome}, "approve", tc.confirmAfter)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			payment := newPayment("0")
+
+			_, err = p.Charge(ctx, payment)
+			require.NoError(t, err)
+
+			err = p.Refund(ctx, payment, &model.Refund{RefundKey: "0"})
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -7,8 +7,56 @@ import (
 
 func ToRepoPayment(paymentInfo *serviceModel.Payment) repoModel.Payment {
 	return repoModel.Payment{
-		OrderUUID:     paymentInfo.OrderUUID,
-		UserUUID:      paymentInfo.UserUUID,
-		PaymentMethod: paymentInfo.PaymentMethod,
+		TransactionUUID: paymentInfo.TransactionUUID,
+		OrderUUID:       paymentInfo.OrderUUID,
+		AttemptKey:      paymentInfo.AttemptKey,
+		UserUUID:        paymentInfo.UserUUID,
+		Amount:          paymentInfo.Amount,
+		Currency:        paymentInfo.Currency,
+		RefundedAmount:  paymentInfo.RefundedAmount,
+		PaymentMethod:   paymentInfo.PaymentMethod,
+		Status:          paymentInfo.Status,
+		CreatedAt:       paymentInfo.CreatedAt,
+		UpdatedAt:       paymentInfo.UpdatedAt,
+	}
+}
+
+func ToModelPayment(repoPayment *repoModel.Payment) *serviceModel.Payment {
+	return &serviceModel.Payment{
+		TransactionUUID: repoPayment.TransactionUUID,
+		OrderUUID:       repoPayment.OrderUUID,
+		AttemptKey:      repoPayment.AttemptKey,
+		UserUUID:        repoPayment.UserUUID,
+		Amount:          repoPayment.Amount,
+		Currency:        repoPayment.Currency,
+		RefundedAmount:  repoPayment.RefundedAmount,
+		PaymentMethod:   repoPayment.PaymentMethod,
+		Status:          repoPayment.Status,
+		CreatedAt:       repoPayment.CreatedAt,
+		UpdatedAt:       repoPayment.UpdatedAt,
+	}
+}
+
+func ToRepoRefund(refund *serviceModel.Refund) repoModel.Refund {
+	return repoModel.Refund{
+		RefundUUID:      refund.RefundUUID,
+		TransactionUUID: refund.TransactionUUID,
+		RefundKey:       refund.RefundKey,
+		Amount:          refund.Amount,
+		Currency:        refund.Currency,
+		Reason:          refund.Reason,
+		CreatedAt:       refund.CreatedAt,
+	}
+}
+
+func ToModelRefund(repoRefund *repoModel.Refund) *serviceModel.Refund {
+	return &serviceModel.Refund{
+		RefundUUID:      repoRefund.RefundUUID,
+		TransactionUUID: repoRefund.TransactionUUID,
+		RefundKey:       repoRefund.RefundKey,
+		Amount:          repoRefund.Amount,
+		Currency:        repoRefund.Currency,
+		Reason:          repoRefund.Reason,
+		CreatedAt:       repoRefund.CreatedAt,
 	}
 }
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	repoConverter "github.com/dexguitar/spacecraftory/payment/internal/repository/converter"
+	repoModel "github.com/dexguitar/spacecraftory/payment/internal/repository/model"
+)
+
+func (r *paymentRepository) GetPayment(ctx context.Context, transactionUUID string) (*model.Payment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	payment, ok := r.data[transactionUUID]
+	if !ok {
+		return nil, model.ErrPaymentNotFound
+	}
+
+	return repoConverter.ToModelPayment(payment), nil
+}
+
+func (r *paymentRepository) GetPaymentAttempt(ctx context.Context, orderUUID, attemptKey string) (*model.Payment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	payment := r.findAttempt(orderUUID, attemptKey)
+	if payment == nil {
+		return nil, model.ErrPaymentNotFound
+	}
+
+	return repoConverter.ToModelPayment(payment), nil
+}
+
+func (r *paymentRepository) ListPendingPayments(ctx context.Context, afterTransactionUUID string, limit uint64) ([]*model.Payment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	pending := make([]*repoModel.Payment, 0)
+	for _, payment := range r.data {
+		if payment.Status == model.PaymentStatusPENDING && payment.TransactionUUID > afterTransactionUUID {
+			pending = append(pending, payment)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].TransactionUUID < pending[j].TransactionUUID
+	})
+	if uint64(len(pending)) > limit {
+		pending = pending[:limit]
+	}
+
+	payments := make([]*model.Payment, 0, len(pending))
+	for _, payment := range pending {
+		payments = append(payments, repoConverter.ToModelPayment(payment))
+	}
+
+	return payments, nil
+}
+
+// findAttempt returns the payment of the order attempt, declined payments do not use up
+// the attempt. The caller holds the lock.
+func (r *paymentRepository) findAttempt(orderUUID, attemptKey string) *repoModel.Payment {
+	for _, payment := range r.data {
+		if payment.OrderUUID == orderUUID && payment.AttemptKey == attemptKey &&
+			payment.Status != model.PaymentStatusDECLINED {
+			return payment
+		}
+	}
+
+	return nil
+}
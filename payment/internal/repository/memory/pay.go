@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	repoConverter "github.com/dexguitar/spacecraftory/payment/internal/repository/converter"
+)
+
+func (r *paymentRepository) PayOrder(ctx context.Context, paymentInfo *model.Payment) (string, error) {
+	newPaymentUUID := uuid.New().String()
+
+	repoModel := repoConverter.ToRepoPayment(paymentInfo)
+	repoModel.TransactionUUID = newPaymentUUID
+	repoModel.RefundedAmount = decimal.Zero
+	repoModel.CreatedAt = time.Now()
+	repoModel.UpdatedAt = repoModel.CreatedAt
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// the order was charged for this attempt already
+	if r.findAttempt(repoModel.OrderUUID, repoModel.AttemptKey) != nil {
+		return "", model.ErrPaymentAlreadyExists
+	}
+	r.data[newPaymentUUID] = &repoModel
+
+	return newPaymentUUID, nil
+}
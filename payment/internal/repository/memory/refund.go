@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	repoConverter "github.com/dexguitar/spacecraftory/payment/internal/repository/converter"
+)
+
+func (r *paymentRepository) CreateRefund(ctx context.Context, payment *model.Payment, refund *model.Refund) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// the refund is applied on top of the refunded total it was computed from,
+	// a refund stored in between makes it fail
+	stored, ok := r.data[payment.TransactionUUID]
+	if !ok || !stored.RefundedAmount.Equal(payment.RefundedAmount.Sub(refund.Amount)) {
+		return "", model.ErrPaymentConflict
+	}
+
+	id := refundID{transactionUUID: refund.TransactionUUID, refundKey: refund.RefundKey}
+	if _, ok := r.refunds[id]; ok {
+		return "", model.ErrPaymentConflict
+	}
+
+	repoRefund := repoConverter.ToRepoRefund(refund)
+	repoRefund.RefundUUID = uuid.New().String()
+	repoRefund.CreatedAt = time.Now()
+
+	stored.RefundedAmount = payment.RefundedAmount
+	stored.Status = payment.Status
+	stored.UpdatedAt = repoRefund.CreatedAt
+	r.refunds[id] = &repoRefund
+
+	return repoRefund.RefundUUID, nil
+}
+
+func (r *paymentRepository) GetRefund(ctx context.Context, transactionUUID, refundKey string) (*model.Refund, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	refund, ok := r.refunds[refundID{transactionUUID: transactionUUID, refundKey: refundKey}]
+	if !ok {
+		return nil, model.ErrRefundNotFound
+	}
+
+	return repoConverter.ToModelRefund(refund), nil
+}
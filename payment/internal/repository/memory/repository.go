@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"sync"
+
+	repoModel "github.com/dexguitar/spacecraftory/payment/internal/repository/model"
+)
+
+// refundID identifies a refund the way the refunds table does: a payment is refunded
+// at most once per refund key
+type refundID struct {
+	transactionUUID string
+	refundKey       string
+}
+
+// paymentRepository keeps payments in memory. It is lost on restart
+// and meant as a test double for the Postgres ledger.
+type paymentRepository struct {
+	mu      sync.RWMutex
+	data    map[string]*repoModel.Payment
+	refunds map[refundID]*repoModel.Refund
+}
+
+func NewPaymentRepository() *paymentRepository {
+	return &paymentRepository{
+		data:    make(map[string]*repoModel.Payment),
+		refunds: make(map[refundID]*repoModel.Refund),
+	}
+}
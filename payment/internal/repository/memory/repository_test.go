@@ -0,0 +1,170 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	"github.com/dexguitar/spacecraftory/payment/internal/repository"
+)
+
+const orderUUID = "123e4567-e89b-12d3-a456-426614174000"
+
+// the test double has to stay interchangeable with the Postgres ledger
+var _ repository.PaymentRepository = (*paymentRepository)(nil)
+
+func newPayment(attemptKey string, status model.PaymentStatus) *model.Payment {
+	return &model.Payment{
+		OrderUUID:     orderUUID,
+		AttemptKey:    attemptKey,
+		UserUUID:      "123e4567-e89b-12d3-a456-426614174012",
+		Amount:        decimal.RequireFromString("1000.00"),
+		Currency:      model.CurrencyRUB,
+		PaymentMethod: model.PaymentMethodCARD,
+		Status:        status,
+	}
+}
+
+func TestPayOrderOncePerAttempt(t *testing.T) {
+	ctx := context.Background()
+	r := NewPaymentRepository()
+
+	declinedUUID, err := r.PayOrder(ctx, newPayment("0", model.PaymentStatusDECLINED))
+	require.NoError(t, err)
+
+	// a declined payment does not use up the attempt
+	transactionUUID, err := r.PayOrder(ctx, newPayment("0", model.PaymentStatusSUCCEEDED))
+	require.NoError(t, err)
+	assert.NotEqual(t, declinedUUID, transactionUUID)
+
+	_, err = r.PayOrder(ctx, newPayment("0", model.PaymentStatusSUCCEEDED))
+	assert.ErrorIs(t, err, model.ErrPaymentAlreadyExists)
+
+	payment, err := r.GetPaymentAttempt(ctx, orderUUID, "0")
+	require.NoError(t, err)
+	assert.Equal(t, transactionUUID, payment.TransactionUUID)
+	assert.True(t, payment.Amount.Equal(decimal.RequireFromString("1000.00")))
+	assert.Equal(t, model.CurrencyRUB, payment.Currency)
+	assert.True(t, payment.RefundedAmount.IsZero())
+
+	_, err = r.GetPaymentAttempt(ctx, orderUUID, "1")
+	assert.ErrorIs(t, err, model.ErrPaymentNotFound)
+
+	_, err = r.GetPayment(ctx, "unknown")
+	assert.ErrorIs(t, err, model.ErrPaymentNotFound)
+}
+
+func TestUpdatePaymentStatusSettlesPendingOnly(t *testing.T) {
+	ctx := context.Background()
+	r := NewPaymentRepository()
+
+	pendingUUID, err := r.PayOrder(ctx, newPayment("0", model.PaymentStatusPENDING))
+	require.NoError(t, err)
+	require.NoError(t, r.UpdatePaymentStatus(ctx, pendingUUID, model.PaymentStatusSUCCEEDED))
+
+	// a payment settled already is left as is
+	require.NoError(t, r.UpdatePaymentStatus(ctx, pendingUUID, model.PaymentStatusDECLINED))
+
+	payment, err := r.GetPayment(ctx, pendingUUID)
+	require.NoError(t, err)
+	assert.Equal(t, model.PaymentStatusSUCCEEDED, payment.Status)
+}
+
+func TestListPendingPaymentsPages(t *testing.T) {
+	ctx := context.Background()
+	r := NewPaymentRepository()
+
+	for _, attemptKey := range []string{"0", "1", "2"} {
+		_, err := r.PayOrder(ctx, newPayment(attemptKey, model.PaymentStatusPENDING))
+		require.NoError(t, err)
+	}
+	_, err := r.PayOrder(ctx, newPayment("3", model.PaymentStatusSUCCEEDED))
+	require.NoError(t, err)
+
+	first, err := r.ListPendingPayments(ctx, "", 2)
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	assert.Less(t, first[0].TransactionUUID, first[1].TransactionUUID)
+
+	rest, err := r.ListPendingPayments(ctx, first[1].TransactionUUID, 2)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.Greater(t, rest[0].TransactionUUID, first[1].TransactionUUID)
+	assert.Equal(t, model.PaymentStatusPENDING, rest[0].Status)
+}
+
+func TestCreateRefund(t *testing.T) {
+	ctx := context.Background()
+	r := NewPaymentRepository()
+
+	transactionUUID, err := r.PayOrder(ctx, newPayment("0", model.PaymentStatusSUCCEEDED))
+	require.NoError(t, err)
+	payment, err := r.GetPayment(ctx, transactionUUID)
+	require.NoError(t, err)
+
+	refund := &model.Refund{
+		TransactionUUID: transactionUUID,
+		RefundKey:       "1",
+		Amount:          decimal.RequireFromString("250.00"),
+		Currency:        model.CurrencyRUB,
+		Reason:          "customer request",
+	}
+	require.NoError(t, payment.ApplyRefund(refund))
+
+	refundUUID, err := r.CreateRefund(ctx, payment, refund)
+	require.NoError(t, err)
+
+	stored, err := r.GetRefund(ctx, transactionUUID, "1")
+	require.NoError(t, err)
+	assert.Equal(t, refundUUID, stored.RefundUUID)
+	assert.True(t, stored.Amount.Equal(refund.Amount))
+	assert.Equal(t, "customer request", stored.Reason)
+
+	payment, err = r.GetPayment(ctx, transactionUUID)
+	require.NoError(t, err)
+	assert.Equal(t, model.PaymentStatusPARTIALLY_REFUNDED, payment.Status)
+	assert.True(t, payment.RefundedAmount.Equal(decimal.RequireFromString("250.00")))
+
+	_, err = r.GetRefund(ctx, transactionUUID, "2")
+	assert.ErrorIs(t, err, model.ErrRefundNotFound)
+}
+
+func TestCreateRefundConflict(t *testing.T) {
+	ctx := context.Background()
+	r := NewPaymentRepository()
+
+	transactionUUID, err := r.PayOrder(ctx, newPayment("0", model.PaymentStatusSUCCEEDED))
+	require.NoError(t, err)
+
+	refundOf := func(payment *model.Payment, refundKey string) *model.Refund {
+		refund := &model.Refund{
+			TransactionUUID: transactionUUID,
+			RefundKey:       refundKey,
+			Amount:          decimal.RequireFromString("100.00"),
+		}
+		require.NoError(t, payment.ApplyRefund(refund))
+		return refund
+	}
+
+	// both refunds are computed from the same refunded total
+	first, err := r.GetPayment(ctx, transactionUUID)
+	require.NoError(t, err)
+	second, err := r.GetPayment(ctx, transactionUUID)
+	require.NoError(t, err)
+
+	_, err = r.CreateRefund(ctx, first, refundOf(first, "1"))
+	require.NoError(t, err)
+
+	_, err = r.CreateRefund(ctx, second, refundOf(second, "2"))
+	assert.ErrorIs(t, err, model.ErrPaymentConflict)
+
+	// a refund key is used once even on top of the current total
+	current, err := r.GetPayment(ctx, transactionUUID)
+	require.NoError(t, err)
+	_, err = r.CreateRefund(ctx, current, refundOf(current, "1"))
+	assert.ErrorIs(t, err, model.ErrPaymentConflict)
+}
@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+)
+
+func (r *paymentRepository) UpdatePaymentStatus(ctx context.Context, transactionUUID string, status model.PaymentStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	payment, ok := r.data[transactionUUID]
+	if !ok || payment.Status != model.PaymentStatusPENDING {
+		return nil
+	}
+	payment.Status = status
+	payment.UpdatedAt = time.Now()
+
+	return nil
+}
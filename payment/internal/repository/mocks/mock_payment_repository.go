@@ -22,6 +22,303 @@ func (_m *PaymentRepository) EXPECT() *PaymentRepository_Expecter {
 	return &PaymentRepository_Expecter{mock: &_m.Mock}
 }
 
+// CreateRefund provides a mock function with given fields: ctx, payment, refund
+func (_m *PaymentRepository) CreateRefund(ctx context.Context, payment *model.Payment, refund *model.Refund) (string, error) {
+	ret := _m.Called(ctx, payment, refund)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRefund")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Payment, *model.Refund) (string, error)); ok {
+		return rf(ctx, payment, refund)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Payment, *model.Refund) string); ok {
+		r0 = rf(ctx, payment, refund)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Payment, *model.Refund) error); ok {
+		r1 = rf(ctx, payment, refund)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_CreateRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefund'
+type PaymentRepository_CreateRefund_Call struct {
+	*mock.Call
+}
+
+// CreateRefund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *model.Payment
+//   - refund *model.Refund
+func (_e *PaymentRepository_Expecter) CreateRefund(ctx interface{}, payment interface{}, refund interface{}) *PaymentRepository_CreateRefund_Call {
+	return &PaymentRepository_CreateRefund_Call{Call: _e.mock.On("CreateRefund", ctx, payment, refund)}
+}
+
+func (_c *PaymentRepository_CreateRefund_Call) Run(run func(ctx context.Context, payment *model.Payment, refund *model.Refund)) *PaymentRepository_CreateRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Payment), args[2].(*model.Refund))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_CreateRefund_Call) Return(_a0 string, _a1 error) *PaymentRepository_CreateRefund_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_CreateRefund_Call) RunAndReturn(run func(context.Context, *model.Payment, *model.Refund) (string, error)) *PaymentRepository_CreateRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPayment provides a mock function with given fields: ctx, transactionUUID
+func (_m *PaymentRepository) GetPayment(ctx context.Context, transactionUUID string) (*model.Payment, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayment")
+	}
+
+	var r0 *model.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Payment, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Payment); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_GetPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPayment'
+type PaymentRepository_GetPayment_Call struct {
+	*mock.Call
+}
+
+// GetPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *PaymentRepository_Expecter) GetPayment(ctx interface{}, transactionUUID interface{}) *PaymentRepository_GetPayment_Call {
+	return &PaymentRepository_GetPayment_Call{Call: _e.mock.On("GetPayment", ctx, transactionUUID)}
+}
+
+func (_c *PaymentRepository_GetPayment_Call) Run(run func(ctx context.Context, transactionUUID string)) *PaymentRepository_GetPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_GetPayment_Call) Return(_a0 *model.Payment, _a1 error) *PaymentRepository_GetPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_GetPayment_Call) RunAndReturn(run func(context.Context, string) (*model.Payment, error)) *PaymentRepository_GetPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaymentAttempt provides a mock function with given fields: ctx, orderUUID, attemptKey
+func (_m *PaymentRepository) GetPaymentAttempt(ctx context.Context, orderUUID string, attemptKey string) (*model.Payment, error) {
+	ret := _m.Called(ctx, orderUUID, attemptKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentAttempt")
+	}
+
+	var r0 *model.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Payment, error)); ok {
+		return rf(ctx, orderUUID, attemptKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Payment); ok {
+		r0 = rf(ctx, orderUUID, attemptKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, orderUUID, attemptKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_GetPaymentAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentAttempt'
+type PaymentRepository_GetPaymentAttempt_Call struct {
+	*mock.Call
+}
+
+// GetPaymentAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - attemptKey string
+func (_e *PaymentRepository_Expecter) GetPaymentAttempt(ctx interface{}, orderUUID interface{}, attemptKey interface{}) *PaymentRepository_GetPaymentAttempt_Call {
+	return &PaymentRepository_GetPaymentAttempt_Call{Call: _e.mock.On("GetPaymentAttempt", ctx, orderUUID, attemptKey)}
+}
+
+func (_c *PaymentRepository_GetPaymentAttempt_Call) Run(run func(ctx context.Context, orderUUID string, attemptKey string)) *PaymentRepository_GetPaymentAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_GetPaymentAttempt_Call) Return(_a0 *model.Payment, _a1 error) *PaymentRepository_GetPaymentAttempt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_GetPaymentAttempt_Call) RunAndReturn(run func(context.Context, string, string) (*model.Payment, error)) *PaymentRepository_GetPaymentAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRefund provides a mock function with given fields: ctx, transactionUUID, refundKey
+func (_m *PaymentRepository) GetRefund(ctx context.Context, transactionUUID string, refundKey string) (*model.Refund, error) {
+	ret := _m.Called(ctx, transactionUUID, refundKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefund")
+	}
+
+	var r0 *model.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Refund, error)); ok {
+		return rf(ctx, transactionUUID, refundKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Refund); ok {
+		r0 = rf(ctx, transactionUUID, refundKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Refund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, transactionUUID, refundKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_GetRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefund'
+type PaymentRepository_GetRefund_Call struct {
+	*mock.Call
+}
+
+// GetRefund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - refundKey string
+func (_e *PaymentRepository_Expecter) GetRefund(ctx interface{}, transactionUUID interface{}, refundKey interface{}) *PaymentRepository_GetRefund_Call {
+	return &PaymentRepository_GetRefund_Call{Call: _e.mock.On("GetRefund", ctx, transactionUUID, refundKey)}
+}
+
+func (_c *PaymentRepository_GetRefund_Call) Run(run func(ctx context.Context, transactionUUID string, refundKey string)) *PaymentRepository_GetRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_GetRefund_Call) Return(_a0 *model.Refund, _a1 error) *PaymentRepository_GetRefund_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_GetRefund_Call) RunAndReturn(run func(context.Context, string, string) (*model.Refund, error)) *PaymentRepository_GetRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPendingPayments provides a mock function with given fields: ctx, afterTransactionUUID, limit
+func (_m *PaymentRepository) ListPendingPayments(ctx context.Context, afterTransactionUUID string, limit uint64) ([]*model.Payment, error) {
+	ret := _m.Called(ctx, afterTransactionUUID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingPayments")
+	}
+
+	var r0 []*model.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) ([]*model.Payment, error)); ok {
+		return rf(ctx, afterTransactionUUID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) []*model.Payment); ok {
+		r0 = rf(ctx, afterTransactionUUID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = rf(ctx, afterTransactionUUID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_ListPendingPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingPayments'
+type PaymentRepository_ListPendingPayments_Call struct {
+	*mock.Call
+}
+
+// ListPendingPayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - afterTransactionUUID string
+//   - limit uint64
+func (_e *PaymentRepository_Expecter) ListPendingPayments(ctx interface{}, afterTransactionUUID interface{}, limit interface{}) *PaymentRepository_ListPendingPayments_Call {
+	return &PaymentRepository_ListPendingPayments_Call{Call: _e.mock.On("ListPendingPayments", ctx, afterTransactionUUID, limit)}
+}
+
+func (_c *PaymentRepository_ListPendingPayments_Call) Run(run func(ctx context.Context, afterTransactionUUID string, limit uint64)) *PaymentRepository_ListPendingPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_ListPendingPayments_Call) Return(_a0 []*model.Payment, _a1 error) *PaymentRepository_ListPendingPayments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_ListPendingPayments_Call) RunAndReturn(run func(context.Context, string, uint64) ([]*model.Payment, error)) *PaymentRepository_ListPendingPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PayOrder provides a mock function with given fields: ctx, payment
 func (_m *PaymentRepository) PayOrder(ctx context.Context, payment *model.Payment) (string, error) {
 	ret := _m.Called(ctx, payment)
@@ -79,6 +376,54 @@ func (_c *PaymentRepository_PayOrder_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// UpdatePaymentStatus provides a mock function with given fields: ctx, transactionUUID, status
+func (_m *PaymentRepository) UpdatePaymentStatus(ctx context.Context, transactionUUID string, status model.PaymentStatus) error {
+	ret := _m.Called(ctx, transactionUUID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePaymentStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PaymentStatus) error); ok {
+		r0 = rf(ctx, transactionUUID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentRepository_UpdatePaymentStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePaymentStatus'
+type PaymentRepository_UpdatePaymentStatus_Call struct {
+	*mock.Call
+}
+
+// UpdatePaymentStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - status model.PaymentStatus
+func (_e *PaymentRepository_Expecter) UpdatePaymentStatus(ctx interface{}, transactionUUID interface{}, status interface{}) *PaymentRepository_UpdatePaymentStatus_Call {
+	return &PaymentRepository_UpdatePaymentStatus_Call{Call: _e.mock.On("UpdatePaymentStatus", ctx, transactionUUID, status)}
+}
+
+func (_c *PaymentRepository_UpdatePaymentStatus_Call) Run(run func(ctx context.Context, transactionUUID string, status model.PaymentStatus)) *PaymentRepository_UpdatePaymentStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.PaymentStatus))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_UpdatePaymentStatus_Call) Return(_a0 error) *PaymentRepository_UpdatePaymentStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentRepository_UpdatePaymentStatus_Call) RunAndReturn(run func(context.Context, string, model.PaymentStatus) error) *PaymentRepository_UpdatePaymentStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPaymentRepository creates a new instance of PaymentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPaymentRepository(t interface {
@@ -1,11 +1,33 @@
 package converter
 
 import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
 	"github.com/dexguitar/spacecraftory/payment/internal/model"
 )
 
 type Payment struct {
-	OrderUUID     string              `db:"order_uuid"`
-	UserUUID      string              `db:"user_uuid"`
-	PaymentMethod model.PaymentMethod `db:"payment_method"`
+	TransactionUUID string              `db:"transaction_uuid"`
+	OrderUUID       string              `db:"order_uuid"`
+	AttemptKey      string              `db:"attempt_key"`
+	UserUUID        string              `db:"user_uuid"`
+	Amount          decimal.Decimal     `db:"amount"`
+	Currency        string              `db:"currency"`
+	RefundedAmount  decimal.Decimal     `db:"refunded_amount"`
+	PaymentMethod   model.PaymentMethod `db:"payment_method"`
+	Status          model.PaymentStatus `db:"status"`
+	CreatedAt       time.Time           `db:"created_at"`
+	UpdatedAt       time.Time           `db:"updated_at"`
+}
+
+type Refund struct {
+	RefundUUID      string          `db:"refund_uuid"`
+	TransactionUUID string          `db:"transaction_uuid"`
+	RefundKey       string          `db:"refund_key"`
+	Amount          decimal.Decimal `db:"amount"`
+	Currency        string          `db:"currency"`
+	Reason          string          `db:"reason"`
+	CreatedAt       time.Time       `db:"created_at"`
 }
@@ -0,0 +1,105 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/dexguitar/spacecraftory/payment/internal/model"
+	"github.com/dexguitar/spacecraftory/payment/internal/repository/converter"
+	repoModel "github.com/dexguitar/spacecraftory/payment/internal/repository/model"
+)
+
+// paymentColumns are selected into model.Payment, amount and currency are empty
+// for payments stored before PayOrder carried them
+var paymentColumns = []string{
+	"transaction_uuid",
+	"order_uuid",
+	"attempt_key",
+	"user_uuid",
+	"coalesce(amount, 0) as amount",
+	"coalesce(currency, '') as currency",
+	"refunded_amount",
+	"payment_method",
+	"status",
+	"created_at",
+	"updated_at",
+}
+
+func (r *paymentRepository) GetPayment(ctx context.Context, transactionUUID string) (*serviceModel.Payment, error) {
+	return r.getPayment(ctx, sq.Eq{"transaction_uuid": transactionUUID})
+}
+
+func (r *paymentRepository) GetPaymentAttempt(ctx context.Context, orderUUID, attemptKey string) (*serviceModel.Payment, error) {
+	return r.getPayment(ctx, sq.And{
+		sq.Eq{"order_uuid": orderUUID, "attempt_key": attemptKey},
+		sq.NotEq{"status": serviceModel.PaymentStatusDECLINED},
+	})
+}
+
+func (r *paymentRepository) getPayment(ctx context.Context, where sq.Sqlizer) (*serviceModel.Payment, error) {
+	query, args, err := sq.
+		Select(paymentColumns...).
+		From("payments").
+		PlaceholderFormat(sq.Dollar).
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payment, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.Payment])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, serviceModel.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToModelPayment(&payment), nil
+}
+
+func (r *paymentRepository) ListPendingPayments(ctx context.Context, afterTransactionUUID string, limit uint64) ([]*serviceModel.Payment, error) {
+	where := sq.And{sq.Eq{"status": serviceModel.PaymentStatusPENDING}}
+	if afterTransactionUUID != "" {
+		where = append(where, sq.Gt{"transaction_uuid": afterTransactionUUID})
+	}
+
+	query, args, err := sq.
+		Select(paymentColumns...).
+		From("payments").
+		PlaceholderFormat(sq.Dollar).
+		Where(where).
+		OrderBy("transaction_uuid").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repoPayments, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.Payment])
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]*serviceModel.Payment, 0, len(repoPayments))
+	for i := range repoPayments {
+		payments = append(payments, converter.ToModelPayment(&repoPayments[i]))
+	}
+
+	return payments, nil
+}
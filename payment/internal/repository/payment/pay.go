@@ -3,6 +3,7 @@ package payment
 import (
 	"context"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 
 	"github.com/dexguitar/spacecraftory/payment/internal/model"
@@ -10,13 +11,29 @@ import (
 )
 
 func (r *paymentRepository) PayOrder(ctx context.Context, paymentInfo *model.Payment) (string, error) {
-	newPaymentUUID := uuid.New().String()
-
 	repoModel := repoConverter.ToRepoPayment(paymentInfo)
+	repoModel.TransactionUUID = uuid.New().String()
+
+	builderInsert := sq.
+		Insert("payments").
+		PlaceholderFormat(sq.Dollar).
+		Columns("transaction_uuid", "order_uuid", "attempt_key", "user_uuid", "amount", "currency", "payment_method", "status").
+		Values(repoModel.TransactionUUID, repoModel.OrderUUID, repoModel.AttemptKey, repoModel.UserUUID, repoModel.Amount, repoModel.Currency, repoModel.PaymentMethod, repoModel.Status).
+		Suffix("on conflict (order_uuid, attempt_key) where status <> 'DECLINED' do nothing")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return "", err
+	}
 
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	r.data[newPaymentUUID] = &repoModel
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return "", err
+	}
+	// the order was charged for this attempt already
+	if tag.RowsAffected() == 0 {
+		return "", model.ErrPaymentAlreadyExists
+	}
 
-	return newPaymentUUID, nil
+	return repoModel.TransactionUUID, nil
 }
@@ -0,0 +1,112 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	serviceModel "github.com/dexguitar/spacecraftory/payment/internal/model"
+	"github.com/dexguitar/spacecraftory/payment/internal/repository/converter"
+	repoModel "github.com/dexguitar/spacecraftory/payment/internal/repository/model"
+)
+
+func (r *paymentRepository) CreateRefund(ctx context.Context, payment *serviceModel.Payment, refund *serviceModel.Refund) (string, error) {
+	repoPayment := converter.ToRepoPayment(payment)
+	repoRefund := converter.ToRepoRefund(refund)
+	repoRefund.RefundUUID = uuid.New().String()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Printf("failed to rollback transaction: %v", err)
+		}
+	}()
+
+	// the refund is applied on top of the refunded total it was computed from,
+	// a refund stored in between makes the update miss
+	query, args, err := sq.
+		Update("payments").
+		PlaceholderFormat(sq.Dollar).
+		Set("refunded_amount", repoPayment.RefundedAmount).
+		Set("status", repoPayment.Status).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"transaction_uuid": repoPayment.TransactionUUID,
+			"refunded_amount":  repoPayment.RefundedAmount.Sub(repoRefund.Amount),
+		}).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return "", err
+	}
+	if tag.RowsAffected() == 0 {
+		return "", serviceModel.ErrPaymentConflict
+	}
+
+	query, args, err = sq.
+		Insert("refunds").
+		PlaceholderFormat(sq.Dollar).
+		Columns("refund_uuid", "transaction_uuid", "refund_key", "amount", "currency", "reason").
+		Values(repoRefund.RefundUUID, repoRefund.TransactionUUID, repoRefund.RefundKey, repoRefund.Amount, repoRefund.Currency, repoRefund.Reason).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		// a concurrent request with the same key stored its refund first
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return "", serviceModel.ErrPaymentConflict
+		}
+		return "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", err
+	}
+
+	return repoRefund.RefundUUID, nil
+}
+
+func (r *paymentRepository) GetRefund(ctx context.Context, transactionUUID, refundKey string) (*serviceModel.Refund, error) {
+	query, args, err := sq.
+		Select("refund_uuid", "transaction_uuid", "refund_key", "amount", "currency", "reason", "created_at").
+		From("refunds").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"transaction_uuid": transactionUUID, "refund_key": refundKey}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	refund, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.Refund])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, serviceModel.ErrRefundNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ToModelRefund(&refund), nil
+}
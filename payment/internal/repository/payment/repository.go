@@ -1,16 +1,15 @@
 package payment
 
 import (
-	"sync"
-
-	repoModel "github.com/dexguitar/spacecraftory/payment/internal/repository/model"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type paymentRepository struct {
-	mu   sync.RWMutex
-	data map[string]*repoModel.Payment
+	db *pgxpool.Pool
 }
 
-func NewPaymentRepository() *paymentRepository {
-	return &paymentRepository{data: make(map[string]*repoModel.Payment)}
+func NewPaymentRepository(db *pgxpool.Pool) *paymentRepository {
+	return &paymentRepository{
+		db: db,
+	}
 }
@@ -0,0 +1,28 @@
+package payment
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+)
+
+func (r *paymentRepository) UpdatePaymentStatus(ctx context.Context, transactionUUID string, status model.PaymentStatus) error {
+	query, args, err := sq.
+		Update("payments").
+		PlaceholderFormat(sq.Dollar).
+		Set("status", status).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"transaction_uuid": transactionUUID,
+			"status":           model.PaymentStatusPENDING,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	return err
+}
@@ -7,5 +7,24 @@ import (
 )
 
 type PaymentRepository interface {
+	// PayOrder stores the payment and returns its transaction UUID.
+	// It fails with ErrPaymentAlreadyExists if the order attempt was paid already,
+	// a declined payment does not use up the attempt.
 	PayOrder(ctx context.Context, payment *model.Payment) (string, error)
+	GetPayment(ctx context.Context, transactionUUID string) (*model.Payment, error)
+	// GetPaymentAttempt returns the payment of the order attempt, declined payments
+	// do not use up the attempt and are not returned.
+	GetPaymentAttempt(ctx context.Context, orderUUID, attemptKey string) (*model.Payment, error)
+	// UpdatePaymentStatus settles a pending payment with the status reported by the provider,
+	// a payment settled already is left as is.
+	UpdatePaymentStatus(ctx context.Context, transactionUUID string, status model.PaymentStatus) error
+	// ListPendingPayments returns up to limit pending payments ordered by transaction UUID,
+	// starting after the transaction UUID given.
+	ListPendingPayments(ctx context.Context, afterTransactionUUID string, limit uint64) ([]*model.Payment, error)
+	// CreateRefund stores the refund together with the payment it was applied to.
+	// It fails with ErrPaymentConflict if the payment was refunded since it was read
+	// or the refund key was used meanwhile.
+	CreateRefund(ctx context.Context, payment *model.Payment, refund *model.Refund) (string, error)
+	// GetRefund returns the refund stored for the key or ErrRefundNotFound.
+	GetRefund(ctx context.Context, transactionUUID, refundKey string) (*model.Refund, error)
 }
@@ -79,6 +79,121 @@ func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// RefundPayment provides a mock function with given fields: ctx, refund
+func (_m *PaymentService) RefundPayment(ctx context.Context, refund *model.Refund) (*model.Payment, error) {
+	ret := _m.Called(ctx, refund)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 *model.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Refund) (*model.Payment, error)); ok {
+		return rf(ctx, refund)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Refund) *model.Payment); ok {
+		r0 = rf(ctx, refund)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Refund) error); ok {
+		r1 = rf(ctx, refund)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentService_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refund *model.Refund
+func (_e *PaymentService_Expecter) RefundPayment(ctx interface{}, refund interface{}) *PaymentService_RefundPayment_Call {
+	return &PaymentService_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, refund)}
+}
+
+func (_c *PaymentService_RefundPayment_Call) Run(run func(ctx context.Context, refund *model.Refund)) *PaymentService_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Refund))
+	})
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) Return(_a0 *model.Payment, _a1 error) *PaymentService_RefundPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) RunAndReturn(run func(context.Context, *model.Refund) (*model.Payment, error)) *PaymentService_RefundPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SettlePendingPayments provides a mock function with given fields: ctx
+func (_m *PaymentService) SettlePendingPayments(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SettlePendingPayments")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_SettlePendingPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SettlePendingPayments'
+type PaymentService_SettlePendingPayments_Call struct {
+	*mock.Call
+}
+
+// SettlePendingPayments is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PaymentService_Expecter) SettlePendingPayments(ctx interface{}) *PaymentService_SettlePendingPayments_Call {
+	return &PaymentService_SettlePendingPayments_Call{Call: _e.mock.On("SettlePendingPayments", ctx)}
+}
+
+func (_c *PaymentService_SettlePendingPayments_Call) Run(run func(ctx context.Context)) *PaymentService_SettlePendingPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PaymentService_SettlePendingPayments_Call) Return(_a0 int, _a1 error) *PaymentService_SettlePendingPayments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_SettlePendingPayments_Call) RunAndReturn(run func(context.Context) (int, error)) *PaymentService_SettlePendingPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPaymentService creates a new instance of PaymentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPaymentService(t interface {
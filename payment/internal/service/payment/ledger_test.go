@@ -0,0 +1,55 @@
+package payment
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	"github.com/dexguitar/spacecraftory/payment/internal/repository/memory"
+)
+
+// TestLedgerPayAndRefund runs the service on the in-memory ledger: repeated attempts
+// and refund keys are answered from the ledger without calling the provider again
+func (s *ServiceSuite) TestLedgerPayAndRefund() {
+	s.service.paymentRepository = memory.NewPaymentRepository()
+
+	payment := &model.Payment{
+		OrderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+		AttemptKey:    "0",
+		UserUUID:      "123e4567-e89b-12d3-a456-426614174012",
+		Amount:        decimal.RequireFromString("1000.00"),
+		Currency:      model.CurrencyRUB,
+		PaymentMethod: model.PaymentMethodCARD,
+	}
+	repeat := *payment
+
+	s.provider.On("Charge", mock.Anything, payment).Return(model.PaymentStatusSUCCEEDED, nil).Once()
+	s.provider.On("Refund", mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, payment)
+	s.Require().NoError(err)
+
+	repeatedUUID, err := s.service.PayOrder(s.ctx, &repeat)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), transactionUUID, repeatedUUID)
+
+	partial := &model.Refund{TransactionUUID: transactionUUID, RefundKey: "1", Amount: decimal.RequireFromString("400.00")}
+	refunded, err := s.service.RefundPayment(s.ctx, partial)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), model.PaymentStatusPARTIALLY_REFUNDED, refunded.Status)
+
+	replayed := &model.Refund{TransactionUUID: transactionUUID, RefundKey: "1", Amount: decimal.RequireFromString("400.00")}
+	_, err = s.service.RefundPayment(s.ctx, replayed)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), partial.RefundUUID, replayed.RefundUUID)
+
+	rest := &model.Refund{TransactionUUID: transactionUUID, RefundKey: "2"}
+	refunded, err = s.service.RefundPayment(s.ctx, rest)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), model.PaymentStatusREFUNDED, refunded.Status)
+	assert.True(s.T(), rest.Amount.Equal(decimal.RequireFromString("600.00")))
+
+	_, err = s.service.RefundPayment(s.ctx, &model.Refund{TransactionUUID: transactionUUID, RefundKey: "3"})
+	assert.ErrorIs(s.T(), err, model.ErrPaymentNotRefundable)
+}
@@ -2,15 +2,159 @@ package payment
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	"github.com/dexguitar/spacecraftory/payment/internal/provider"
 )
 
 func (s *service) PayOrder(ctx context.Context, payment *model.Payment) (string, error) {
+	if err := s.validateAmount(payment); err != nil {
+		return "", err
+	}
+
+	paymentProvider, ok := s.providers[payment.PaymentMethod]
+	if !ok {
+		return "", model.ErrBadRequest
+	}
+
+	// a repeated attempt is answered from the ledger without charging again
+	existing, err := s.paymentRepository.GetPaymentAttempt(ctx, payment.OrderUUID, payment.AttemptKey)
+	if err == nil {
+		return s.replayAttempt(ctx, paymentProvider, existing, payment)
+	}
+	if !errors.Is(err, model.ErrPaymentNotFound) {
+		return "", err
+	}
+
+	status, err := s.charge(ctx, paymentProvider, payment)
+	if err != nil {
+		return "", err
+	}
+	payment.Status = status
+
 	transactionUUID, err := s.paymentRepository.PayOrder(ctx, payment)
+	if errors.Is(err, model.ErrPaymentAlreadyExists) {
+		// a concurrent repeat of the attempt was stored first
+		existing, err := s.paymentRepository.GetPaymentAttempt(ctx, payment.OrderUUID, payment.AttemptKey)
+		if err != nil {
+			return "", err
+		}
+		return s.replayAttempt(ctx, paymentProvider, existing, payment)
+	}
 	if err != nil {
 		// TODO: later will add db error check and map to service errors
 		return "", err
 	}
+
+	// the payment is recorded, a retry of the attempt settles it once the provider confirms it
+	if status == model.PaymentStatusPENDING {
+		return "", model.ErrPaymentPending
+	}
 	return transactionUUID, nil
 }
+
+// replayAttempt answers a repeated attempt with the transaction it created,
+// unless the repeat carries different payment details.
+func (s *service) replayAttempt(ctx context.Context, paymentProvider provider.PaymentProvider, existing, payment *model.Payment) (string, error) {
+	if !existing.SameAttempt(payment) {
+		return "", model.ErrPaymentAlreadyExists
+	}
+	if existing.Status == model.PaymentStatusPENDING {
+		return s.settlePending(ctx, paymentProvider, existing)
+	}
+	return existing.TransactionUUID, nil
+}
+
+// settlePending asks the provider about a pending payment and records the answer.
+// A declined payment frees the attempt, so the order may be charged with it again.
+// A charge the provider has no record of was never made and counts as declined.
+// The settlement is published before it is recorded, so order hears about every
+// settled payment at least once even if recording it fails.
+func (s *service) settlePending(ctx context.Context, paymentProvider provider.PaymentProvider, payment *model.Payment) (string, error) {
+	status, err := s.chargeStatus(ctx, paymentProvider, payment)
+	if errors.Is(err, model.ErrPaymentDeclined) || errors.Is(err, model.ErrChargeNotFound) {
+		if err := s.settle(ctx, payment, model.PaymentStatusDECLINED); err != nil {
+			return "", err
+		}
+		return "", model.ErrPaymentDeclined
+	}
+	if err != nil {
+		return "", err
+	}
+	if status == model.PaymentStatusPENDING {
+		return "", model.ErrPaymentPending
+	}
+
+	if err := s.settle(ctx, payment, status); err != nil {
+		return "", err
+	}
+	return payment.TransactionUUID, nil
+}
+
+func (s *service) settle(ctx context.Context, payment *model.Payment, status model.PaymentStatus) error {
+	if err := s.publishSettled(ctx, payment, status); err != nil {
+		return err
+	}
+
+	return s.paymentRepository.UpdatePaymentStatus(ctx, payment.TransactionUUID, status)
+}
+
+func (s *service) publishSettled(ctx context.Context, payment *model.Payment, status model.PaymentStatus) error {
+	payload, err := s.settledEncoder.Encode(model.PaymentSettledEvent{
+		EventUUID:       payment.TransactionUUID,
+		OrderUUID:       payment.OrderUUID,
+		TransactionUUID: payment.TransactionUUID,
+		AttemptKey:      payment.AttemptKey,
+		Status:          status,
+		PaymentMethod:   payment.PaymentMethod,
+		Amount:          payment.Amount,
+		Currency:        payment.Currency,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.settledProducer.Send(ctx, []byte(payment.OrderUUID), payload); err != nil {
+		return fmt.Errorf("failed to publish settled payment: %w", err)
+	}
+	return nil
+}
+
+// charge charges the payment, a provider that does not answer in time fails with ErrProviderTimeout
+func (s *service) charge(ctx context.Context, paymentProvider provider.PaymentProvider, payment *model.Payment) (model.PaymentStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.providerTimeout)
+	defer cancel()
+
+	status, err := paymentProvider.Charge(ctx, payment)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "", model.ErrProviderTimeout
+	}
+	return status, err
+}
+
+// chargeStatus asks the provider about a pending charge, a provider that does not answer
+// in time fails with ErrProviderTimeout
+func (s *service) chargeStatus(ctx context.Context, paymentProvider provider.PaymentProvider, payment *model.Payment) (model.PaymentStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.providerTimeout)
+	defer cancel()
+
+	status, err := paymentProvider.ChargeStatus(ctx, payment)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "", model.ErrProviderTimeout
+	}
+	return status, err
+}
+
+func (s *service) validateAmount(payment *model.Payment) error {
+	if !payment.Amount.IsPositive() || payment.Currency != model.CurrencyRUB {
+		return model.ErrBadRequest
+	}
+
+	if limit, ok := s.limits[payment.PaymentMethod]; ok && payment.Amount.GreaterThan(limit) {
+		return model.ErrAmountLimitExceeded
+	}
+
+	return nil
+}
@@ -1,8 +1,10 @@
 package payment
 
 import (
+	"context"
 	"errors"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -47,13 +49,6 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 			paymentMethod:   model.PaymentMethodINVESTOR_MONEY,
 			expectedTxnUUID: "txn-investor-abc",
 		},
-		{
-			name:            "Payment with UNKNOWN method",
-			orderUUID:       "123e4567-e89b-12d3-a456-426614174004",
-			userUUID:        "123e4567-e89b-12d3-a456-426614174016",
-			paymentMethod:   model.PaymentMethodUNKNOWN,
-			expectedTxnUUID: "txn-unknown-xyz",
-		},
 	}
 
 	for _, tc := range testCases {
@@ -61,9 +56,14 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 			payment := &model.Payment{
 				OrderUUID:     tc.orderUUID,
 				UserUUID:      tc.userUUID,
+				Amount:        decimal.RequireFromString("150000.00"),
+				Currency:      model.CurrencyRUB,
 				PaymentMethod: tc.paymentMethod,
 			}
 
+			s.expectNewAttempt(payment)
+			s.provider.On("Charge", mock.Anything, payment).
+				Return(model.PaymentStatusSUCCEEDED, nil).Once()
 			s.paymentRepo.On("PayOrder", s.ctx, payment).
 				Return(tc.expectedTxnUUID, nil).Once()
 
@@ -71,22 +71,389 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 
 			s.Require().NoError(err)
 			assert.Equal(s.T(), tc.expectedTxnUUID, transactionUUID)
+			assert.Equal(s.T(), model.PaymentStatusSUCCEEDED, payment.Status)
 		})
 	}
 }
 
+// expectNewAttempt makes the payment the first one of its order attempt
+func (s *ServiceSuite) expectNewAttempt(payment *model.Payment) {
+	s.paymentRepo.On("GetPaymentAttempt", s.ctx, payment.OrderUUID, payment.AttemptKey).
+		Return(nil, model.ErrPaymentNotFound).Once()
+}
+
 func (s *ServiceSuite) TestPayOrderError() {
+	payment := &model.Payment{
+		OrderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+		UserUUID:      "123e4567-e89b-12d3-a456-426614174012",
+		Amount:        decimal.RequireFromString("150000.00"),
+		Currency:      model.CurrencyRUB,
+		PaymentMethod: model.PaymentMethodCARD,
+	}
+
+	s.expectNewAttempt(payment)
+	s.provider.On("Charge", mock.Anything, payment).
+		Return(model.PaymentStatusSUCCEEDED, nil).Once()
 	s.paymentRepo.On("PayOrder", s.ctx, mock.Anything).
 		Return("", ErrPaymentFailed).Once()
 
+	transactionUUID, err := s.service.PayOrder(s.ctx, payment)
+
+	assert.ErrorIs(s.T(), err, ErrPaymentFailed)
+	assert.Empty(s.T(), transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderRepeatedAttempt() {
+	original := &model.Payment{
+		TransactionUUID: "txn-123",
+		OrderUUID:       "123e4567-e89b-12d3-a456-426614174000",
+		AttemptKey:      "3",
+		UserUUID:        "123e4567-e89b-12d3-a456-426614174012",
+		Amount:          decimal.RequireFromString("150000.00"),
+		Currency:        model.CurrencyRUB,
+		PaymentMethod:   model.PaymentMethodCARD,
+		Status:          model.PaymentStatusSUCCEEDED,
+	}
+
+	testCases := []struct {
+		name            string
+		paymentMethod   model.PaymentMethod
+		amount          string
+		expectedTxnUUID string
+		expectedErr     error
+	}{
+		{
+			name:            "Same details return the original transaction",
+			paymentMethod:   model.PaymentMethodCARD,
+			amount:          "150000",
+			expectedTxnUUID: "txn-123",
+		},
+		{
+			name:          "Different payment method",
+			paymentMethod: model.PaymentMethodSBP,
+			amount:        "150000.00",
+			expectedErr:   model.ErrPaymentAlreadyExists,
+		},
+		{
+			name:          "Different amount",
+			paymentMethod: model.PaymentMethodCARD,
+			amount:        "149999.99",
+			expectedErr:   model.ErrPaymentAlreadyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			payment := &model.Payment{
+				OrderUUID:     original.OrderUUID,
+				AttemptKey:    original.AttemptKey,
+				UserUUID:      original.UserUUID,
+				Amount:        decimal.RequireFromString(tc.amount),
+				Currency:      model.CurrencyRUB,
+				PaymentMethod: tc.paymentMethod,
+			}
+
+			s.paymentRepo.On("GetPaymentAttempt", s.ctx, original.OrderUUID, original.AttemptKey).
+				Return(original, nil).Once()
+
+			transactionUUID, err := s.service.PayOrder(s.ctx, payment)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(s.T(), err, tc.expectedErr)
+				assert.Empty(s.T(), transactionUUID)
+				return
+			}
+			s.Require().NoError(err)
+			assert.Equal(s.T(), tc.expectedTxnUUID, transactionUUID)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestPayOrderConcurrentAttempt() {
 	payment := &model.Payment{
 		OrderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+		AttemptKey:    "3",
 		UserUUID:      "123e4567-e89b-12d3-a456-426614174012",
+		Amount:        decimal.RequireFromString("150000.00"),
+		Currency:      model.CurrencyRUB,
 		PaymentMethod: model.PaymentMethodCARD,
 	}
+	stored := &model.Payment{
+		TransactionUUID: "txn-123",
+		OrderUUID:       payment.OrderUUID,
+		AttemptKey:      payment.AttemptKey,
+		UserUUID:        payment.UserUUID,
+		Amount:          payment.Amount,
+		Currency:        payment.Currency,
+		PaymentMethod:   payment.PaymentMethod,
+		Status:          model.PaymentStatusSUCCEEDED,
+	}
+
+	// the repeat stored its payment between the lookup and the insert
+	s.expectNewAttempt(payment)
+	s.provider.On("Charge", mock.Anything, payment).
+		Return(model.PaymentStatusSUCCEEDED, nil).Once()
+	s.paymentRepo.On("PayOrder", s.ctx, payment).
+		Return("", model.ErrPaymentAlreadyExists).Once()
+	s.paymentRepo.On("GetPaymentAttempt", s.ctx, payment.OrderUUID, payment.AttemptKey).
+		Return(stored, nil).Once()
 
 	transactionUUID, err := s.service.PayOrder(s.ctx, payment)
 
-	assert.ErrorIs(s.T(), err, ErrPaymentFailed)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "txn-123", transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderMethodWithoutProvider() {
+	payment := &model.Payment{
+		OrderUUID:     "123e4567-e89b-12d3-a456-426614174004",
+		UserUUID:      "123e4567-e89b-12d3-a456-426614174016",
+		Amount:        decimal.RequireFromString("150000.00"),
+		Currency:      model.CurrencyRUB,
+		PaymentMethod: model.PaymentMethodUNKNOWN,
+	}
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, payment)
+
+	assert.ErrorIs(s.T(), err, model.ErrBadRequest)
 	assert.Empty(s.T(), transactionUUID)
 }
+
+func (s *ServiceSuite) TestPayOrderProviderFailure() {
+	testCases := []struct {
+		name          string
+		chargeStatus  model.PaymentStatus
+		chargeErr     error
+		stored        bool
+		expectedError error
+	}{
+		{
+			name:          "Charge declined",
+			chargeErr:     model.ErrPaymentDeclined,
+			expectedError: model.ErrPaymentDeclined,
+		},
+		{
+			name:          "Provider timed out",
+			chargeErr:     context.DeadlineExceeded,
+			expectedError: model.ErrProviderTimeout,
+		},
+		{
+			name:          "Charge awaits confirmation",
+			chargeStatus:  model.PaymentStatusPENDING,
+			stored:        true,
+			expectedError: model.ErrPaymentPending,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			payment := &model.Payment{
+				OrderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+				AttemptKey:    "3",
+				UserUUID:      "123e4567-e89b-12d3-a456-426614174012",
+				Amount:        decimal.RequireFromString("150000.00"),
+				Currency:      model.CurrencyRUB,
+				PaymentMethod: model.PaymentMethodSBP,
+			}
+
+			s.expectNewAttempt(payment)
+			s.provider.On("Charge", mock.Anything, payment).
+				Return(tc.chargeStatus, tc.chargeErr).Once()
+			// only a pending charge is recorded, a failed one leaves nothing to replay
+			if tc.stored {
+				s.paymentRepo.On("PayOrder", s.ctx, payment).
+					Return("txn-123", nil).Once()
+			}
+
+			transactionUUID, err := s.service.PayOrder(s.ctx, payment)
+
+			assert.ErrorIs(s.T(), err, tc.expectedError)
+			assert.Empty(s.T(), transactionUUID)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestPayOrderSettlePending() {
+	testCases := []struct {
+		name            string
+		providerStatus  model.PaymentStatus
+		providerErr     error
+		settledStatus   model.PaymentStatus
+		expectedTxnUUID string
+		expectedError   error
+	}{
+		{
+			name:            "Charge confirmed",
+			providerStatus:  model.PaymentStatusSUCCEEDED,
+			settledStatus:   model.PaymentStatusSUCCEEDED,
+			expectedTxnUUID: "txn-123",
+		},
+		{
+			name:           "Charge still pending",
+			providerStatus: model.PaymentStatusPENDING,
+			expectedError:  model.ErrPaymentPending,
+		},
+		{
+			name:          "Charge declined on confirmation",
+			providerErr:   model.ErrPaymentDeclined,
+			settledStatus: model.PaymentStatusDECLINED,
+			expectedError: model.ErrPaymentDeclined,
+		},
+		{
+			name:          "Charge unknown to the provider",
+			providerErr:   model.ErrChargeNotFound,
+			settledStatus: model.PaymentStatusDECLINED,
+			expectedError: model.ErrPaymentDeclined,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.producer.events = nil
+			pending := &model.Payment{
+				TransactionUUID: "txn-123",
+				OrderUUID:       "123e4567-e89b-12d3-a456-426614174000",
+				AttemptKey:      "3",
+				UserUUID:        "123e4567-e89b-12d3-a456-426614174012",
+				Amount:          decimal.RequireFromString("150000.00"),
+				Currency:        model.CurrencyRUB,
+				PaymentMethod:   model.PaymentMethodSBP,
+				Status:          model.PaymentStatusPENDING,
+			}
+			payment := &model.Payment{
+				OrderUUID:     pending.OrderUUID,
+				AttemptKey:    pending.AttemptKey,
+				UserUUID:      pending.UserUUID,
+				Amount:        pending.Amount,
+				Currency:      pending.Currency,
+				PaymentMethod: pending.PaymentMethod,
+			}
+
+			s.paymentRepo.On("GetPaymentAttempt", s.ctx, pending.OrderUUID, pending.AttemptKey).
+				Return(pending, nil).Once()
+			s.provider.On("ChargeStatus", mock.Anything, pending).
+				Return(tc.providerStatus, tc.providerErr).Once()
+			if tc.settledStatus != "" {
+				s.paymentRepo.On("UpdatePaymentStatus", s.ctx, pending.TransactionUUID, tc.settledStatus).
+					Return(nil).Once()
+			}
+
+			transactionUUID, err := s.service.PayOrder(s.ctx, payment)
+
+			if tc.settledStatus != "" {
+				assert.Equal(s.T(), map[string]string{pending.TransactionUUID: string(tc.settledStatus)}, s.producer.settledStatuses())
+			} else {
+				assert.Empty(s.T(), s.producer.events)
+			}
+			if tc.expectedError != nil {
+				assert.ErrorIs(s.T(), err, tc.expectedError)
+				assert.Empty(s.T(), transactionUUID)
+				return
+			}
+			s.Require().NoError(err)
+			assert.Equal(s.T(), tc.expectedTxnUUID, transactionUUID)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestPayOrderAmountLimit() {
+	testCases := []struct {
+		name          string
+		amount        string
+		paymentMethod model.PaymentMethod
+		expectedErr   error
+	}{
+		{
+			name:          "Amount equal to the limit",
+			amount:        "500000.00",
+			paymentMethod: model.PaymentMethodCREDIT_CARD,
+		},
+		{
+			name:          "Amount above the limit",
+			amount:        "500000.01",
+			paymentMethod: model.PaymentMethodCREDIT_CARD,
+			expectedErr:   model.ErrAmountLimitExceeded,
+		},
+		{
+			name:          "Method without a limit",
+			amount:        "5000000.00",
+			paymentMethod: model.PaymentMethodINVESTOR_MONEY,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			payment := &model.Payment{
+				OrderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+				UserUUID:      "123e4567-e89b-12d3-a456-426614174012",
+				Amount:        decimal.RequireFromString(tc.amount),
+				Currency:      model.CurrencyRUB,
+				PaymentMethod: tc.paymentMethod,
+			}
+
+			if tc.expectedErr == nil {
+				s.expectNewAttempt(payment)
+				s.provider.On("Charge", mock.Anything, payment).
+					Return(model.PaymentStatusSUCCEEDED, nil).Once()
+				s.paymentRepo.On("PayOrder", s.ctx, payment).
+					Return("txn-123", nil).Once()
+			}
+
+			transactionUUID, err := s.service.PayOrder(s.ctx, payment)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(s.T(), err, tc.expectedErr)
+				assert.Empty(s.T(), transactionUUID)
+				return
+			}
+			s.Require().NoError(err)
+			assert.Equal(s.T(), "txn-123", transactionUUID)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestPayOrderInvalidAmount() {
+	testCases := []struct {
+		name     string
+		amount   decimal.Decimal
+		currency string
+	}{
+		{
+			name:     "Zero amount",
+			amount:   decimal.Zero,
+			currency: model.CurrencyRUB,
+		},
+		{
+			name:     "Negative amount",
+			amount:   decimal.RequireFromString("-10.00"),
+			currency: model.CurrencyRUB,
+		},
+		{
+			name:     "Unsupported currency",
+			amount:   decimal.RequireFromString("150000.00"),
+			currency: "USD",
+		},
+		{
+			name:     "Missing currency",
+			amount:   decimal.RequireFromString("150000.00"),
+			currency: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			payment := &model.Payment{
+				OrderUUID:     "123e4567-e89b-12d3-a456-426614174000",
+				UserUUID:      "123e4567-e89b-12d3-a456-426614174012",
+				Amount:        tc.amount,
+				Currency:      tc.currency,
+				PaymentMethod: model.PaymentMethodCARD,
+			}
+
+			transactionUUID, err := s.service.PayOrder(s.ctx, payment)
+
+			assert.ErrorIs(s.T(), err, model.ErrBadRequest)
+			assert.Empty(s.T(), transactionUUID)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	"github.com/dexguitar/spacecraftory/payment/internal/provider"
+)
+
+// RefundPayment refunds the payment of refund.TransactionUUID, a zero amount refunds
+// everything left on it. The refund is completed with its UUID, amount and currency,
+// the payment is returned as it is after the refund.
+//
+// The money is returned through the provider of the payment before the refund is
+// recorded. A refund key that was used already gets the refund stored for it, and
+// providers refund a key once, so a retried request never returns money twice.
+func (s *service) RefundPayment(ctx context.Context, refund *model.Refund) (*model.Payment, error) {
+	if refund.Amount.IsNegative() || refund.RefundKey == "" {
+		return nil, model.ErrBadRequest
+	}
+
+	payment, err := s.replayRefund(ctx, refund)
+	if !errors.Is(err, model.ErrRefundNotFound) {
+		return payment, err
+	}
+
+	payment, err = s.paymentRepository.GetPayment(ctx, refund.TransactionUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentProvider, ok := s.providers[payment.PaymentMethod]
+	if !ok {
+		return nil, fmt.Errorf("no payment provider for method %s", payment.PaymentMethod)
+	}
+
+	if err := payment.ApplyRefund(refund); err != nil {
+		return nil, err
+	}
+
+	if err := s.refund(ctx, paymentProvider, payment, refund); err != nil {
+		return nil, err
+	}
+
+	refundUUID, err := s.paymentRepository.CreateRefund(ctx, payment, refund)
+	if err != nil {
+		// a concurrent request with the same key may have stored its refund first
+		if errors.Is(err, model.ErrPaymentConflict) {
+			if payment, replayErr := s.replayRefund(ctx, refund); !errors.Is(replayErr, model.ErrRefundNotFound) {
+				return payment, replayErr
+			}
+		}
+		return nil, err
+	}
+	refund.RefundUUID = refundUUID
+
+	return payment, nil
+}
+
+// refund returns the money through the provider, a provider that does not answer
+// in time fails with ErrProviderTimeout
+func (s *service) refund(ctx context.Context, paymentProvider provider.PaymentProvider, payment *model.Payment, refund *model.Refund) error {
+	ctx, cancel := context.WithTimeout(ctx, s.providerTimeout)
+	defer cancel()
+
+	err := paymentProvider.Refund(ctx, payment, refund)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return model.ErrProviderTimeout
+	}
+	return err
+}
+
+// replayRefund completes the refund with the one stored for its key and returns the
+// payment as it is now. It fails with ErrRefundNotFound if the key was not used yet
+// and with ErrRefundKeyMismatch if it was used for another amount.
+func (s *service) replayRefund(ctx context.Context, refund *model.Refund) (*model.Payment, error) {
+	stored, err := s.paymentRepository.GetRefund(ctx, refund.TransactionUUID, refund.RefundKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// a zero amount asked for everything left, which is what the stored refund took
+	if !refund.Amount.IsZero() && !refund.Amount.Equal(stored.Amount) {
+		return nil, model.ErrRefundKeyMismatch
+	}
+
+	payment, err := s.paymentRepository.GetPayment(ctx, refund.TransactionUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	*refund = *stored
+
+	return payment, nil
+}
@@ -0,0 +1,341 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+)
+
+const (
+	refundTransactionUUID = "123e4567-e89b-12d3-a456-426614174100"
+	refundKey             = "3"
+)
+
+func (s *ServiceSuite) refundablePayment(status model.PaymentStatus, refunded string) *model.Payment {
+	return &model.Payment{
+		TransactionUUID: refundTransactionUUID,
+		OrderUUID:       "123e4567-e89b-12d3-a456-426614174000",
+		UserUUID:        "123e4567-e89b-12d3-a456-426614174012",
+		Amount:          decimal.RequireFromString("1000.00"),
+		Currency:        model.CurrencyRUB,
+		RefundedAmount:  decimal.RequireFromString(refunded),
+		PaymentMethod:   model.PaymentMethodCARD,
+		Status:          status,
+	}
+}
+
+func (s *ServiceSuite) TestRefundPaymentSuccess() {
+	testCases := []struct {
+		name             string
+		status           model.PaymentStatus
+		refunded         string
+		amount           string
+		expectedAmount   string
+		expectedRefunded string
+		expectedStatus   model.PaymentStatus
+	}{
+		{
+			name:             "Partial refund",
+			status:           model.PaymentStatusSUCCEEDED,
+			refunded:         "0",
+			amount:           "250.50",
+			expectedAmount:   "250.50",
+			expectedRefunded: "250.50",
+			expectedStatus:   model.PaymentStatusPARTIALLY_REFUNDED,
+		},
+		{
+			name:             "Full refund",
+			status:           model.PaymentStatusSUCCEEDED,
+			refunded:         "0",
+			amount:           "1000.00",
+			expectedAmount:   "1000.00",
+			expectedRefunded: "1000.00",
+			expectedStatus:   model.PaymentStatusREFUNDED,
+		},
+		{
+			name:             "Remaining amount of a partially refunded payment",
+			status:           model.PaymentStatusPARTIALLY_REFUNDED,
+			refunded:         "250.50",
+			amount:           "749.50",
+			expectedAmount:   "749.50",
+			expectedRefunded: "1000.00",
+			expectedStatus:   model.PaymentStatusREFUNDED,
+		},
+		{
+			name:             "Empty amount refunds the rest",
+			status:           model.PaymentStatusPARTIALLY_REFUNDED,
+			refunded:         "250.50",
+			amount:           "0",
+			expectedAmount:   "749.50",
+			expectedRefunded: "1000.00",
+			expectedStatus:   model.PaymentStatusREFUNDED,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+				Return(nil, model.ErrRefundNotFound).Once()
+			s.paymentRepo.On("GetPayment", s.ctx, refundTransactionUUID).
+				Return(s.refundablePayment(tc.status, tc.refunded), nil).Once()
+			providerRefund := s.provider.On("Refund", mock.Anything, mock.Anything,
+				mock.MatchedBy(func(refund *model.Refund) bool {
+					return refund.Amount.Equal(decimal.RequireFromString(tc.expectedAmount)) &&
+						refund.RefundKey == refundKey
+				}),
+			).Return(nil).Once()
+
+			s.paymentRepo.On("CreateRefund", s.ctx,
+				mock.MatchedBy(func(payment *model.Payment) bool {
+					return payment.Status == tc.expectedStatus &&
+						payment.RefundedAmount.Equal(decimal.RequireFromString(tc.expectedRefunded))
+				}),
+				mock.MatchedBy(func(refund *model.Refund) bool {
+					return refund.Amount.Equal(decimal.RequireFromString(tc.expectedAmount)) &&
+						refund.Currency == model.CurrencyRUB &&
+						refund.RefundKey == refundKey
+				}),
+			).Return("refund-123", nil).Once().NotBefore(providerRefund)
+
+			refund := &model.Refund{
+				TransactionUUID: refundTransactionUUID,
+				RefundKey:       refundKey,
+				Amount:          decimal.RequireFromString(tc.amount),
+				Reason:          "customer request",
+			}
+
+			payment, err := s.service.RefundPayment(s.ctx, refund)
+
+			s.Require().NoError(err)
+			assert.Equal(s.T(), "refund-123", refund.RefundUUID)
+			assert.Equal(s.T(), tc.expectedStatus, payment.Status)
+			assert.True(s.T(), payment.RefundedAmount.Equal(decimal.RequireFromString(tc.expectedRefunded)))
+		})
+	}
+}
+
+func (s *ServiceSuite) TestRefundPaymentError() {
+	errDatabase := errors.New("database connection failed")
+
+	testCases := []struct {
+		name          string
+		amount        string
+		withoutKey    bool
+		mockSetup     func()
+		expectedError error
+	}{
+		{
+			name:          "Negative amount",
+			amount:        "-1.00",
+			mockSetup:     func() {},
+			expectedError: model.ErrBadRequest,
+		},
+		{
+			name:          "Missing refund key",
+			amount:        "100.00",
+			withoutKey:    true,
+			mockSetup:     func() {},
+			expectedError: model.ErrBadRequest,
+		},
+		{
+			name:   "Payment not found",
+			amount: "100.00",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(nil, model.ErrRefundNotFound).Once()
+				s.paymentRepo.On("GetPayment", s.ctx, refundTransactionUUID).
+					Return(nil, model.ErrPaymentNotFound).Once()
+			},
+			expectedError: model.ErrPaymentNotFound,
+		},
+		{
+			name:   "Amount above what is left",
+			amount: "800.00",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(nil, model.ErrRefundNotFound).Once()
+				s.paymentRepo.On("GetPayment", s.ctx, refundTransactionUUID).
+					Return(s.refundablePayment(model.PaymentStatusPARTIALLY_REFUNDED, "250.50"), nil).Once()
+			},
+			expectedError: model.ErrRefundExceedsPayment,
+		},
+		{
+			name:   "Payment already refunded",
+			amount: "0",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(nil, model.ErrRefundNotFound).Once()
+				s.paymentRepo.On("GetPayment", s.ctx, refundTransactionUUID).
+					Return(s.refundablePayment(model.PaymentStatusREFUNDED, "1000.00"), nil).Once()
+			},
+			expectedError: model.ErrPaymentNotRefundable,
+		},
+		{
+			name:   "Payment refunded concurrently",
+			amount: "100.00",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(nil, model.ErrRefundNotFound).Once()
+				s.paymentRepo.On("GetPayment", s.ctx, refundTransactionUUID).
+					Return(s.refundablePayment(model.PaymentStatusSUCCEEDED, "0"), nil).Once()
+				s.provider.On("Refund", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).Once()
+				s.paymentRepo.On("CreateRefund", s.ctx, mock.Anything, mock.Anything).
+					Return("", model.ErrPaymentConflict).Once()
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(nil, model.ErrRefundNotFound).Once()
+			},
+			expectedError: model.ErrPaymentConflict,
+		},
+		{
+			name:   "Provider fails the refund",
+			amount: "100.00",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(nil, model.ErrRefundNotFound).Once()
+				s.paymentRepo.On("GetPayment", s.ctx, refundTransactionUUID).
+					Return(s.refundablePayment(model.PaymentStatusSUCCEEDED, "0"), nil).Once()
+				s.provider.On("Refund", mock.Anything, mock.Anything, mock.Anything).
+					Return(ErrPaymentFailed).Once()
+			},
+			expectedError: ErrPaymentFailed,
+		},
+		{
+			name:   "Provider timeout",
+			amount: "100.00",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(nil, model.ErrRefundNotFound).Once()
+				s.paymentRepo.On("GetPayment", s.ctx, refundTransactionUUID).
+					Return(s.refundablePayment(model.PaymentStatusSUCCEEDED, "0"), nil).Once()
+				s.provider.On("Refund", mock.Anything, mock.Anything, mock.Anything).
+					Return(context.DeadlineExceeded).Once()
+			},
+			expectedError: model.ErrProviderTimeout,
+		},
+		{
+			name:   "Refund key used for another amount",
+			amount: "100.00",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(&model.Refund{
+						RefundUUID:      "refund-123",
+						TransactionUUID: refundTransactionUUID,
+						RefundKey:       refundKey,
+						Amount:          decimal.RequireFromString("250.50"),
+						Currency:        model.CurrencyRUB,
+					}, nil).Once()
+			},
+			expectedError: model.ErrRefundKeyMismatch,
+		},
+		{
+			name:   "Repository error",
+			amount: "100.00",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(nil, model.ErrRefundNotFound).Once()
+				s.paymentRepo.On("GetPayment", s.ctx, refundTransactionUUID).
+					Return(nil, errDatabase).Once()
+			},
+			expectedError: errDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.mockSetup()
+
+			refund := &model.Refund{
+				TransactionUUID: refundTransactionUUID,
+				RefundKey:       refundKey,
+				Amount:          decimal.RequireFromString(tc.amount),
+			}
+			if tc.withoutKey {
+				refund.RefundKey = ""
+			}
+
+			payment, err := s.service.RefundPayment(s.ctx, refund)
+
+			assert.ErrorIs(s.T(), err, tc.expectedError)
+			assert.Nil(s.T(), payment)
+			assert.Empty(s.T(), refund.RefundUUID)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestRefundPaymentReplay() {
+	stored := &model.Refund{
+		RefundUUID:      "refund-123",
+		TransactionUUID: refundTransactionUUID,
+		RefundKey:       refundKey,
+		Amount:          decimal.RequireFromString("250.50"),
+		Currency:        model.CurrencyRUB,
+		Reason:          "customer request",
+	}
+
+	testCases := []struct {
+		name      string
+		amount    string
+		mockSetup func()
+	}{
+		{
+			name:   "Repeated key with the same amount",
+			amount: "250.50",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(stored, nil).Once()
+			},
+		},
+		{
+			name:   "Repeated key with an empty amount",
+			amount: "0",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(stored, nil).Once()
+			},
+		},
+		{
+			name:   "Concurrent request with the same key stored first",
+			amount: "250.50",
+			mockSetup: func() {
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(nil, model.ErrRefundNotFound).Once()
+				s.paymentRepo.On("GetPayment", s.ctx, refundTransactionUUID).
+					Return(s.refundablePayment(model.PaymentStatusSUCCEEDED, "0"), nil).Once()
+				s.provider.On("Refund", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).Once()
+				s.paymentRepo.On("CreateRefund", s.ctx, mock.Anything, mock.Anything).
+					Return("", model.ErrPaymentConflict).Once()
+				s.paymentRepo.On("GetRefund", s.ctx, refundTransactionUUID, refundKey).
+					Return(stored, nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.mockSetup()
+			// the payment as it is after the stored refund
+			s.paymentRepo.On("GetPayment", s.ctx, refundTransactionUUID).
+				Return(s.refundablePayment(model.PaymentStatusPARTIALLY_REFUNDED, "250.50"), nil).Once()
+
+			refund := &model.Refund{
+				TransactionUUID: refundTransactionUUID,
+				RefundKey:       refundKey,
+				Amount:          decimal.RequireFromString(tc.amount),
+			}
+
+			payment, err := s.service.RefundPayment(s.ctx, refund)
+
+			s.Require().NoError(err)
+			assert.Equal(s.T(), stored, refund)
+			assert.Equal(s.T(), model.PaymentStatusPARTIALLY_REFUNDED, payment.Status)
+			assert.True(s.T(), payment.RefundedAmount.Equal(decimal.RequireFromString("250.50")))
+		})
+	}
+}
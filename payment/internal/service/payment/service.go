@@ -1,13 +1,44 @@
 package payment
 
 import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	kafkaConverter "github.com/dexguitar/spacecraftory/payment/internal/converter/kafka"
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	"github.com/dexguitar/spacecraftory/payment/internal/provider"
 	"github.com/dexguitar/spacecraftory/payment/internal/repository"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
 )
 
 type service struct {
 	paymentRepository repository.PaymentRepository
+	// providers charges payments per method, a method without a provider cannot be paid with
+	providers map[model.PaymentMethod]provider.PaymentProvider
+	// providerTimeout bounds every call to a provider
+	providerTimeout time.Duration
+	// limits caps a single payment per method, methods without an entry are not capped
+	limits map[model.PaymentMethod]decimal.Decimal
+	// settledProducer announces pending payments once they settle, so order learns about them
+	settledProducer kafka.Producer
+	settledEncoder  kafkaConverter.PaymentSettledEncoder
 }
 
-func NewService(paymentRepository repository.PaymentRepository) *service {
-	return &service{paymentRepository: paymentRepository}
+func NewService(
+	paymentRepository repository.PaymentRepository,
+	providers map[model.PaymentMethod]provider.PaymentProvider,
+	providerTimeout time.Duration,
+	limits map[model.PaymentMethod]decimal.Decimal,
+	settledProducer kafka.Producer,
+	settledEncoder kafkaConverter.PaymentSettledEncoder,
+) *service {
+	return &service{
+		paymentRepository: paymentRepository,
+		providers:         providers,
+		providerTimeout:   providerTimeout,
+		limits:            limits,
+		settledProducer:   settledProducer,
+		settledEncoder:    settledEncoder,
+	}
 }
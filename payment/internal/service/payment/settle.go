@@ -0,0 +1,55 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+)
+
+// settleBatchSize limits how many pending payments are read per query
+const settleBatchSize = 100
+
+// SettlePendingPayments asks the providers about every pending payment and records
+// their answers, so a charge confirmed asynchronously settles without the order
+// being paid again. It returns how many payments were settled.
+func (s *service) SettlePendingPayments(ctx context.Context) (int, error) {
+	settled := 0
+	after := ""
+	for {
+		payments, err := s.paymentRepository.ListPendingPayments(ctx, after, settleBatchSize)
+		if err != nil {
+			return settled, err
+		}
+
+		for _, payment := range payments {
+			after = payment.TransactionUUID
+
+			paymentProvider, ok := s.providers[payment.PaymentMethod]
+			if !ok {
+				continue
+			}
+
+			_, err := s.settlePending(ctx, paymentProvider, payment)
+			switch {
+			case err == nil, errors.Is(err, model.ErrPaymentDeclined):
+				settled++
+			case errors.Is(err, model.ErrPaymentPending):
+				// still awaiting confirmation, checked again on the next pass
+			default:
+				// one provider failing must not hold back the others
+				logger.Error(ctx, "failed to settle pending payment",
+					zap.String("transaction_uuid", payment.TransactionUUID),
+					zap.Error(err),
+				)
+			}
+		}
+
+		if len(payments) < settleBatchSize {
+			return settled, nil
+		}
+	}
+}
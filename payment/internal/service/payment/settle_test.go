@@ -0,0 +1,135 @@
+package payment
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+)
+
+func pendingPayment(transactionUUID string) *model.Payment {
+	return &model.Payment{
+		TransactionUUID: transactionUUID,
+		OrderUUID:       "123e4567-e89b-12d3-a456-426614174000",
+		AttemptKey:      "3",
+		UserUUID:        "123e4567-e89b-12d3-a456-426614174012",
+		Amount:          decimal.RequireFromString("150000.00"),
+		Currency:        model.CurrencyRUB,
+		PaymentMethod:   model.PaymentMethodSBP,
+		Status:          model.PaymentStatusPENDING,
+	}
+}
+
+func (s *ServiceSuite) TestSettlePendingPayments() {
+	confirmed := pendingPayment("txn-1")
+	stillPending := pendingPayment("txn-2")
+	declined := pendingPayment("txn-3")
+	failed := pendingPayment("txn-4")
+
+	s.paymentRepo.On("ListPendingPayments", s.ctx, "", uint64(settleBatchSize)).
+		Return([]*model.Payment{confirmed, stillPending, declined, failed}, nil).Once()
+
+	s.provider.On("ChargeStatus", mock.Anything, confirmed).
+		Return(model.PaymentStatusSUCCEEDED, nil).Once()
+	s.paymentRepo.On("UpdatePaymentStatus", s.ctx, confirmed.TransactionUUID, model.PaymentStatusSUCCEEDED).
+		Return(nil).Once()
+
+	s.provider.On("ChargeStatus", mock.Anything, stillPending).
+		Return(model.PaymentStatusPENDING, nil).Once()
+
+	s.provider.On("ChargeStatus", mock.Anything, declined).
+		Return(model.PaymentStatus(""), model.ErrPaymentDeclined).Once()
+	s.paymentRepo.On("UpdatePaymentStatus", s.ctx, declined.TransactionUUID, model.PaymentStatusDECLINED).
+		Return(nil).Once()
+
+	s.provider.On("ChargeStatus", mock.Anything, failed).
+		Return(model.PaymentStatus(""), ErrPaymentFailed).Once()
+
+	settled, err := s.service.SettlePendingPayments(s.ctx)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 2, settled)
+	assert.Equal(s.T(), map[string]string{
+		confirmed.TransactionUUID: string(model.PaymentStatusSUCCEEDED),
+		declined.TransactionUUID:  string(model.PaymentStatusDECLINED),
+	}, s.producer.settledStatuses())
+}
+
+func (s *ServiceSuite) TestSettlePendingPaymentsEvent() {
+	confirmed := pendingPayment("txn-1")
+
+	s.paymentRepo.On("ListPendingPayments", s.ctx, "", uint64(settleBatchSize)).
+		Return([]*model.Payment{confirmed}, nil).Once()
+	s.provider.On("ChargeStatus", mock.Anything, confirmed).
+		Return(model.PaymentStatusSUCCEEDED, nil).Once()
+	s.paymentRepo.On("UpdatePaymentStatus", s.ctx, confirmed.TransactionUUID, model.PaymentStatusSUCCEEDED).
+		Return(nil).Once()
+
+	_, err := s.service.SettlePendingPayments(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(s.producer.events, 1)
+	assert.Equal(s.T(), confirmed.OrderUUID, s.producer.keys[0])
+	event := s.producer.events[0]
+	assert.Equal(s.T(), confirmed.TransactionUUID, event.GetEventUuid())
+	assert.Equal(s.T(), confirmed.OrderUUID, event.GetOrderUuid())
+	assert.Equal(s.T(), confirmed.TransactionUUID, event.GetTransactionUuid())
+	assert.Equal(s.T(), confirmed.AttemptKey, event.GetAttemptKey())
+	assert.Equal(s.T(), string(model.PaymentStatusSUCCEEDED), event.GetStatus())
+	assert.Equal(s.T(), string(model.PaymentMethodSBP), event.GetPaymentMethod())
+	assert.Equal(s.T(), "150000.00", event.GetAmount())
+	assert.Equal(s.T(), model.CurrencyRUB, event.GetCurrency())
+}
+
+func (s *ServiceSuite) TestSettlePendingPaymentsPublishFailure() {
+	confirmed := pendingPayment("txn-1")
+	s.producer.err = ErrPaymentFailed
+
+	s.paymentRepo.On("ListPendingPayments", s.ctx, "", uint64(settleBatchSize)).
+		Return([]*model.Payment{confirmed}, nil).Once()
+	s.provider.On("ChargeStatus", mock.Anything, confirmed).
+		Return(model.PaymentStatusSUCCEEDED, nil).Once()
+
+	settled, err := s.service.SettlePendingPayments(s.ctx)
+
+	// the payment stays pending and is settled and announced on the next pass
+	s.Require().NoError(err)
+	assert.Zero(s.T(), settled)
+	s.paymentRepo.AssertNotCalled(s.T(), "UpdatePaymentStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestSettlePendingPaymentsPaging() {
+	firstPage := make([]*model.Payment, 0, settleBatchSize)
+	for i := range settleBatchSize {
+		firstPage = append(firstPage, pendingPayment(fmt.Sprintf("txn-%03d", i)))
+	}
+	last := firstPage[len(firstPage)-1].TransactionUUID
+	next := pendingPayment("txn-999")
+
+	s.paymentRepo.On("ListPendingPayments", s.ctx, "", uint64(settleBatchSize)).
+		Return(firstPage, nil).Once()
+	s.paymentRepo.On("ListPendingPayments", s.ctx, last, uint64(settleBatchSize)).
+		Return([]*model.Payment{next}, nil).Once()
+	s.provider.On("ChargeStatus", mock.Anything, mock.Anything).
+		Return(model.PaymentStatusSUCCEEDED, nil).Times(settleBatchSize + 1)
+	s.paymentRepo.On("UpdatePaymentStatus", s.ctx, mock.Anything, model.PaymentStatusSUCCEEDED).
+		Return(nil).Times(settleBatchSize + 1)
+
+	settled, err := s.service.SettlePendingPayments(s.ctx)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), settleBatchSize+1, settled)
+}
+
+func (s *ServiceSuite) TestSettlePendingPaymentsRepositoryError() {
+	s.paymentRepo.On("ListPendingPayments", s.ctx, "", uint64(settleBatchSize)).
+		Return(nil, ErrPaymentFailed).Once()
+
+	settled, err := s.service.SettlePendingPayments(s.ctx)
+
+	assert.ErrorIs(s.T(), err, ErrPaymentFailed)
+	assert.Zero(s.T(), settled)
+}
@@ -3,26 +3,98 @@ package payment
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/dexguitar/spacecraftory/payment/internal/converter/kafka/encoder"
+	"github.com/dexguitar/spacecraftory/payment/internal/model"
+	"github.com/dexguitar/spacecraftory/payment/internal/provider"
+	providerMocks "github.com/dexguitar/spacecraftory/payment/internal/provider/mocks"
 	"github.com/dexguitar/spacecraftory/payment/internal/repository/mocks"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/logger"
+	eventsV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1"
 )
 
+// fakeProducer keeps the settled payment events the service publishes
+type fakeProducer struct {
+	err    error
+	keys   []string
+	events []*eventsV1.PaymentSettled
+}
+
+func (p *fakeProducer) Send(_ context.Context, key, value []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	event := &eventsV1.PaymentSettled{}
+	if err := proto.Unmarshal(value, event); err != nil {
+		return err
+	}
+	p.keys = append(p.keys, string(key))
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *fakeProducer) SendMessage(ctx context.Context, msg kafka.ProducerMessage) error {
+	return p.Send(ctx, msg.Key, msg.Value)
+}
+
+func (p *fakeProducer) SendBatch(ctx context.Context, msgs []kafka.ProducerMessage) error {
+	for _, msg := range msgs {
+		if err := p.SendMessage(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// settledStatuses maps the transaction of every published event to its status
+func (p *fakeProducer) settledStatuses() map[string]string {
+	statuses := make(map[string]string, len(p.events))
+	for _, event := range p.events {
+		statuses[event.GetTransactionUuid()] = event.GetStatus()
+	}
+	return statuses
+}
+
 type ServiceSuite struct {
 	suite.Suite
 	ctx         context.Context
 	paymentRepo *mocks.PaymentRepository
+	provider    *providerMocks.PaymentProvider
+	producer    *fakeProducer
 	service     *service
 }
 
 func (s *ServiceSuite) SetupTest() {
+	logger.SetNopLogger()
+
 	s.ctx = context.Background()
 
 	s.paymentRepo = mocks.NewPaymentRepository(s.T())
+	s.provider = providerMocks.NewPaymentProvider(s.T())
+	s.producer = &fakeProducer{}
 
 	s.service = NewService(
 		s.paymentRepo,
+		map[model.PaymentMethod]provider.PaymentProvider{
+			model.PaymentMethodCARD:           s.provider,
+			model.PaymentMethodSBP:            s.provider,
+			model.PaymentMethodCREDIT_CARD:    s.provider,
+			model.PaymentMethodINVESTOR_MONEY: s.provider,
+		},
+		time.Second,
+		map[model.PaymentMethod]decimal.Decimal{
+			model.PaymentMethodCARD:        decimal.NewFromInt(1_000_000),
+			model.PaymentMethodCREDIT_CARD: decimal.NewFromInt(500_000),
+		},
+		s.producer,
+		encoder.NewPaymentSettledEncoder(),
 	)
 }
 
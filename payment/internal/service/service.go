@@ -8,4 +8,6 @@ import (
 
 type PaymentService interface {
 	PayOrder(ctx context.Context, payment *model.Payment) (string, error)
+	RefundPayment(ctx context.Context, refund *model.Refund) (*model.Payment, error)
+	SettlePendingPayments(ctx context.Context) (int, error)
 }
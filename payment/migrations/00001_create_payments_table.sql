@@ -0,0 +1,20 @@
+-- +goose Up
+-- payment ledger, one row per payment transaction
+create table if not exists payments (
+    transaction_uuid uuid primary key,
+    order_uuid uuid not null,
+    user_uuid uuid not null,
+    -- amount and currency stay empty until PayOrder carries them
+    amount numeric(20, 2),
+    currency char(3),
+    payment_method text not null,
+    status text not null,
+    created_at timestamp not null default now(),
+    updated_at timestamp not null default now()
+);
+
+create index if not exists idx_payments_order_uuid on payments(order_uuid);
+
+-- +goose Down
+drop index if exists idx_payments_order_uuid;
+drop table if exists payments;
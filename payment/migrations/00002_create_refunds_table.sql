@@ -0,0 +1,19 @@
+-- +goose Up
+-- refunds of a payment, the payment row keeps the running total
+alter table payments add column if not exists refunded_amount numeric(20, 2) not null default 0;
+
+create table if not exists refunds (
+    refund_uuid uuid primary key,
+    transaction_uuid uuid not null references payments(transaction_uuid),
+    amount numeric(20, 2) not null,
+    currency char(3) not null,
+    reason text not null default '',
+    created_at timestamp not null default now()
+);
+
+create index if not exists idx_refunds_transaction_uuid on refunds(transaction_uuid);
+
+-- +goose Down
+drop index if exists idx_refunds_transaction_uuid;
+drop table if exists refunds;
+alter table payments drop column if exists refunded_amount;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- an order is charged at most once per attempt key
+alter table payments add column if not exists attempt_key text not null default '';
+
+-- earlier payments keep their own transaction uuid as the key, so repeated charges
+-- made before the key existed do not break the unique index
+update payments set attempt_key = transaction_uuid::text where attempt_key = '';
+
+create unique index if not exists idx_payments_order_uuid_attempt_key on payments(order_uuid, attempt_key);
+
+-- +goose Down
+drop index if exists idx_payments_order_uuid_attempt_key;
+alter table payments drop column if exists attempt_key;
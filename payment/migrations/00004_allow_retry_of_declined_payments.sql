@@ -0,0 +1,9 @@
+-- +goose Up
+-- a declined payment does not use up the attempt, the order may be charged again with the same key
+drop index if exists idx_payments_order_uuid_attempt_key;
+create unique index if not exists idx_payments_order_uuid_attempt_key on payments(order_uuid, attempt_key)
+    where status <> 'DECLINED';
+
+-- +goose Down
+drop index if exists idx_payments_order_uuid_attempt_key;
+create unique index if not exists idx_payments_order_uuid_attempt_key on payments(order_uuid, attempt_key);
@@ -0,0 +1,11 @@
+-- +goose Up
+-- a refund key is refunded at most once per payment, refunds stored before keys existed use their own UUID
+alter table refunds add column if not exists refund_key text;
+update refunds set refund_key = refund_uuid::text where refund_key is null;
+alter table refunds alter column refund_key set not null;
+
+create unique index if not exists idx_refunds_transaction_uuid_refund_key on refunds(transaction_uuid, refund_key);
+
+-- +goose Down
+drop index if exists idx_refunds_transaction_uuid_refund_key;
+alter table refunds drop column if exists refund_key;
@@ -8,6 +8,7 @@ import (
 type RedisClient interface {
 	Set(ctx context.Context, key string, value any) error
 	SetWithTTL(ctx context.Context, key string, value any, ttl time.Duration) error
+	SetNX(ctx context.Context, key string, value any, ttl time.Duration) (bool, error)
 	Get(ctx context.Context, key string) ([]byte, error)
 	HashSet(ctx context.Context, key string, values any) error
 	HGetAll(ctx context.Context, key string) ([]any, error)
@@ -16,6 +17,8 @@ type RedisClient interface {
 	Expire(ctx context.Context, key string, expiration time.Duration) error
 	Ping(ctx context.Context) error
 	TxPipeline(ctx context.Context, fn func(TxPipeliner) error) error
+	// Eval атомарно выполняет Lua-скрипт над ключами keys и возвращает его ответ.
+	Eval(ctx context.Context, script string, keys []string, args ...any) (any, error)
 	SetOperator
 }
 
@@ -23,6 +26,8 @@ type TxPipeliner interface {
 	HashSet(key string, values any) error
 	Expire(key string, expiration time.Duration) error
 	SAdd(key, value string) error
+	SRem(key, value string) error
+	Del(key string) error
 }
 
 type SetOperator interface {
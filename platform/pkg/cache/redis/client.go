@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	redigo "github.com/gomodule/redigo/redis"
@@ -69,6 +70,23 @@ func (c *client) SetWithTTL(ctx context.Context, key string, value any, ttl time
 	})
 }
 
+func (c *client) SetNX(ctx context.Context, key string, value any, ttl time.Duration) (bool, error) {
+	var set bool
+	err := c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		_, err := redigo.String(conn.Do("SET", key, value, "PX", ttl.Milliseconds(), "NX"))
+		if errors.Is(err, redigo.ErrNil) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		set = true
+		return nil
+	})
+
+	return set, err
+}
+
 func (c *client) Get(ctx context.Context, key string) ([]byte, error) {
 	var result []byte
 	err := c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
@@ -0,0 +1,28 @@
+package redis
+
+import (
+	"context"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// Eval выполняет скрипт через EVALSHA и при его отсутствии в кеше redis отправляет тело скрипта.
+func (c *client) Eval(ctx context.Context, script string, keys []string, args ...any) (any, error) {
+	var reply any
+	err := c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		keysAndArgs := make([]any, 0, len(keys)+len(args))
+		for _, key := range keys {
+			keysAndArgs = append(keysAndArgs, key)
+		}
+		keysAndArgs = append(keysAndArgs, args...)
+
+		result, err := redigo.NewScript(len(keys), script).Do(conn, keysAndArgs...)
+		if err != nil {
+			return err
+		}
+		reply = result
+		return nil
+	})
+
+	return reply, err
+}
@@ -44,3 +44,11 @@ func (p *txPipeliner) Expire(key string, expiration time.Duration) error {
 func (p *txPipeliner) SAdd(key, value string) error {
 	return p.conn.Send("SADD", key, value)
 }
+
+func (p *txPipeliner) SRem(key, value string) error {
+	return p.conn.Send("SREM", key, value)
+}
+
+func (p *txPipeliner) Del(key string) error {
+	return p.conn.Send("DEL", key)
+}
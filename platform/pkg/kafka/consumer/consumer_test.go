@@ -0,0 +1,13 @@
+package consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// nopLogger discards the log records of the middlewares under test
+type nopLogger struct{}
+
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
@@ -0,0 +1,71 @@
+package consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
+
+// DedupStore — хранилище идентификаторов уже обработанных событий.
+type DedupStore interface {
+	// Claim атомарно захватывает событие для обработки. Возвращает false, если событие
+	// уже обработано или его обрабатывает другой консьюмер. Захват, не отмеченный
+	// обработанным, истекает сам, чтобы событие упавшего процесса обработалось снова.
+	Claim(ctx context.Context, eventUUID string) (bool, error)
+	// MarkProcessed отмечает захваченное событие обработанным.
+	MarkProcessed(ctx context.Context, eventUUID string) error
+	// Release снимает захват события, обработка которого завершилась ошибкой.
+	Release(ctx context.Context, eventUUID string) error
+}
+
+// EventUUIDFunc — извлекает идентификатор события из сообщения.
+type EventUUIDFunc func(msg kafka.Message) (string, error)
+
+// Dedup — middleware, пропускающий уже обработанные события.
+//
+// Событие захватывается до вызова обработчика, поэтому одновременно доставленные
+// дубликаты не обрабатываются дважды. После ошибки обработчика захват снимается,
+// и повтор обрабатывает событие заново. При падении между обработкой и отметкой
+// событие будет обработано ещё раз, когда истечёт захват.
+// Сообщения, из которых не удалось извлечь идентификатор, передаются обработчику как есть.
+func Dedup(store DedupStore, eventUUID EventUUIDFunc, logger Logger) Middleware {
+	return func(next kafka.MessageHandler) kafka.MessageHandler {
+		return func(ctx context.Context, msg kafka.Message) error {
+			id, err := eventUUID(msg)
+			if err != nil || id == "" {
+				return next(ctx, msg)
+			}
+
+			claimed, err := store.Claim(ctx, id)
+			if err != nil {
+				logger.Error(ctx, "Failed to claim event", zap.String("event_uuid", id), zap.Error(err))
+				return err
+			}
+			if !claimed {
+				logger.Info(ctx, "Kafka event already processed, skipping",
+					zap.String("topic", msg.Topic),
+					zap.Int64("offset", msg.Offset),
+					zap.String("event_uuid", id),
+				)
+				return nil
+			}
+
+			if err := next(ctx, msg); err != nil {
+				// захват снимается и при отменённом контексте, иначе повтор ждал бы его истечения
+				if releaseErr := store.Release(context.WithoutCancel(ctx), id); releaseErr != nil {
+					logger.Error(ctx, "Failed to release event", zap.String("event_uuid", id), zap.Error(releaseErr))
+				}
+				return err
+			}
+
+			// обработка уже прошла, повторять её из-за сбоя отметки не нужно
+			if err := store.MarkProcessed(ctx, id); err != nil {
+				logger.Error(ctx, "Failed to mark event as processed", zap.String("event_uuid", id), zap.Error(err))
+			}
+
+			return nil
+		}
+	}
+}
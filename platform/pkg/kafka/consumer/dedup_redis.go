@@ -0,0 +1,50 @@
+package consumer
+
+import (
+	"context"
+	"time"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/cache"
+)
+
+// Значения ключа события: захвачено на обработку или обработано.
+const (
+	dedupClaimed   = "claimed"
+	dedupProcessed = "processed"
+)
+
+type redisDedupStore struct {
+	client   cache.RedisClient
+	prefix   string
+	ttl      time.Duration
+	claimTTL time.Duration
+}
+
+// NewRedisDedupStore — хранилище обработанных событий в Redis.
+// Ключи хранятся ttl, поэтому дубликаты распознаются только в этом окне.
+// Захват события на обработку держится claimTTL.
+func NewRedisDedupStore(client cache.RedisClient, prefix string, ttl, claimTTL time.Duration) *redisDedupStore {
+	return &redisDedupStore{
+		client:   client,
+		prefix:   prefix,
+		ttl:      ttl,
+		claimTTL: claimTTL,
+	}
+}
+
+// DedupKeyPrefix — префикс ключей обработанных событий consumer group.
+func DedupKeyPrefix(groupID string) string {
+	return "kafka:dedup:" + groupID + ":"
+}
+
+func (s *redisDedupStore) Claim(ctx context.Context, eventUUID string) (bool, error) {
+	return s.client.SetNX(ctx, s.prefix+eventUUID, dedupClaimed, s.claimTTL)
+}
+
+func (s *redisDedupStore) MarkProcessed(ctx context.Context, eventUUID string) error {
+	return s.client.SetWithTTL(ctx, s.prefix+eventUUID, dedupProcessed, s.ttl)
+}
+
+func (s *redisDedupStore) Release(ctx context.Context, eventUUID string) error {
+	return s.client.Del(ctx, s.prefix+eventUUID)
+}
@@ -0,0 +1,148 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
+
+// memoryDedupStore keeps claims the way the stores do: an event is claimed once
+// until it is released
+type memoryDedupStore struct {
+	mu        sync.Mutex
+	claimed   map[string]bool
+	processed map[string]bool
+}
+
+func newMemoryDedupStore() *memoryDedupStore {
+	return &memoryDedupStore{
+		claimed:   make(map[string]bool),
+		processed: make(map[string]bool),
+	}
+}
+
+func (s *memoryDedupStore) Claim(_ context.Context, eventUUID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.claimed[eventUUID] {
+		return false, nil
+	}
+	s.claimed[eventUUID] = true
+	return true, nil
+}
+
+func (s *memoryDedupStore) MarkProcessed(_ context.Context, eventUUID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.processed[eventUUID] = true
+	return nil
+}
+
+func (s *memoryDedupStore) Release(_ context.Context, eventUUID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.claimed, eventUUID)
+	return nil
+}
+
+func eventUUIDFromKey(msg kafka.Message) (string, error) {
+	return string(msg.Key), nil
+}
+
+func TestDedupSkipsProcessedEvent(t *testing.T) {
+	store := newMemoryDedupStore()
+	var calls int
+	handler := Dedup(store, eventUUIDFromKey, nopLogger{})(func(context.Context, kafka.Message) error {
+		calls++
+		return nil
+	})
+
+	msg := kafka.Message{Key: []byte("event-1")}
+	require.NoError(t, handler(context.Background(), msg))
+	require.NoError(t, handler(context.Background(), msg))
+
+	assert.Equal(t, 1, calls)
+	assert.True(t, store.processed["event-1"])
+}
+
+func TestDedupConcurrentDelivery(t *testing.T) {
+	store := newMemoryDedupStore()
+	var calls atomic.Int32
+	release := make(chan struct{})
+	handler := Dedup(store, eventUUIDFromKey, nopLogger{})(func(context.Context, kafka.Message) error {
+		calls.Add(1)
+		<-release
+		return nil
+	})
+
+	msg := kafka.Message{Key: []byte("event-1")}
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, handler(context.Background(), msg))
+		}()
+	}
+
+	// duplicates delivered while the first one is processed are skipped
+	require.Eventually(t, func() bool {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		return store.claimed["event-1"]
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestDedupReleasesFailedEvent(t *testing.T) {
+	store := newMemoryDedupStore()
+	errHandler := errors.New("handler failed")
+	var calls int
+	handler := Dedup(store, eventUUIDFromKey, nopLogger{})(func(context.Context, kafka.Message) error {
+		calls++
+		if calls == 1 {
+			return errHandler
+		}
+		return nil
+	})
+
+	msg := kafka.Message{Key: []byte("event-1")}
+	assert.ErrorIs(t, handler(context.Background(), msg), errHandler)
+	assert.False(t, store.processed["event-1"])
+
+	// the retry processes the event again
+	require.NoError(t, handler(context.Background(), msg))
+	assert.Equal(t, 2, calls)
+	assert.True(t, store.processed["event-1"])
+}
+
+func TestDedupWithoutEventUUID(t *testing.T) {
+	store := newMemoryDedupStore()
+	var calls int
+	handler := Dedup(store, func(kafka.Message) (string, error) {
+		return "", errors.New("undecodable message")
+	}, nopLogger{})(func(context.Context, kafka.Message) error {
+		calls++
+		return nil
+	})
+
+	require.NoError(t, handler(context.Background(), kafka.Message{}))
+	require.NoError(t, handler(context.Background(), kafka.Message{}))
+
+	assert.Equal(t, 2, calls)
+	assert.Empty(t, store.claimed)
+}
@@ -1,6 +1,9 @@
 package consumer
 
 import (
+	"sync/atomic"
+	"time"
+
 	"github.com/IBM/sarama"
 	"go.uber.org/zap"
 
@@ -10,10 +13,21 @@ import (
 // Middleware — функция middleware для дополнительной обработки.
 type Middleware func(next kafka.MessageHandler) kafka.MessageHandler
 
+// Задержка перед перезапуском сессии после ошибки обработчика. Растёт вдвое с каждой
+// ошибкой подряд, чтобы сообщение, которое некуда отложить, не крутилось без паузы.
+const (
+	handlerErrorInitialBackoff = time.Second
+	handlerErrorMaxBackoff     = time.Minute
+)
+
 // groupHandler — обёртка для sarama.ConsumerGroupHandler
 type groupHandler struct {
 	handler kafka.MessageHandler
 	logger  Logger
+
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	failures       atomic.Int64
 }
 
 // NewGroupHandler создаёт новый groupHandler с middleware цепочкой.
@@ -24,8 +38,10 @@ func NewGroupHandler(handler kafka.MessageHandler, logger Logger, middlewares ..
 	}
 
 	return &groupHandler{
-		handler: handler,
-		logger:  logger,
+		handler:        handler,
+		logger:         logger,
+		initialBackoff: handlerErrorInitialBackoff,
+		maxBackoff:     handlerErrorMaxBackoff,
 	}
 }
 
@@ -57,11 +73,22 @@ func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 				Headers:        extractHeaders(message.Headers),
 			}
 
+			// Сообщение не помечается, и чтение партиции прекращается: иначе смещение
+			// следующего сообщения закоммитит и это. После паузы и ребалансировки оно придёт снова.
 			if err := g.handler(session.Context(), msg); err != nil {
-				g.logger.Error(session.Context(), "Kafka handler error", zap.Error(err))
-				continue
+				backoff := g.backoff(g.failures.Add(1))
+				g.logger.Error(session.Context(), "Kafka handler error, partition consumption stopped",
+					zap.String("topic", message.Topic),
+					zap.Int32("partition", message.Partition),
+					zap.Int64("offset", message.Offset),
+					zap.Duration("backoff", backoff),
+					zap.Error(err),
+				)
+				_ = sleep(session.Context(), backoff)
+				return err
 			}
 
+			g.failures.Store(0)
 			session.MarkMessage(message, "")
 
 		case <-session.Context().Done():
@@ -71,6 +98,16 @@ func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 	}
 }
 
+// backoff — задержка после failures ошибок обработчика подряд.
+func (g *groupHandler) backoff(failures int64) time.Duration {
+	backoff := g.initialBackoff
+	for i := int64(1); i < failures && backoff < g.maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, g.maxBackoff)
+}
+
 func extractHeaders(headers []*sarama.RecordHeader) map[string][]byte {
 	result := make(map[string][]byte)
 	for _, h := range headers {
@@ -0,0 +1,111 @@
+package consumer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
+
+// fakeSession records the marked offsets, other methods of the session are not used
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	marked []int64
+}
+
+func (s *fakeSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+// fakeClaim delivers the messages given, other methods of the claim are not used
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func newFakeClaim(offsets ...int64) *fakeClaim {
+	messages := make(chan *sarama.ConsumerMessage, len(offsets))
+	for _, offset := range offsets {
+		messages <- &sarama.ConsumerMessage{Topic: testTopic, Offset: offset}
+	}
+	close(messages)
+
+	return &fakeClaim{messages: messages}
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+func TestConsumeClaimMarksHandledMessages(t *testing.T) {
+	handler := NewGroupHandler(func(context.Context, kafka.Message) error { return nil }, nopLogger{})
+	session := &fakeSession{ctx: context.Background()}
+
+	err := handler.ConsumeClaim(session, newFakeClaim(1, 2, 3))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, session.marked)
+}
+
+func TestConsumeClaimBacksOffAfterHandlerError(t *testing.T) {
+	handler := NewGroupHandler(func(_ context.Context, msg kafka.Message) error {
+		if msg.Offset == 2 {
+			return errTestHandler
+		}
+		return nil
+	}, nopLogger{})
+	handler.initialBackoff = 20 * time.Millisecond
+	handler.maxBackoff = 40 * time.Millisecond
+
+	session := &fakeSession{ctx: context.Background()}
+	started := time.Now()
+
+	err := handler.ConsumeClaim(session, newFakeClaim(1, 2, 3))
+
+	// the failed message and the ones after it are not marked
+	assert.ErrorIs(t, err, errTestHandler)
+	assert.Equal(t, []int64{1}, session.marked)
+	assert.GreaterOrEqual(t, time.Since(started), 20*time.Millisecond)
+
+	// the restarted session starts from the failed message, the backoff grows up to the limit
+	for _, expectedBackoff := range []time.Duration{40 * time.Millisecond, 40 * time.Millisecond} {
+		session := &fakeSession{ctx: context.Background()}
+		started := time.Now()
+
+		err := handler.ConsumeClaim(session, newFakeClaim(2, 3))
+
+		assert.ErrorIs(t, err, errTestHandler)
+		assert.Empty(t, session.marked)
+		assert.GreaterOrEqual(t, time.Since(started), expectedBackoff)
+	}
+
+	// a handled message resets the backoff
+	session = &fakeSession{ctx: context.Background()}
+	assert.NoError(t, handler.ConsumeClaim(session, newFakeClaim(1)))
+	assert.Equal(t, handler.initialBackoff, handler.backoff(handler.failures.Add(1)))
+}
+
+func TestConsumeClaimBackoffStopsWithSession(t *testing.T) {
+	handler := NewGroupHandler(func(context.Context, kafka.Message) error {
+		return errTestHandler
+	}, nopLogger{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	session := &fakeSession{ctx: ctx}
+
+	started := time.Now()
+	err := handler.ConsumeClaim(session, newFakeClaim(1))
+
+	assert.ErrorIs(t, err, errTestHandler)
+	assert.Less(t, time.Since(started), handlerErrorInitialBackoff)
+}
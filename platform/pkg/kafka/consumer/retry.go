@@ -0,0 +1,218 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
+
+// Заголовки, которыми помечается сообщение при отправке в retry или dead-letter топик.
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderError             = "x-error"
+	HeaderRetryCount        = "x-retry-count"
+	HeaderRetryNotBefore    = "x-retry-not-before"
+)
+
+// RetryPolicy — политика повторной обработки сообщений консьюмера.
+//
+// Сначала обработчик повторяется в процессе с экспоненциальной задержкой,
+// затем сообщение откладывается в RetryTopic на RetryDelay, а после
+// MaxRetryTopicAttempts проходов через него уходит в DeadLetterTopic.
+// Пустой RetryTopic или DeadLetterTopic пропускает соответствующий шаг. Если не задан
+// ни один, ошибка останавливает чтение партиции, и сообщение обрабатывается снова
+// после паузы, которая растёт с каждой ошибкой подряд.
+// Ошибки kafka.ErrPermanent не повторяются: сообщение сразу уходит в DeadLetterTopic.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	RetryTopic            string
+	RetryDelay            time.Duration
+	MaxRetryTopicAttempts int
+
+	DeadLetterTopic string
+}
+
+// RetryTopicName — имя retry топика для топика и consumer group.
+// Группа входит в имя, чтобы повторы одной группы не получала другая.
+func RetryTopicName(topic, groupID string) string {
+	return topic + "." + groupID + ".retry"
+}
+
+// DeadLetterTopicName — имя dead-letter топика для топика и consumer group.
+func DeadLetterTopicName(topic, groupID string) string {
+	return topic + "." + groupID + ".dlq"
+}
+
+type retrier struct {
+	policy   RetryPolicy
+	producer sarama.SyncProducer
+	logger   Logger
+}
+
+// Retry — middleware, применяющий RetryPolicy. Консьюмер должен быть подписан
+// и на основной топик, и на RetryTopic.
+func Retry(policy RetryPolicy, producer sarama.SyncProducer, logger Logger) Middleware {
+	r := &retrier{
+		policy:   policy,
+		producer: producer,
+		logger:   logger,
+	}
+
+	return func(next kafka.MessageHandler) kafka.MessageHandler {
+		return func(ctx context.Context, msg kafka.Message) error {
+			if msg.Topic == r.policy.RetryTopic {
+				if err := waitNotBefore(ctx, msg); err != nil {
+					return err
+				}
+			}
+
+			err := r.handle(ctx, next, msg)
+			if err == nil || ctx.Err() != nil {
+				return err
+			}
+
+			return r.forward(ctx, msg, err)
+		}
+	}
+}
+
+// handle вызывает обработчик до MaxAttempts раз с экспоненциальной задержкой.
+func (r *retrier) handle(ctx context.Context, next kafka.MessageHandler, msg kafka.Message) error {
+	backoff := r.policy.InitialBackoff
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = next(ctx, msg)
+		if err == nil || attempt >= r.policy.MaxAttempts || errors.Is(err, kafka.ErrPermanent) {
+			return err
+		}
+
+		r.logger.Error(ctx, "Kafka handler error, retrying",
+			zap.String("topic", msg.Topic),
+			zap.Int64("offset", msg.Offset),
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+
+		if waitErr := sleep(ctx, backoff); waitErr != nil {
+			return waitErr
+		}
+
+		backoff = min(backoff*2, r.policy.MaxBackoff)
+	}
+}
+
+// forward отправляет сообщение в retry топик, а когда повторы исчерпаны — в dead-letter топик.
+// Если ни один из них не настроен, возвращается исходная ошибка.
+func (r *retrier) forward(ctx context.Context, msg kafka.Message, handlerErr error) error {
+	retryCount := headerInt(msg.Headers, HeaderRetryCount)
+
+	topic := r.policy.DeadLetterTopic
+	if r.policy.RetryTopic != "" && retryCount < int64(r.policy.MaxRetryTopicAttempts) && !errors.Is(handlerErr, kafka.ErrPermanent) {
+		topic = r.policy.RetryTopic
+	}
+	if topic == "" {
+		return handlerErr
+	}
+
+	headers := failureHeaders(msg, handlerErr)
+	if topic == r.policy.RetryTopic {
+		notBefore := time.Now().Add(r.policy.RetryDelay).UnixMilli()
+		headers[HeaderRetryCount] = []byte(strconv.FormatInt(retryCount+1, 10))
+		headers[HeaderRetryNotBefore] = []byte(strconv.FormatInt(notBefore, 10))
+	}
+
+	_, _, err := r.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: toRecordHeaders(headers),
+	})
+	if err != nil {
+		r.logger.Error(ctx, "Failed to forward Kafka message", zap.String("topic", topic), zap.Error(err))
+		return handlerErr
+	}
+
+	r.logger.Error(ctx, "Kafka message forwarded after handler error",
+		zap.String("from", msg.Topic),
+		zap.String("to", topic),
+		zap.Int64("retry_count", retryCount),
+		zap.Error(handlerErr),
+	)
+
+	return nil
+}
+
+// failureHeaders копирует заголовки сообщения и добавляет к ним координаты
+// исходного сообщения (только при первой неудаче) и текст ошибки.
+func failureHeaders(msg kafka.Message, handlerErr error) map[string][]byte {
+	headers := make(map[string][]byte, len(msg.Headers)+4)
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+
+	if _, ok := headers[HeaderOriginalTopic]; !ok {
+		headers[HeaderOriginalTopic] = []byte(msg.Topic)
+		headers[HeaderOriginalPartition] = []byte(strconv.FormatInt(int64(msg.Partition), 10))
+		headers[HeaderOriginalOffset] = []byte(strconv.FormatInt(msg.Offset, 10))
+	}
+	headers[HeaderError] = []byte(handlerErr.Error())
+
+	return headers
+}
+
+// waitNotBefore выдерживает задержку, выставленную при отправке в retry топик.
+func waitNotBefore(ctx context.Context, msg kafka.Message) error {
+	notBefore := headerInt(msg.Headers, HeaderRetryNotBefore)
+	if notBefore == 0 {
+		return nil
+	}
+
+	return sleep(ctx, time.Until(time.UnixMilli(notBefore)))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func headerInt(headers map[string][]byte, key string) int64 {
+	v, err := strconv.ParseInt(string(headers[key]), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
+func toRecordHeaders(headers map[string][]byte) []sarama.RecordHeader {
+	result := make([]sarama.RecordHeader, 0, len(headers))
+	for k, v := range headers {
+		result = append(result, sarama.RecordHeader{Key: []byte(k), Value: v})
+	}
+
+	return result
+}
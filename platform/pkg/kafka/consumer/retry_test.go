@@ -0,0 +1,208 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
+
+const (
+	testTopic      = "order.paid"
+	testRetryTopic = "order.paid.assembly.retry"
+	testDLQTopic   = "order.paid.assembly.dlq"
+)
+
+var errTestHandler = errors.New("handler failed")
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:           3,
+		InitialBackoff:        time.Millisecond,
+		MaxBackoff:            time.Millisecond,
+		RetryTopic:            testRetryTopic,
+		RetryDelay:            time.Minute,
+		MaxRetryTopicAttempts: 2,
+		DeadLetterTopic:       testDLQTopic,
+	}
+}
+
+// failingHandler fails the first failures calls and counts all of them
+func failingHandler(failures int, err error, calls *int) kafka.MessageHandler {
+	return func(context.Context, kafka.Message) error {
+		*calls++
+		if *calls <= failures {
+			return err
+		}
+		return nil
+	}
+}
+
+func recordHeaders(msg *sarama.ProducerMessage) map[string]string {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		headers[string(h.Key)] = string(h.Value)
+	}
+	return headers
+}
+
+// expectForward expects a message forwarded to topic and passes its headers to check
+func expectForward(producer *mocks.SyncProducer, topic string, check func(headers map[string]string)) {
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		if msg.Topic != topic {
+			return errors.New("message forwarded to " + msg.Topic + ", expected " + topic)
+		}
+		check(recordHeaders(msg))
+		return nil
+	})
+}
+
+func TestRetryInProcess(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	defer producer.Close() //nolint:errcheck
+
+	var calls int
+	handler := Retry(testRetryPolicy(), producer, nopLogger{})(failingHandler(2, errTestHandler, &calls))
+
+	err := handler(context.Background(), kafka.Message{Topic: testTopic})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryForwardsToRetryTopic(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	defer producer.Close() //nolint:errcheck
+
+	before := time.Now()
+	expectForward(producer, testRetryTopic, func(headers map[string]string) {
+		assert.Equal(t, "1", headers[HeaderRetryCount])
+		assert.Equal(t, testTopic, headers[HeaderOriginalTopic])
+		assert.Equal(t, "2", headers[HeaderOriginalPartition])
+		assert.Equal(t, "42", headers[HeaderOriginalOffset])
+		assert.Equal(t, errTestHandler.Error(), headers[HeaderError])
+
+		notBefore, err := strconv.ParseInt(headers[HeaderRetryNotBefore], 10, 64)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, notBefore, before.Add(time.Minute).UnixMilli())
+	})
+
+	var calls int
+	handler := Retry(testRetryPolicy(), producer, nopLogger{})(failingHandler(3, errTestHandler, &calls))
+
+	err := handler(context.Background(), kafka.Message{Topic: testTopic, Partition: 2, Offset: 42})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryTopicMessage(t *testing.T) {
+	testCases := []struct {
+		name          string
+		retryCount    string
+		expectedTopic string
+		expectedCount string
+	}{
+		{
+			name:          "Retries left",
+			retryCount:    "1",
+			expectedTopic: testRetryTopic,
+			expectedCount: "2",
+		},
+		{
+			name:          "Retries exhausted",
+			retryCount:    "2",
+			expectedTopic: testDLQTopic,
+			expectedCount: "2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			producer := mocks.NewSyncProducer(t, nil)
+			defer producer.Close() //nolint:errcheck
+
+			expectForward(producer, tc.expectedTopic, func(headers map[string]string) {
+				assert.Equal(t, tc.expectedCount, headers[HeaderRetryCount])
+				// the coordinates of the first failure are kept
+				assert.Equal(t, testTopic, headers[HeaderOriginalTopic])
+				assert.Equal(t, "7", headers[HeaderOriginalOffset])
+			})
+
+			var calls int
+			handler := Retry(testRetryPolicy(), producer, nopLogger{})(failingHandler(3, errTestHandler, &calls))
+
+			err := handler(context.Background(), kafka.Message{
+				Topic: testRetryTopic,
+				Headers: map[string][]byte{
+					HeaderRetryCount:        []byte(tc.retryCount),
+					HeaderRetryNotBefore:    []byte(strconv.FormatInt(time.Now().Add(-time.Second).UnixMilli(), 10)),
+					HeaderOriginalTopic:     []byte(testTopic),
+					HeaderOriginalPartition: []byte("0"),
+					HeaderOriginalOffset:    []byte("7"),
+				},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, 3, calls)
+		})
+	}
+}
+
+func TestRetryPermanentError(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	defer producer.Close() //nolint:errcheck
+
+	permanentErr := errors.Join(kafka.ErrPermanent, errTestHandler)
+	expectForward(producer, testDLQTopic, func(headers map[string]string) {
+		assert.Empty(t, headers[HeaderRetryCount])
+	})
+
+	var calls int
+	handler := Retry(testRetryPolicy(), producer, nopLogger{})(failingHandler(1, permanentErr, &calls))
+
+	err := handler(context.Background(), kafka.Message{Topic: testTopic})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryWithoutTopics(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	defer producer.Close() //nolint:errcheck
+
+	policy := testRetryPolicy()
+	policy.RetryTopic = ""
+	policy.DeadLetterTopic = ""
+
+	var calls int
+	handler := Retry(policy, producer, nopLogger{})(failingHandler(3, errTestHandler, &calls))
+
+	err := handler(context.Background(), kafka.Message{Topic: testTopic})
+
+	assert.ErrorIs(t, err, errTestHandler)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryForwardFailure(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	defer producer.Close() //nolint:errcheck
+
+	producer.ExpectSendMessageAndFail(sarama.ErrOutOfBrokers)
+
+	var calls int
+	handler := Retry(testRetryPolicy(), producer, nopLogger{})(failingHandler(3, errTestHandler, &calls))
+
+	err := handler(context.Background(), kafka.Message{Topic: testTopic})
+
+	// the message is not lost, the handler error stops the partition
+	assert.ErrorIs(t, err, errTestHandler)
+}
@@ -0,0 +1,85 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
+)
+
+// Заголовки конверта события. Тип события передаётся в EventTypeHeader,
+// trace context — в заголовках W3C (traceparent).
+const (
+	SchemaVersionHeader   = "x-schema-version"
+	OccurredAtHeader      = "x-occurred-at"
+	ProducerServiceHeader = "x-producer-service"
+)
+
+// LegacySchemaVersion — версия схемы сообщений, отправленных до появления SchemaVersionHeader.
+const LegacySchemaVersion = 1
+
+// Envelope — метаданные события, которые передаются в заголовках сообщения
+// рядом с protobuf-телом.
+type Envelope struct {
+	EventType       string
+	SchemaVersion   int
+	OccurredAt      time.Time
+	ProducerService string
+	TraceID         string
+	SpanID          string
+}
+
+// ParseEnvelope читает конверт из заголовков сообщения.
+// Без заголовка версии считается, что событие имеет LegacySchemaVersion.
+func ParseEnvelope(msg Message) (Envelope, error) {
+	return parseEnvelope(msg, "")
+}
+
+// parseEnvelope читает конверт, а сообщению без заголовка типа присваивает legacyEventType.
+// Пустой legacyEventType оставляет такое сообщение ошибкой ErrMissingEventType.
+func parseEnvelope(msg Message, legacyEventType string) (Envelope, error) {
+	envelope := Envelope{
+		EventType:       string(msg.Headers[EventTypeHeader]),
+		SchemaVersion:   LegacySchemaVersion,
+		ProducerService: string(msg.Headers[ProducerServiceHeader]),
+	}
+	if envelope.EventType == "" {
+		envelope.EventType = legacyEventType
+	}
+	if envelope.EventType == "" {
+		return Envelope{}, ErrMissingEventType
+	}
+
+	if v, ok := msg.Headers[SchemaVersionHeader]; ok {
+		version, err := strconv.Atoi(string(v))
+		if err != nil || version < 1 {
+			return Envelope{}, fmt.Errorf("%w: %s %q", ErrUnsupportedSchemaVersion, envelope.EventType, v)
+		}
+		envelope.SchemaVersion = version
+	}
+
+	if v, ok := msg.Headers[OccurredAtHeader]; ok {
+		occurredAt, err := time.Parse(time.RFC3339Nano, string(v))
+		if err != nil {
+			return Envelope{}, fmt.Errorf("%w: invalid %s header: %v", ErrInvalidEnvelope, OccurredAtHeader, err)
+		}
+		envelope.OccurredAt = occurredAt
+	}
+
+	spanContext := trace.SpanContextFromContext(tracing.ExtractKafkaHeaders(context.Background(), msg.Headers))
+	if spanContext.IsValid() {
+		envelope.TraceID = spanContext.TraceID().String()
+		envelope.SpanID = spanContext.SpanID().String()
+	}
+
+	return envelope, nil
+}
+
+// FormatOccurredAt — значение OccurredAtHeader для момента t.
+func FormatOccurredAt(t time.Time) []byte {
+	return []byte(t.UTC().Format(time.RFC3339Nano))
+}
@@ -0,0 +1,100 @@
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestParseEnvelope(t *testing.T) {
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+
+	occurredAt := time.Date(2026, 10, 17, 12, 30, 0, 123456789, time.UTC)
+
+	testCases := []struct {
+		name             string
+		headers          map[string][]byte
+		expectedEnvelope Envelope
+		expectedError    error
+	}{
+		{
+			name: "Full envelope",
+			headers: map[string][]byte{
+				EventTypeHeader:       []byte("OrderPaid"),
+				SchemaVersionHeader:   []byte("2"),
+				OccurredAtHeader:      FormatOccurredAt(occurredAt),
+				ProducerServiceHeader: []byte("order"),
+				"traceparent":         []byte(testTraceParent),
+			},
+			expectedEnvelope: Envelope{
+				EventType:       "OrderPaid",
+				SchemaVersion:   2,
+				OccurredAt:      occurredAt,
+				ProducerService: "order",
+				TraceID:         "4bf92f3577b34da6a3ce929d0e0e4736",
+				SpanID:          "00f067aa0ba902b7",
+			},
+		},
+		{
+			name:    "Without schema version",
+			headers: map[string][]byte{EventTypeHeader: []byte("OrderPaid")},
+			expectedEnvelope: Envelope{
+				EventType:     "OrderPaid",
+				SchemaVersion: LegacySchemaVersion,
+			},
+		},
+		{
+			name:          "Missing event type",
+			headers:       map[string][]byte{SchemaVersionHeader: []byte("1")},
+			expectedError: ErrMissingEventType,
+		},
+		{
+			name: "Schema version is not a number",
+			headers: map[string][]byte{
+				EventTypeHeader:     []byte("OrderPaid"),
+				SchemaVersionHeader: []byte("v2"),
+			},
+			expectedError: ErrUnsupportedSchemaVersion,
+		},
+		{
+			name: "Schema version below one",
+			headers: map[string][]byte{
+				EventTypeHeader:     []byte("OrderPaid"),
+				SchemaVersionHeader: []byte("0"),
+			},
+			expectedError: ErrUnsupportedSchemaVersion,
+		},
+		{
+			name: "Bad occurred_at",
+			headers: map[string][]byte{
+				EventTypeHeader:  []byte("OrderPaid"),
+				OccurredAtHeader: []byte("yesterday"),
+			},
+			expectedError: ErrInvalidEnvelope,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			envelope, err := ParseEnvelope(Message{Headers: tc.headers})
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.ErrorIs(t, err, ErrPermanent)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.expectedEnvelope.OccurredAt.Equal(envelope.OccurredAt))
+			envelope.OccurredAt = tc.expectedEnvelope.OccurredAt
+			assert.Equal(t, tc.expectedEnvelope, envelope)
+		})
+	}
+}
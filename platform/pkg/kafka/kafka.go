@@ -12,5 +12,10 @@ type Consumer interface {
 }
 
 type Producer interface {
+	// Send отправляет сообщение в топик продюсера.
 	Send(ctx context.Context, key, value []byte) error
+	// SendMessage отправляет сообщение с заголовками, Topic переопределяет топик продюсера.
+	SendMessage(ctx context.Context, msg ProducerMessage) error
+	// SendBatch отправляет несколько сообщений за один вызов.
+	SendBatch(ctx context.Context, msgs []ProducerMessage) error
 }
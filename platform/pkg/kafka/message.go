@@ -14,3 +14,15 @@ type Message struct {
 	Partition int32
 	Offset    int64
 }
+
+// ProducerMessage — исходящее сообщение Kafka.
+// Пустой Topic означает топик, заданный при создании продюсера.
+type ProducerMessage struct {
+	Topic   string
+	Key     []byte
+	Value   []byte
+	Headers map[string][]byte
+}
+
+// EventTypeHeader — заголовок с типом события в сообщении.
+const EventTypeHeader = "x-event-type"
@@ -0,0 +1,133 @@
+package producer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
+
+// asyncProducer — продюсер поверх sarama.AsyncProducer.
+// Send возвращается после постановки сообщения в очередь, результат доставки
+// приходит в DeliveryCallback. Требует Producer.Return.Successes = true.
+type asyncProducer struct {
+	asyncProducer sarama.AsyncProducer
+	topic         string
+	options       options
+	metrics       *producerMetrics
+	logger        Logger
+
+	wg sync.WaitGroup
+}
+
+// deliveryMetadata — данные вызова Send, привязанные к сообщению sarama.
+type deliveryMetadata struct {
+	ctx     context.Context
+	started time.Time
+	message kafka.ProducerMessage
+}
+
+// NewAsyncProducer создаёт асинхронный продюсер и запускает обработку результатов доставки.
+// Close останавливает продюсер и дожидается обработки всех результатов.
+func NewAsyncProducer(saramaProducer sarama.AsyncProducer, topic string, logger Logger, opts ...Option) *asyncProducer {
+	p := &asyncProducer{
+		asyncProducer: saramaProducer,
+		topic:         topic,
+		options:       newOptions(opts),
+		metrics:       newProducerMetrics(),
+		logger:        logger,
+	}
+
+	p.wg.Add(2)
+	go p.handleSuccesses()
+	go p.handleErrors()
+
+	return p
+}
+
+func (p *asyncProducer) Send(ctx context.Context, key, value []byte) error {
+	return p.SendMessage(ctx, kafka.ProducerMessage{Key: key, Value: value})
+}
+
+func (p *asyncProducer) SendMessage(ctx context.Context, msg kafka.ProducerMessage) error {
+	message, err := toSaramaMessage(ctx, p.topic, p.options, msg)
+	if err != nil {
+		return err
+	}
+
+	message.Metadata = deliveryMetadata{
+		ctx:     context.WithoutCancel(ctx),
+		started: time.Now(),
+		message: msg,
+	}
+
+	select {
+	case p.asyncProducer.Input() <- message:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *asyncProducer) SendBatch(ctx context.Context, msgs []kafka.ProducerMessage) error {
+	for _, msg := range msgs {
+		if err := p.SendMessage(ctx, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close закрывает sarama.AsyncProducer и ждёт, пока обработаются все результаты доставки.
+func (p *asyncProducer) Close() error {
+	err := p.asyncProducer.Close()
+	p.wg.Wait()
+
+	return err
+}
+
+func (p *asyncProducer) handleSuccesses() {
+	defer p.wg.Done()
+
+	for message := range p.asyncProducer.Successes() {
+		p.delivered(message, nil)
+	}
+}
+
+func (p *asyncProducer) handleErrors() {
+	defer p.wg.Done()
+
+	for producerErr := range p.asyncProducer.Errors() {
+		p.delivered(producerErr.Msg, producerErr.Err)
+	}
+}
+
+func (p *asyncProducer) delivered(message *sarama.ProducerMessage, err error) {
+	metadata, ok := message.Metadata.(deliveryMetadata)
+	if !ok {
+		metadata = deliveryMetadata{ctx: context.Background(), started: time.Now()}
+	}
+	ctx := metadata.ctx
+
+	p.metrics.record(ctx, message.Topic, metadata.started, err)
+	if err != nil {
+		p.logger.Error(ctx, "Failed to send message", zap.String("topic", message.Topic), zap.Error(err))
+	} else {
+		p.logger.Info(ctx, "Message sent",
+			messageFields(p.options, message.Topic, message.Partition, message.Offset, metadata.message.Key, metadata.message.Value)...)
+	}
+
+	if p.options.onDelivery != nil {
+		p.options.onDelivery(ctx, Delivery{
+			Message:   metadata.message,
+			Partition: message.Partition,
+			Offset:    message.Offset,
+			Err:       err,
+		})
+	}
+}
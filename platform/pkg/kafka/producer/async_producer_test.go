@@ -0,0 +1,100 @@
+package producer
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
+
+type ctxKey struct{}
+
+// deliveryRecorder collects the deliveries passed to the callback
+type deliveryRecorder struct {
+	mu         sync.Mutex
+	deliveries []Delivery
+	values     []any
+}
+
+func (r *deliveryRecorder) callback(ctx context.Context, delivery Delivery) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deliveries = append(r.deliveries, delivery)
+	r.values = append(r.values, ctx.Value(ctxKey{}))
+}
+
+func newMockAsyncProducer(t *testing.T) *mocks.AsyncProducer {
+	cfg := mocks.NewTestConfig()
+	cfg.Producer.Return.Successes = true
+
+	return mocks.NewAsyncProducer(t, cfg)
+}
+
+func TestAsyncProducerDeliveryCallback(t *testing.T) {
+	saramaProducer := newMockAsyncProducer(t)
+	saramaProducer.ExpectInputAndSucceed()
+	saramaProducer.ExpectInputAndFail(errTestBroker)
+
+	recorder := &deliveryRecorder{}
+	log := &recordingLogger{}
+	p := NewAsyncProducer(saramaProducer, testTopic, log, WithDeliveryCallback(recorder.callback))
+
+	// a cancelled caller context must not reach the callback as cancelled
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "request"))
+	require.NoError(t, p.Send(ctx, []byte("first"), []byte("1")))
+	require.NoError(t, p.SendMessage(ctx, kafka.ProducerMessage{Key: []byte("second"), Value: []byte("2")}))
+	cancel()
+	require.NoError(t, p.Close())
+
+	require.Len(t, recorder.deliveries, 2)
+	byKey := make(map[string]Delivery, len(recorder.deliveries))
+	for _, delivery := range recorder.deliveries {
+		byKey[string(delivery.Message.Key)] = delivery
+	}
+
+	assert.NoError(t, byKey["first"].Err)
+	assert.Equal(t, []byte("1"), byKey["first"].Message.Value)
+	assert.Positive(t, byKey["first"].Offset)
+	assert.ErrorIs(t, byKey["second"].Err, errTestBroker)
+	assert.Equal(t, []any{"request", "request"}, recorder.values)
+	assert.ElementsMatch(t, []string{"info", "error"}, log.levels())
+}
+
+func TestAsyncProducerSendBatch(t *testing.T) {
+	saramaProducer := newMockAsyncProducer(t)
+	saramaProducer.ExpectInputWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		assert.Equal(t, testTopic, msg.Topic)
+		return nil
+	})
+	saramaProducer.ExpectInputWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		assert.Equal(t, "order.created", msg.Topic)
+		return nil
+	})
+
+	recorder := &deliveryRecorder{}
+	p := NewAsyncProducer(saramaProducer, testTopic, &recordingLogger{}, WithDeliveryCallback(recorder.callback))
+
+	err := p.SendBatch(context.Background(), []kafka.ProducerMessage{
+		{Key: []byte("first")},
+		{Key: []byte("second"), Topic: "order.created"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, p.Close())
+
+	assert.Len(t, recorder.deliveries, 2)
+}
+
+func TestAsyncProducerSendWithoutTopic(t *testing.T) {
+	saramaProducer := newMockAsyncProducer(t)
+	p := NewAsyncProducer(saramaProducer, "", &recordingLogger{})
+
+	assert.ErrorIs(t, p.Send(context.Background(), nil, nil), errNoTopic)
+	require.NoError(t, p.Close())
+}
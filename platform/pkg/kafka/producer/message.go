@@ -0,0 +1,78 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
+)
+
+var errNoTopic = errors.New("kafka producer: topic is not set")
+
+// toSaramaMessage собирает сообщение: trace context, correlation ID и конверт события
+// из продюсера, поверх них — заголовки, переданные вызывающим.
+func toSaramaMessage(ctx context.Context, defaultTopic string, o options, msg kafka.ProducerMessage) (*sarama.ProducerMessage, error) {
+	topic := msg.Topic
+	if topic == "" {
+		topic = defaultTopic
+	}
+	if topic == "" {
+		return nil, errNoTopic
+	}
+
+	headers := make(map[string][]byte, len(msg.Headers)+6)
+	tracing.InjectKafkaHeaders(ctx, headers)
+	headers[kafka.OccurredAtHeader] = kafka.FormatOccurredAt(time.Now())
+	if o.eventType != "" {
+		headers[kafka.EventTypeHeader] = []byte(o.eventType)
+	}
+	if o.schemaVersion > 0 {
+		headers[kafka.SchemaVersionHeader] = []byte(strconv.Itoa(o.schemaVersion))
+	}
+	if o.producerService != "" {
+		headers[kafka.ProducerServiceHeader] = []byte(o.producerService)
+	}
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+
+	recordHeaders := make([]sarama.RecordHeader, 0, len(headers))
+	for k, v := range headers {
+		recordHeaders = append(recordHeaders, sarama.RecordHeader{Key: []byte(k), Value: v})
+	}
+
+	return &sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: recordHeaders,
+	}, nil
+}
+
+// messageFields — поля лога об отправленном сообщении, тело только при включённом логировании.
+func messageFields(o options, topic string, partition int32, offset int64, key, value []byte) []zap.Field {
+	fields := []zap.Field{
+		zap.String("topic", topic),
+		zap.Int32("partition", partition),
+		zap.Int64("offset", offset),
+		zap.String("key", string(key)),
+		zap.Int("value_size", len(value)),
+	}
+
+	if o.payloadLogLimit > 0 {
+		payload := value
+		if len(payload) > o.payloadLogLimit {
+			payload = payload[:o.payloadLogLimit]
+			fields = append(fields, zap.Bool("value_truncated", true))
+		}
+		fields = append(fields, zap.Binary("value", payload))
+	}
+
+	return fields
+}
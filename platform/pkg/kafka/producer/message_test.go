@@ -0,0 +1,61 @@
+package producer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestMessageFields(t *testing.T) {
+	testCases := []struct {
+		name              string
+		payloadLogLimit   int
+		value             []byte
+		expectedValue     []byte
+		expectedTruncated bool
+	}{
+		{
+			name:  "Payload logging disabled",
+			value: []byte("payload"),
+		},
+		{
+			name:            "Payload within the limit",
+			payloadLogLimit: 7,
+			value:           []byte("payload"),
+			expectedValue:   []byte("payload"),
+		},
+		{
+			name:              "Payload truncated to the limit",
+			payloadLogLimit:   3,
+			value:             []byte("payload"),
+			expectedValue:     []byte("pay"),
+			expectedTruncated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := newOptions([]Option{WithPayloadLogging(tc.payloadLogLimit)})
+
+			fields := make(map[string]zap.Field)
+			for _, field := range messageFields(o, testTopic, 2, 42, []byte("key"), tc.value) {
+				fields[field.Key] = field
+			}
+
+			assert.Equal(t, testTopic, fields["topic"].String)
+			assert.Equal(t, int64(2), fields["partition"].Integer)
+			assert.Equal(t, int64(42), fields["offset"].Integer)
+			assert.Equal(t, "key", fields["key"].String)
+			assert.Equal(t, int64(len(tc.value)), fields["value_size"].Integer)
+
+			value, logged := fields["value"]
+			assert.Equal(t, tc.expectedValue != nil, logged)
+			if logged {
+				assert.Equal(t, tc.expectedValue, value.Interface)
+			}
+			_, truncated := fields["value_truncated"]
+			assert.Equal(t, tc.expectedTruncated, truncated)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package producer
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "platform/kafka/producer"
+
+// producerMetrics — метрики отправки сообщений, с атрибутом topic.
+// Инструменты берутся из глобального MeterProvider и работают как no-op, пока он не настроен.
+type producerMetrics struct {
+	sent     metric.Int64Counter
+	failed   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+func newProducerMetrics() *producerMetrics {
+	meter := otel.Meter(meterName)
+
+	// ошибки создания инструментов не критичны: вместо них возвращаются no-op инструменты
+	sent, _ := meter.Int64Counter(
+		"kafka_producer_messages_sent_total",
+		metric.WithDescription("Total number of Kafka messages acknowledged by brokers"),
+	)
+	failed, _ := meter.Int64Counter(
+		"kafka_producer_messages_failed_total",
+		metric.WithDescription("Total number of Kafka messages that failed to be delivered"),
+	)
+	duration, _ := meter.Float64Histogram(
+		"kafka_producer_send_duration_seconds",
+		metric.WithDescription("Time from sending a Kafka message to its acknowledgement"),
+		metric.WithUnit("s"),
+	)
+
+	return &producerMetrics{
+		sent:     sent,
+		failed:   failed,
+		duration: duration,
+	}
+}
+
+func (m *producerMetrics) record(ctx context.Context, topic string, started time.Time, err error) {
+	attrs := metric.WithAttributes(attribute.String("topic", topic))
+
+	m.duration.Record(ctx, time.Since(started).Seconds(), attrs)
+	if err != nil {
+		m.failed.Add(ctx, 1, attrs)
+		return
+	}
+	m.sent.Add(ctx, 1, attrs)
+}
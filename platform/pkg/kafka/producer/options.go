@@ -0,0 +1,75 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
+
+// Delivery — результат доставки сообщения асинхронным продюсером.
+type Delivery struct {
+	Message   kafka.ProducerMessage
+	Partition int32
+	Offset    int64
+	Err       error
+}
+
+// DeliveryCallback вызывается асинхронным продюсером после подтверждения или ошибки доставки.
+// ctx — контекст вызова Send без отмены.
+type DeliveryCallback func(ctx context.Context, delivery Delivery)
+
+type options struct {
+	eventType       string
+	schemaVersion   int
+	producerService string
+	payloadLogLimit int
+	onDelivery      DeliveryCallback
+}
+
+// Option — настройка producer.
+type Option func(o *options)
+
+// WithEventType задаёт тип события, который передаётся в заголовке каждого сообщения.
+func WithEventType(eventType string) Option {
+	return func(o *options) {
+		o.eventType = eventType
+	}
+}
+
+// WithSchemaVersion задаёт версию схемы события для конверта.
+func WithSchemaVersion(version int) Option {
+	return func(o *options) {
+		o.schemaVersion = version
+	}
+}
+
+// WithProducerService задаёт имя сервиса-отправителя для конверта.
+func WithProducerService(service string) Option {
+	return func(o *options) {
+		o.producerService = service
+	}
+}
+
+// WithPayloadLogging включает логирование тела сообщения, обрезанного до limit байт.
+// По умолчанию тело не логируется.
+func WithPayloadLogging(limit int) Option {
+	return func(o *options) {
+		o.payloadLogLimit = limit
+	}
+}
+
+// WithDeliveryCallback задаёт callback доставки для асинхронного продюсера.
+func WithDeliveryCallback(callback DeliveryCallback) Option {
+	return func(o *options) {
+		o.onDelivery = callback
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
@@ -2,9 +2,13 @@ package producer
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/IBM/sarama"
 	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
 )
 
 type Logger interface {
@@ -15,35 +19,87 @@ type Logger interface {
 type producer struct {
 	syncProducer sarama.SyncProducer
 	topic        string
+	options      options
+	metrics      *producerMetrics
 	logger       Logger
 }
 
-func NewProducer(syncProducer sarama.SyncProducer, topic string, logger Logger) *producer {
+// NewProducer создаёт синхронный продюсер. topic — топик по умолчанию,
+// может быть пустым, если каждое сообщение задаёт свой топик.
+func NewProducer(syncProducer sarama.SyncProducer, topic string, logger Logger, opts ...Option) *producer {
 	return &producer{
 		syncProducer: syncProducer,
 		topic:        topic,
+		options:      newOptions(opts),
+		metrics:      newProducerMetrics(),
 		logger:       logger,
 	}
 }
 
 func (p *producer) Send(ctx context.Context, key, value []byte) error {
-	partition, offset, err := p.syncProducer.SendMessage(&sarama.ProducerMessage{
-		Topic: p.topic,
-		Key:   sarama.ByteEncoder(key),
-		Value: sarama.ByteEncoder(value),
-	})
+	return p.SendMessage(ctx, kafka.ProducerMessage{Key: key, Value: value})
+}
+
+func (p *producer) SendMessage(ctx context.Context, msg kafka.ProducerMessage) error {
+	message, err := toSaramaMessage(ctx, p.topic, p.options, msg)
+	if err != nil {
+		return err
+	}
+
+	started := time.Now()
+	partition, offset, err := p.syncProducer.SendMessage(message)
+	p.metrics.record(ctx, message.Topic, started, err)
 	if err != nil {
-		p.logger.Error(ctx, "Failed to send message", zap.Error(err))
+		p.logger.Error(ctx, "Failed to send message", zap.String("topic", message.Topic), zap.Error(err))
 		return err
 	}
 
-	p.logger.Info(ctx, "Message sent",
-		zap.String("topic", p.topic),
-		zap.Int32("partition", partition),
-		zap.Int64("offset", offset),
-		zap.String("key", string(key)),
-		zap.String("value", string(value)),
-	)
+	p.logger.Info(ctx, "Message sent", messageFields(p.options, message.Topic, partition, offset, msg.Key, msg.Value)...)
 
 	return nil
 }
+
+func (p *producer) SendBatch(ctx context.Context, msgs []kafka.ProducerMessage) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	messages := make([]*sarama.ProducerMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		message, err := toSaramaMessage(ctx, p.topic, p.options, msg)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, message)
+	}
+
+	started := time.Now()
+	err := p.syncProducer.SendMessages(messages)
+
+	// sarama возвращает ProducerErrors только по недоставленным сообщениям
+	failed := make(map[*sarama.ProducerMessage]error)
+	var producerErrs sarama.ProducerErrors
+	if errors.As(err, &producerErrs) {
+		for _, producerErr := range producerErrs {
+			failed[producerErr.Msg] = producerErr.Err
+		}
+	} else if err != nil {
+		for _, message := range messages {
+			failed[message] = err
+		}
+	}
+
+	for i, message := range messages {
+		msgErr := failed[message]
+		p.metrics.record(ctx, message.Topic, started, msgErr)
+		if msgErr != nil {
+			p.logger.Error(ctx, "Failed to send message", zap.String("topic", message.Topic), zap.Error(msgErr))
+			continue
+		}
+
+		p.logger.Info(ctx, "Message sent",
+			messageFields(p.options, message.Topic, message.Partition, message.Offset, msgs[i].Key, msgs[i].Value)...)
+	}
+
+	return err
+}
@@ -0,0 +1,173 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+)
+
+const testTopic = "order.paid"
+
+var errTestBroker = errors.New("broker unavailable")
+
+// logEntry is a log record captured by recordingLogger
+type logEntry struct {
+	level  string
+	msg    string
+	fields map[string]zap.Field
+}
+
+// recordingLogger keeps the log records of the producer under test
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (l *recordingLogger) Info(_ context.Context, msg string, fields ...zap.Field) {
+	l.record("info", msg, fields)
+}
+
+func (l *recordingLogger) Error(_ context.Context, msg string, fields ...zap.Field) {
+	l.record("error", msg, fields)
+}
+
+func (l *recordingLogger) record(level, msg string, fields []zap.Field) {
+	entry := logEntry{level: level, msg: msg, fields: make(map[string]zap.Field, len(fields))}
+	for _, field := range fields {
+		entry.fields[field.Key] = field
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+}
+
+func (l *recordingLogger) levels() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	levels := make([]string, 0, len(l.entries))
+	for _, entry := range l.entries {
+		levels = append(levels, entry.level)
+	}
+
+	return levels
+}
+
+// batchSyncProducer delivers a batch and fails the messages listed in failed
+// the way sarama does: with ProducerErrors for the undelivered ones only
+type batchSyncProducer struct {
+	sarama.SyncProducer
+
+	failed   map[int]error
+	batchErr error
+	sent     []*sarama.ProducerMessage
+}
+
+func (p *batchSyncProducer) SendMessages(msgs []*sarama.ProducerMessage) error {
+	p.sent = msgs
+	if p.batchErr != nil {
+		return p.batchErr
+	}
+
+	var producerErrs sarama.ProducerErrors
+	for i, msg := range msgs {
+		if err, ok := p.failed[i]; ok {
+			producerErrs = append(producerErrs, &sarama.ProducerError{Msg: msg, Err: err})
+			continue
+		}
+		msg.Partition = 1
+		msg.Offset = int64(i + 10)
+	}
+	if len(producerErrs) > 0 {
+		return producerErrs
+	}
+
+	return nil
+}
+
+func TestProducerSendBatch(t *testing.T) {
+	msgs := []kafka.ProducerMessage{
+		{Key: []byte("first"), Value: []byte("1")},
+		{Key: []byte("second"), Value: []byte("2"), Topic: "order.created"},
+		{Key: []byte("third"), Value: []byte("3")},
+	}
+
+	testCases := []struct {
+		name           string
+		failed         map[int]error
+		batchErr       error
+		expectedLevels []string
+	}{
+		{
+			name:           "All messages delivered",
+			expectedLevels: []string{"info", "info", "info"},
+		},
+		{
+			name:           "One message failed",
+			failed:         map[int]error{1: errTestBroker},
+			expectedLevels: []string{"info", "error", "info"},
+		},
+		{
+			name:           "Whole batch failed",
+			batchErr:       errTestBroker,
+			expectedLevels: []string{"error", "error", "error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			syncProducer := &batchSyncProducer{failed: tc.failed, batchErr: tc.batchErr}
+			log := &recordingLogger{}
+			p := NewProducer(syncProducer, testTopic, log)
+
+			err := p.SendBatch(context.Background(), msgs)
+
+			switch {
+			case tc.batchErr != nil:
+				assert.ErrorIs(t, err, tc.batchErr)
+			case tc.failed != nil:
+				var producerErrs sarama.ProducerErrors
+				require.ErrorAs(t, err, &producerErrs)
+				assert.Len(t, producerErrs, len(tc.failed))
+			default:
+				require.NoError(t, err)
+			}
+			require.Len(t, syncProducer.sent, len(msgs))
+			assert.Equal(t, testTopic, syncProducer.sent[0].Topic)
+			assert.Equal(t, "order.created", syncProducer.sent[1].Topic)
+			assert.Equal(t, tc.expectedLevels, log.levels())
+			for i, entry := range log.entries {
+				if entry.level == "info" {
+					assert.Equal(t, int64(i+10), entry.fields["offset"].Integer)
+				}
+			}
+		})
+	}
+}
+
+func TestProducerSendBatchEmpty(t *testing.T) {
+	syncProducer := &batchSyncProducer{}
+	p := NewProducer(syncProducer, testTopic, &recordingLogger{})
+
+	require.NoError(t, p.SendBatch(context.Background(), nil))
+	assert.Nil(t, syncProducer.sent)
+}
+
+func TestProducerSendBatchWithoutTopic(t *testing.T) {
+	syncProducer := &batchSyncProducer{}
+	p := NewProducer(syncProducer, "", &recordingLogger{})
+
+	err := p.SendBatch(context.Background(), []kafka.ProducerMessage{{Topic: testTopic}, {}})
+
+	assert.ErrorIs(t, err, errNoTopic)
+	assert.Nil(t, syncProducer.sent)
+}
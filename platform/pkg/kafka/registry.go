@@ -0,0 +1,93 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPermanent помечает ошибки обработки, которые не исправятся повтором:
+// такие сообщения сразу уходят в dead-letter топик.
+var ErrPermanent = errors.New("permanent kafka message error")
+
+var (
+	ErrMissingEventType         = fmt.Errorf("%w: event type header is missing", ErrPermanent)
+	ErrUnknownEventType         = fmt.Errorf("%w: unknown event type", ErrPermanent)
+	ErrUnsupportedSchemaVersion = fmt.Errorf("%w: unsupported schema version", ErrPermanent)
+	ErrInvalidEnvelope          = fmt.Errorf("%w: invalid event envelope", ErrPermanent)
+	ErrInvalidPayload           = fmt.Errorf("%w: invalid event payload", ErrPermanent)
+)
+
+// DecodeFunc декодирует тело события одной версии схемы.
+type DecodeFunc func(data []byte) (any, error)
+
+// Event — декодированное событие вместе с конвертом.
+type Event struct {
+	Envelope Envelope
+	Payload  any
+}
+
+type decoderKey struct {
+	eventType string
+	version   int
+}
+
+// DecoderRegistry выбирает декодер по типу события и версии схемы из конверта.
+// Регистрируется только на старте сервиса, дальше используется только для чтения.
+type DecoderRegistry struct {
+	decoders        map[decoderKey]DecodeFunc
+	legacyEventType string
+}
+
+func NewDecoderRegistry() *DecoderRegistry {
+	return &DecoderRegistry{
+		decoders: make(map[decoderKey]DecodeFunc),
+	}
+}
+
+// Register добавляет декодер для типа события и версии схемы.
+func (r *DecoderRegistry) Register(eventType string, version int, decode DecodeFunc) *DecoderRegistry {
+	r.decoders[decoderKey{eventType: eventType, version: version}] = decode
+	return r
+}
+
+// WithLegacyEventType задаёт тип событий, отправленных в топик до появления EventTypeHeader:
+// сообщения без заголовка типа декодируются как eventType версии LegacySchemaVersion.
+// Без него такие сообщения возвращают ErrMissingEventType.
+func (r *DecoderRegistry) WithLegacyEventType(eventType string) *DecoderRegistry {
+	r.legacyEventType = eventType
+	return r
+}
+
+// Decode читает конверт и декодирует тело подходящим декодером.
+// Неизвестные тип или версия возвращают ErrUnknownEventType или ErrUnsupportedSchemaVersion.
+func (r *DecoderRegistry) Decode(msg Message) (Event, error) {
+	envelope, err := parseEnvelope(msg, r.legacyEventType)
+	if err != nil {
+		return Event{}, err
+	}
+
+	decode, ok := r.decoders[decoderKey{eventType: envelope.EventType, version: envelope.SchemaVersion}]
+	if !ok {
+		if r.knowsType(envelope.EventType) {
+			return Event{}, fmt.Errorf("%w: %s v%d", ErrUnsupportedSchemaVersion, envelope.EventType, envelope.SchemaVersion)
+		}
+		return Event{}, fmt.Errorf("%w: %s", ErrUnknownEventType, envelope.EventType)
+	}
+
+	payload, err := decode(msg.Value)
+	if err != nil {
+		return Event{}, fmt.Errorf("%w: %s v%d: %v", ErrInvalidPayload, envelope.EventType, envelope.SchemaVersion, err)
+	}
+
+	return Event{Envelope: envelope, Payload: payload}, nil
+}
+
+func (r *DecoderRegistry) knowsType(eventType string) bool {
+	for key := range r.decoders {
+		if key.eventType == eventType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,110 @@
+package kafka
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeString(data []byte) (any, error) {
+	return string(data), nil
+}
+
+func decodeUpper(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty payload")
+	}
+	return "v2:" + string(data), nil
+}
+
+func TestDecoderRegistryDecode(t *testing.T) {
+	registry := NewDecoderRegistry().
+		Register("OrderPaid", 1, decodeString).
+		Register("OrderPaid", 2, decodeUpper)
+
+	testCases := []struct {
+		name            string
+		registry        *DecoderRegistry
+		headers         map[string][]byte
+		value           string
+		expectedType    string
+		expectedPayload any
+		expectedError   error
+	}{
+		{
+			name:            "Registered version",
+			registry:        registry,
+			headers:         map[string][]byte{EventTypeHeader: []byte("OrderPaid"), SchemaVersionHeader: []byte("2")},
+			value:           "payload",
+			expectedType:    "OrderPaid",
+			expectedPayload: "v2:payload",
+		},
+		{
+			name:            "Without schema version",
+			registry:        registry,
+			headers:         map[string][]byte{EventTypeHeader: []byte("OrderPaid")},
+			value:           "payload",
+			expectedType:    "OrderPaid",
+			expectedPayload: "payload",
+		},
+		{
+			name:          "Missing event type",
+			registry:      registry,
+			headers:       map[string][]byte{},
+			value:         "payload",
+			expectedError: ErrMissingEventType,
+		},
+		{
+			name:            "Missing event type with a legacy event type",
+			registry:        NewDecoderRegistry().Register("OrderPaid", 1, decodeString).WithLegacyEventType("OrderPaid"),
+			headers:         map[string][]byte{},
+			value:           "payload",
+			expectedType:    "OrderPaid",
+			expectedPayload: "payload",
+		},
+		{
+			name:          "Unknown event type",
+			registry:      registry,
+			headers:       map[string][]byte{EventTypeHeader: []byte("OrderCreated")},
+			value:         "payload",
+			expectedError: ErrUnknownEventType,
+		},
+		{
+			name:          "Unknown version",
+			registry:      registry,
+			headers:       map[string][]byte{EventTypeHeader: []byte("OrderPaid"), SchemaVersionHeader: []byte("3")},
+			value:         "payload",
+			expectedError: ErrUnsupportedSchemaVersion,
+		},
+		{
+			name:          "Bad occurred_at",
+			registry:      registry,
+			headers:       map[string][]byte{EventTypeHeader: []byte("OrderPaid"), OccurredAtHeader: []byte("2026-13-45")},
+			value:         "payload",
+			expectedError: ErrInvalidEnvelope,
+		},
+		{
+			name:          "Invalid payload",
+			registry:      registry,
+			headers:       map[string][]byte{EventTypeHeader: []byte("OrderPaid"), SchemaVersionHeader: []byte("2")},
+			expectedError: ErrInvalidPayload,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := tc.registry.Decode(Message{Headers: tc.headers, Value: []byte(tc.value)})
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.ErrorIs(t, err, ErrPermanent)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedType, event.Envelope.EventType)
+			assert.Equal(t, tc.expectedPayload, event.Payload)
+		})
+	}
+}
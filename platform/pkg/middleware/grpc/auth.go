@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 
 	"google.golang.org/grpc"
@@ -16,6 +17,11 @@ import (
 const (
 	// SessionUUIDMetadataKey ключ для передачи UUID сессии в gRPC metadata
 	SessionUUIDMetadataKey = "session-uuid"
+	// ServiceTokenMetadataKey ключ для передачи токена сервиса в gRPC metadata
+	ServiceTokenMetadataKey = "service-token"
+
+	// RoleService роль, которую получают вызовы других сервисов по токену сервиса
+	RoleService = "service"
 )
 
 type contextKey string
@@ -32,16 +38,27 @@ type IAMClient = authV1.AuthServiceClient
 
 // AuthInterceptor interceptor для аутентификации gRPC запросов
 type AuthInterceptor struct {
-	iamClient IAMClient
+	iamClient    IAMClient
+	policy       *Policy
+	serviceToken string
 }
 
-// NewAuthInterceptor создает новый interceptor аутентификации
-func NewAuthInterceptor(iamClient IAMClient) *AuthInterceptor {
+// NewAuthInterceptor создает новый interceptor аутентификации.
+// policy может быть nil — тогда проверяется только валидность сессии
+func NewAuthInterceptor(iamClient IAMClient, policy *Policy) *AuthInterceptor {
 	return &AuthInterceptor{
 		iamClient: iamClient,
+		policy:    policy,
 	}
 }
 
+// WithServiceToken разрешает вызовы других сервисов по общему токену. Такие вызовы
+// проходят без сессии и получают роль RoleService; пустой токен их запрещает
+func (i *AuthInterceptor) WithServiceToken(token string) *AuthInterceptor {
+	i.serviceToken = token
+	return i
+}
+
 // Unary возвращает unary server interceptor для аутентификации
 func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(
@@ -55,6 +72,11 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return nil, err
 		}
 
+		user, _ := GetUserFromContext(authCtx)
+		if !i.policy.Allowed(methodTarget(info.FullMethod), user) {
+			return nil, status.Error(codes.PermissionDenied, "insufficient role")
+		}
+
 		return handler(authCtx, req)
 	}
 }
@@ -67,6 +89,14 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, er
 		return nil, status.Error(codes.Unauthenticated, "missing metadata")
 	}
 
+	// Вызов другого сервиса проверяется по токену без обращения к IAM
+	if serviceTokens := md.Get(ServiceTokenMetadataKey); i.serviceToken != "" && len(serviceTokens) > 0 {
+		if subtle.ConstantTimeCompare([]byte(serviceTokens[0]), []byte(i.serviceToken)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid service token")
+		}
+		return context.WithValue(ctx, userContextKey, &commonV1.User{Roles: []string{RoleService}}), nil
+	}
+
 	// Получаем session UUID из metadata
 	sessionUUIDs := md.Get(SessionUUIDMetadataKey)
 	if len(sessionUUIDs) == 0 {
@@ -92,6 +122,19 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, er
 	return authCtx, nil
 }
 
+// SessionUUIDFromIncomingMetadata возвращает session UUID из входящих gRPC metadata
+// или пустую строку, если его нет
+func SessionUUIDFromIncomingMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(SessionUUIDMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
 // GetUserFromContext извлекает пользователя из контекста
 func GetUserFromContext(ctx context.Context) (*commonV1.User, bool) {
 	user, ok := ctx.Value(userContextKey).(*commonV1.User)
@@ -127,3 +170,12 @@ func ForwardSessionUUIDToGRPC(ctx context.Context) context.Context {
 
 	return metadata.AppendToOutgoingContext(ctx, SessionUUIDMetadataKey, sessionUUID)
 }
+
+// ForwardServiceTokenToGRPC добавляет токен сервиса в исходящие gRPC metadata
+func ForwardServiceTokenToGRPC(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, ServiceTokenMetadataKey, token)
+}
@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	authV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/auth/v1"
+	commonV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/common/v1"
+)
+
+// fakeIAMClient knows a single session, other methods of the client are not used
+type fakeIAMClient struct {
+	authV1.AuthServiceClient
+	sessionUUID string
+	user        *commonV1.User
+}
+
+func (c *fakeIAMClient) WhoAmI(_ context.Context, req *authV1.WhoAmIRequest, _ ...grpc.CallOption) (*authV1.WhoAmIResponse, error) {
+	if req.GetSessionUuid() != c.sessionUUID {
+		return nil, errors.New("session not found")
+	}
+	return &authV1.WhoAmIResponse{User: c.user}, nil
+}
+
+func TestAuthInterceptorUnary(t *testing.T) {
+	iamClient := &fakeIAMClient{
+		sessionUUID: "session-1",
+		user:        &commonV1.User{Uuid: "user-1"},
+	}
+	policy := NewPolicy().Require("InventoryService/ReserveParts", RoleService, "admin")
+
+	testCases := []struct {
+		name         string
+		serviceToken string
+		md           metadata.MD
+		method       string
+		expectedCode codes.Code
+		expectedUser *commonV1.User
+	}{
+		{
+			name:         "Service token",
+			serviceToken: "secret",
+			md:           metadata.Pairs(ServiceTokenMetadataKey, "secret"),
+			method:       "/inventory.v1.InventoryService/ReserveParts",
+			expectedCode: codes.OK,
+			expectedUser: &commonV1.User{Roles: []string{RoleService}},
+		},
+		{
+			name:         "Wrong service token",
+			serviceToken: "secret",
+			md:           metadata.Pairs(ServiceTokenMetadataKey, "guess"),
+			method:       "/inventory.v1.InventoryService/ReserveParts",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Service token is not accepted when none is configured",
+			md:           metadata.Pairs(ServiceTokenMetadataKey, ""),
+			method:       "/inventory.v1.InventoryService/ReserveParts",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "User session without the role",
+			serviceToken: "secret",
+			md:           metadata.Pairs(SessionUUIDMetadataKey, "session-1"),
+			method:       "/inventory.v1.InventoryService/ReserveParts",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "User session on an open method",
+			serviceToken: "secret",
+			md:           metadata.Pairs(SessionUUIDMetadataKey, "session-1"),
+			method:       "/inventory.v1.InventoryService/GetPart",
+			expectedCode: codes.OK,
+			expectedUser: iamClient.user,
+		},
+		{
+			name:         "Unknown session",
+			md:           metadata.Pairs(SessionUUIDMetadataKey, "session-2"),
+			method:       "/inventory.v1.InventoryService/GetPart",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Missing metadata",
+			method:       "/inventory.v1.InventoryService/GetPart",
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			interceptor := NewAuthInterceptor(iamClient, policy).WithServiceToken(tc.serviceToken).Unary()
+
+			ctx := context.Background()
+			if tc.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.md)
+			}
+
+			var handlerUser *commonV1.User
+			handler := func(ctx context.Context, _ any) (any, error) {
+				handlerUser, _ = GetUserFromContext(ctx)
+				return "ok", nil
+			}
+
+			resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			if tc.expectedCode != codes.OK {
+				assert.Nil(t, resp)
+				return
+			}
+			assert.Equal(t, "ok", resp)
+			assert.Equal(t, tc.expectedUser, handlerUser)
+		})
+	}
+}
+
+func TestForwardServiceTokenToGRPC(t *testing.T) {
+	ctx := ForwardServiceTokenToGRPC(context.Background(), "secret")
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, []string{"secret"}, md.Get(ServiceTokenMetadataKey))
+
+	ctx = ForwardServiceTokenToGRPC(context.Background(), "")
+	_, ok = metadata.FromOutgoingContext(ctx)
+	assert.False(t, ok)
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	commonV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/common/v1"
+)
+
+// policyRule связывает шаблон метода с ролями, которым он разрешен
+type policyRule struct {
+	pattern string
+	roles   []string
+}
+
+// Policy таблица авторизации: шаблон метода -> требуемые роли.
+//
+// Шаблоны сопоставляются через path.Match. Для gRPC цель имеет вид
+// "InventoryService/GetPart" (короткое имя сервиса и метод), для HTTP —
+// "GET /api/v1/orders/*". Побеждает первое совпавшее правило; методы без
+// правил доступны любому аутентифицированному пользователю.
+type Policy struct {
+	rules []policyRule
+}
+
+// NewPolicy создает пустую таблицу авторизации
+func NewPolicy() *Policy {
+	return &Policy{}
+}
+
+// Require добавляет правило: pattern доступен только пользователям с одной из ролей
+func (p *Policy) Require(pattern string, roles ...string) *Policy {
+	p.rules = append(p.rules, policyRule{pattern: pattern, roles: roles})
+	return p
+}
+
+// Allowed проверяет, может ли пользователь вызвать target
+func (p *Policy) Allowed(target string, user *commonV1.User) bool {
+	if p == nil {
+		return true
+	}
+
+	for _, rule := range p.rules {
+		if ok, _ := path.Match(rule.pattern, target); !ok {
+			continue
+		}
+
+		for _, role := range rule.roles {
+			if HasRole(user, role) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// HasRole проверяет наличие роли у пользователя
+func HasRole(user *commonV1.User, role string) bool {
+	return slices.Contains(user.GetRoles(), role)
+}
+
+// methodTarget приводит "/inventory.v1.InventoryService/GetPart" к "InventoryService/GetPart"
+func methodTarget(fullMethod string) string {
+	service, method := path.Split(strings.TrimPrefix(fullMethod, "/"))
+	service = strings.TrimSuffix(service, "/")
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+	return service + "/" + method
+}
@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonV1 "github.com/dexguitar/spacecraftory/shared/pkg/proto/common/v1"
+)
+
+func TestPolicyAllowed(t *testing.T) {
+	policy := NewPolicy().
+		Require("InventoryService/ReserveParts", "inventory_admin", "admin").
+		Require("InventoryService/*Reservation", "admin").
+		Require("POST /api/v1/orders/*/refund", "admin")
+
+	admin := &commonV1.User{Roles: []string{"admin"}}
+	inventoryAdmin := &commonV1.User{Roles: []string{"inventory_admin"}}
+	customer := &commonV1.User{}
+
+	testCases := []struct {
+		name     string
+		policy   *Policy
+		target   string
+		user     *commonV1.User
+		expected bool
+	}{
+		{
+			name:     "Nil policy allows everything",
+			target:   "InventoryService/ReserveParts",
+			user:     customer,
+			expected: true,
+		},
+		{
+			name:     "Method without rules is open",
+			policy:   policy,
+			target:   "InventoryService/GetPart",
+			user:     customer,
+			expected: true,
+		},
+		{
+			name:     "Any of the required roles",
+			policy:   policy,
+			target:   "InventoryService/ReserveParts",
+			user:     inventoryAdmin,
+			expected: true,
+		},
+		{
+			name:     "Missing role",
+			policy:   policy,
+			target:   "InventoryService/ReserveParts",
+			user:     customer,
+			expected: false,
+		},
+		{
+			name:     "Wildcard pattern",
+			policy:   policy,
+			target:   "InventoryService/CommitReservation",
+			user:     inventoryAdmin,
+			expected: false,
+		},
+		{
+			name:     "Wildcard pattern with the role",
+			policy:   policy,
+			target:   "InventoryService/ReleaseReservation",
+			user:     admin,
+			expected: true,
+		},
+		{
+			name:     "HTTP target",
+			policy:   policy,
+			target:   "POST /api/v1/orders/123e4567-e89b-12d3-a456-426614174000/refund",
+			user:     customer,
+			expected: false,
+		},
+		{
+			name:     "Wildcard does not cross path segments",
+			policy:   policy,
+			target:   "POST /api/v1/orders/123/items/refund",
+			user:     customer,
+			expected: true,
+		},
+		{
+			name:     "Missing user",
+			policy:   policy,
+			target:   "InventoryService/ReserveParts",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.policy.Allowed(tc.target, tc.user))
+		})
+	}
+}
+
+func TestPolicyFirstMatchWins(t *testing.T) {
+	policy := NewPolicy().
+		Require("InventoryService/GetPart", "admin").
+		Require("InventoryService/*", "inventory_admin")
+
+	inventoryAdmin := &commonV1.User{Roles: []string{"inventory_admin"}}
+
+	assert.False(t, policy.Allowed("InventoryService/GetPart", inventoryAdmin))
+	assert.True(t, policy.Allowed("InventoryService/ListParts", inventoryAdmin))
+}
+
+func TestMethodTarget(t *testing.T) {
+	testCases := []struct {
+		fullMethod string
+		expected   string
+	}{
+		{fullMethod: "/inventory.v1.InventoryService/GetPart", expected: "InventoryService/GetPart"},
+		{fullMethod: "/auth.v1.AuthService/WhoAmI", expected: "AuthService/WhoAmI"},
+		{fullMethod: "/HealthService/Check", expected: "HealthService/Check"},
+		{fullMethod: "/grpc.health.v1.Health/Check", expected: "Health/Check"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.fullMethod, func(t *testing.T) {
+			assert.Equal(t, tc.expected, methodTarget(tc.fullMethod))
+		})
+	}
+}
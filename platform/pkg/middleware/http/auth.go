@@ -17,12 +17,15 @@ type IAMClient = authV1.AuthServiceClient
 // AuthMiddleware middleware для аутентификации HTTP запросов
 type AuthMiddleware struct {
 	iamClient IAMClient
+	policy    *grpcAuth.Policy
 }
 
-// NewAuthMiddleware создает новый middleware аутентификации
-func NewAuthMiddleware(iamClient IAMClient) *AuthMiddleware {
+// NewAuthMiddleware создает новый middleware аутентификации.
+// policy может быть nil — тогда проверяется только валидность сессии
+func NewAuthMiddleware(iamClient IAMClient, policy *grpcAuth.Policy) *AuthMiddleware {
 	return &AuthMiddleware{
 		iamClient: iamClient,
+		policy:    policy,
 	}
 }
 
@@ -46,6 +49,12 @@ func (m *AuthMiddleware) Handle(next http.Handler) http.Handler {
 			return
 		}
 
+		// Проверяем роли по таблице авторизации ("METHOD /path")
+		if !m.policy.Allowed(r.Method+" "+r.URL.Path, whoamiRes.User) {
+			writeErrorResponse(w, http.StatusForbidden, "FORBIDDEN", "Insufficient role")
+			return
+		}
+
 		// Добавляем пользователя и session UUID в контекст используя функции из grpc middleware
 		ctx := r.Context()
 		ctx = grpcAuth.AddUserToContext(ctx, whoamiRes.User)
@@ -65,3 +74,8 @@ func GetUserFromContext(ctx context.Context) (*commonV1.User, bool) {
 func GetSessionUUIDFromContext(ctx context.Context) (string, bool) {
 	return grpcAuth.GetSessionUUIDFromContext(ctx)
 }
+
+// HasRole проверяет наличие роли у пользователя
+func HasRole(user *commonV1.User, role string) bool {
+	return grpcAuth.HasRole(user, role)
+}
@@ -0,0 +1,49 @@
+package kafka
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka/consumer"
+	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
+)
+
+// Tracing продолжает trace продюсера: извлекает trace context и correlation ID
+// из заголовков сообщения и оборачивает обработку в span консьюмера.
+func Tracing(serviceName string) consumer.Middleware {
+	tracer := otel.GetTracerProvider().Tracer(serviceName)
+
+	return func(next kafka.MessageHandler) kafka.MessageHandler {
+		return func(ctx context.Context, msg kafka.Message) error {
+			ctx = tracing.ExtractKafkaHeaders(ctx, msg.Headers)
+
+			ctx, span := tracer.Start(
+				ctx,
+				msg.Topic+" process",
+				trace.WithSpanKind(trace.SpanKindConsumer),
+				trace.WithAttributes(
+					attribute.String("messaging.system", "kafka"),
+					attribute.String("messaging.destination.name", msg.Topic),
+					attribute.Int("messaging.kafka.destination.partition", int(msg.Partition)),
+					attribute.Int64("messaging.kafka.message.offset", msg.Offset),
+					attribute.String("messaging.event_type", string(msg.Headers[kafka.EventTypeHeader])),
+					attribute.String("messaging.correlation_id", tracing.CorrelationIDFromContext(ctx)),
+				),
+			)
+			defer span.End()
+
+			err := next(ctx, msg)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return err
+		}
+	}
+}
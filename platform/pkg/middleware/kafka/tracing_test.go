@@ -0,0 +1,97 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dexguitar/spacecraftory/platform/pkg/kafka"
+	"github.com/dexguitar/spacecraftory/platform/pkg/tracing"
+)
+
+// useRecordingTracer направляет span'ы глобального tracer provider в recorder
+func useRecordingTracer(t *testing.T) *tracetest.SpanRecorder {
+	previousProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	return recorder
+}
+
+// producedMessage возвращает сообщение с заголовками, которые записал бы продюсер, и span продюсера
+func producedMessage(t *testing.T) (kafka.Message, trace.SpanContext) {
+	ctx, span := otel.Tracer("producer").Start(context.Background(), "order.paid publish")
+	span.End()
+
+	headers := map[string][]byte{
+		kafka.EventTypeHeader: []byte("OrderPaid"),
+	}
+	tracing.InjectKafkaHeaders(tracing.WithCorrelationID(ctx, "order-123"), headers)
+
+	return kafka.Message{
+		Topic:     "order.paid",
+		Partition: 1,
+		Offset:    42,
+		Headers:   headers,
+	}, span.SpanContext()
+}
+
+func TestTracingContinuesProducerTrace(t *testing.T) {
+	recorder := useRecordingTracer(t)
+	msg, producerSpan := producedMessage(t)
+
+	var handlerCtx context.Context
+	handler := Tracing("order")(func(ctx context.Context, _ kafka.Message) error {
+		handlerCtx = ctx
+		return nil
+	})
+
+	require.NoError(t, handler(context.Background(), msg))
+
+	handlerSpan := trace.SpanContextFromContext(handlerCtx)
+	assert.Equal(t, producerSpan.TraceID(), handlerSpan.TraceID())
+	assert.NotEqual(t, producerSpan.SpanID(), handlerSpan.SpanID())
+	assert.Equal(t, "order-123", tracing.CorrelationIDFromContext(handlerCtx))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	consumerSpan := spans[1]
+	assert.Equal(t, "order.paid process", consumerSpan.Name())
+	assert.Equal(t, trace.SpanKindConsumer, consumerSpan.SpanKind())
+	assert.Equal(t, producerSpan.SpanID(), consumerSpan.Parent().SpanID())
+	assert.Equal(t, handlerSpan.SpanID(), consumerSpan.SpanContext().SpanID())
+}
+
+func TestTracingRecordsHandlerError(t *testing.T) {
+	recorder := useRecordingTracer(t)
+	msg, _ := producedMessage(t)
+
+	failure := errors.New("order not found")
+	handler := Tracing("order")(func(context.Context, kafka.Message) error {
+		return failure
+	})
+
+	assert.ErrorIs(t, handler(context.Background(), msg), failure)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, failure.Error(), spans[1].Status().Description)
+}
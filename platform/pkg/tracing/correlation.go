@@ -0,0 +1,25 @@
+package tracing
+
+import "context"
+
+// CorrelationIDHeader is the Kafka header key for the correlation ID
+const CorrelationIDHeader = "x-correlation-id"
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a context carrying the correlation ID.
+// The correlation ID ties together all messages caused by one business operation,
+// even when they end up in different traces.
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, correlationID)
+}
+
+// CorrelationIDFromContext returns the correlation ID of ctx.
+// Falls back to the trace ID and returns an empty string if neither is set.
+func CorrelationIDFromContext(ctx context.Context) string {
+	if correlationID, ok := ctx.Value(correlationIDKey{}).(string); ok && correlationID != "" {
+		return correlationID
+	}
+
+	return TraceIDFromContext(ctx)
+}
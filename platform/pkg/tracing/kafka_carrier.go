@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+)
+
+// kafkaHeadersCarrier is an adapter between Kafka message headers and OpenTelemetry's TextMapCarrier.
+type kafkaHeadersCarrier map[string][]byte
+
+// Get returns the value for the specified key.
+// Returns an empty string if the key doesn't exist.
+func (c kafkaHeadersCarrier) Get(key string) string {
+	return string(c[key])
+}
+
+// Set sets the value for the specified key.
+func (c kafkaHeadersCarrier) Set(key, value string) {
+	c[key] = []byte(value)
+}
+
+// Keys returns a list of all keys in the carrier.
+func (c kafkaHeadersCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// InjectKafkaHeaders writes the trace context (W3C traceparent, baggage) and
+// the correlation ID of ctx into Kafka message headers.
+func InjectKafkaHeaders(ctx context.Context, headers map[string][]byte) {
+	otel.GetTextMapPropagator().Inject(ctx, kafkaHeadersCarrier(headers))
+
+	if correlationID := CorrelationIDFromContext(ctx); correlationID != "" {
+		headers[CorrelationIDHeader] = []byte(correlationID)
+	}
+}
+
+// ExtractKafkaHeaders returns ctx enriched with the trace context and the
+// correlation ID found in Kafka message headers.
+func ExtractKafkaHeaders(ctx context.Context, headers map[string][]byte) context.Context {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, kafkaHeadersCarrier(headers))
+
+	if correlationID := string(headers[CorrelationIDHeader]); correlationID != "" {
+		ctx = WithCorrelationID(ctx, correlationID)
+	}
+
+	return ctx
+}
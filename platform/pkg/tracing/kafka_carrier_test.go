@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func useTraceContextPropagator(t *testing.T) {
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+}
+
+func TestKafkaHeadersRoundTrip(t *testing.T) {
+	useTraceContextPropagator(t)
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "produce")
+	defer span.End()
+	ctx = WithCorrelationID(ctx, "order-123")
+
+	headers := map[string][]byte{}
+	InjectKafkaHeaders(ctx, headers)
+
+	require.Contains(t, headers, "traceparent")
+	assert.Equal(t, "order-123", string(headers[CorrelationIDHeader]))
+
+	extracted := ExtractKafkaHeaders(context.Background(), headers)
+
+	spanContext := trace.SpanContextFromContext(extracted)
+	assert.True(t, spanContext.IsRemote())
+	assert.Equal(t, span.SpanContext().TraceID(), spanContext.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), spanContext.SpanID())
+	assert.Equal(t, "order-123", CorrelationIDFromContext(extracted))
+}
+
+func TestInjectKafkaHeadersFallsBackToTraceID(t *testing.T) {
+	useTraceContextPropagator(t)
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "produce")
+	defer span.End()
+
+	headers := map[string][]byte{}
+	InjectKafkaHeaders(ctx, headers)
+
+	// without a correlation ID the trace ID ties the messages together
+	assert.Equal(t, span.SpanContext().TraceID().String(), string(headers[CorrelationIDHeader]))
+}
+
+func TestExtractKafkaHeadersWithoutTrace(t *testing.T) {
+	useTraceContextPropagator(t)
+
+	// messages produced before tracing carry no trace headers
+	extracted := ExtractKafkaHeaders(context.Background(), map[string][]byte{})
+
+	assert.False(t, trace.SpanContextFromContext(extracted).IsValid())
+	assert.Empty(t, CorrelationIDFromContext(extracted))
+}
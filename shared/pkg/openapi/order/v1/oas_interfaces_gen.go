@@ -13,6 +13,18 @@ type GetOrderByUUIDRes interface {
 	getOrderByUUIDRes()
 }
 
+type GetOrderHistoryRes interface {
+	getOrderHistoryRes()
+}
+
+type ListOrdersRes interface {
+	listOrdersRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
+
+type RefundOrderRes interface {
+	refundOrderRes()
+}
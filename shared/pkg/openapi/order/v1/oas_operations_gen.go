@@ -6,8 +6,11 @@ package order_v1
 type OperationName = string
 
 const (
-	CancelOrderOperation    OperationName = "CancelOrder"
-	CreateOrderOperation    OperationName = "CreateOrder"
-	GetOrderByUUIDOperation OperationName = "GetOrderByUUID"
-	PayOrderOperation       OperationName = "PayOrder"
+	CancelOrderOperation     OperationName = "CancelOrder"
+	CreateOrderOperation     OperationName = "CreateOrder"
+	GetOrderByUUIDOperation  OperationName = "GetOrderByUUID"
+	GetOrderHistoryOperation OperationName = "GetOrderHistory"
+	ListOrdersOperation      OperationName = "ListOrders"
+	PayOrderOperation        OperationName = "PayOrder"
+	RefundOrderOperation     OperationName = "RefundOrder"
 )
@@ -4,6 +4,7 @@ package order_v1
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -13,7 +14,7 @@ func (s *GenericErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
-// Ref: #/components/schemas/bad_request_error
+// Ref: #
 type BadRequestError struct {
 	// Error code.
 	Code int `json:"code"`
@@ -41,17 +42,20 @@ func (s *BadRequestError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*BadRequestError) cancelOrderRes()    {}
-func (*BadRequestError) createOrderRes()    {}
-func (*BadRequestError) getOrderByUUIDRes() {}
-func (*BadRequestError) payOrderRes()       {}
+func (*BadRequestError) cancelOrderRes()     {}
+func (*BadRequestError) createOrderRes()     {}
+func (*BadRequestError) getOrderByUUIDRes()  {}
+func (*BadRequestError) getOrderHistoryRes() {}
+func (*BadRequestError) listOrdersRes()      {}
+func (*BadRequestError) payOrderRes()        {}
+func (*BadRequestError) refundOrderRes()     {}
 
 // CancelOrderNoContent is response for CancelOrder operation.
 type CancelOrderNoContent struct{}
 
 func (*CancelOrderNoContent) cancelOrderRes() {}
 
-// Ref: #/components/schemas/conflict_error
+// Ref: #
 type ConflictError struct {
 	// Error code.
 	Code int `json:"code"`
@@ -80,37 +84,55 @@ func (s *ConflictError) SetMessage(val string) {
 }
 
 func (*ConflictError) cancelOrderRes() {}
+func (*ConflictError) createOrderRes() {}
 func (*ConflictError) payOrderRes()    {}
+func (*ConflictError) refundOrderRes() {}
 
-// Ref: #/components/schemas/create_order_request
+// Parts are passed either as `items` with quantities or as the legacy flat
+// `part_uuids` list, where every entry counts as one unit. At least one of
+// them must be non-empty; repeated parts are summed up.
+// Ref: #
 type CreateOrderRequest struct {
-	// UUID of the user placing the order.
-	UserUUID uuid.UUID `json:"user_uuid"`
-	// List of part UUIDs to include in the order.
+	// UUID of the user the order is placed for. Defaults to the session user;
+	// only admins may set it to another user.
+	UserUUID OptUUID `json:"user_uuid"`
+	// Order line items.
+	Items []OrderItem `json:"items"`
+	// List of part UUIDs to include in the order, one unit each (legacy, prefer items).
 	PartUuids []uuid.UUID `json:"part_uuids"`
 }
 
 // GetUserUUID returns the value of UserUUID.
-func (s *CreateOrderRequest) GetUserUUID() uuid.UUID {
+func (s *CreateOrderRequest) GetUserUUID() OptUUID {
 	return s.UserUUID
 }
 
+// GetItems returns the value of Items.
+func (s *CreateOrderRequest) GetItems() []OrderItem {
+	return s.Items
+}
+
 // GetPartUuids returns the value of PartUuids.
 func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
 // SetUserUUID sets the value of UserUUID.
-func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
+func (s *CreateOrderRequest) SetUserUUID(val OptUUID) {
 	s.UserUUID = val
 }
 
+// SetItems sets the value of Items.
+func (s *CreateOrderRequest) SetItems(val []OrderItem) {
+	s.Items = val
+}
+
 // SetPartUuids sets the value of PartUuids.
 func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
 }
 
-// Ref: #/components/schemas/create_order_response
+// Ref: #
 type CreateOrderResponse struct {
 	// Unique identifier of the created order.
 	OrderUUID uuid.UUID `json:"order_uuid"`
@@ -140,7 +162,68 @@ func (s *CreateOrderResponse) SetTotalPrice(val float64) {
 
 func (*CreateOrderResponse) createOrderRes() {}
 
-// Ref: #/components/schemas/generic_error
+// Ref: #
+type ForbiddenError struct {
+	// Error code.
+	Code int `json:"code"`
+	// Error message.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *ForbiddenError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ForbiddenError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *ForbiddenError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ForbiddenError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ForbiddenError) createOrderRes() {}
+func (*ForbiddenError) listOrdersRes()  {}
+
+// Ref: #
+type GatewayTimeoutError struct {
+	// Error code.
+	Code int `json:"code"`
+	// Error message.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *GatewayTimeoutError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *GatewayTimeoutError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *GatewayTimeoutError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *GatewayTimeoutError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*GatewayTimeoutError) payOrderRes() {}
+
+// Ref: #
 type GenericError struct {
 	// Error code.
 	Code int `json:"code"`
@@ -194,7 +277,7 @@ func (s *GenericErrorStatusCode) SetResponse(val GenericError) {
 	s.Response = val
 }
 
-// Ref: #/components/schemas/internal_server_error
+// Ref: #
 type InternalServerError struct {
 	// Error code.
 	Code int `json:"code"`
@@ -222,12 +305,45 @@ func (s *InternalServerError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*InternalServerError) cancelOrderRes()    {}
-func (*InternalServerError) createOrderRes()    {}
-func (*InternalServerError) getOrderByUUIDRes() {}
-func (*InternalServerError) payOrderRes()       {}
+func (*InternalServerError) cancelOrderRes()     {}
+func (*InternalServerError) createOrderRes()     {}
+func (*InternalServerError) getOrderByUUIDRes()  {}
+func (*InternalServerError) getOrderHistoryRes() {}
+func (*InternalServerError) listOrdersRes()      {}
+func (*InternalServerError) payOrderRes()        {}
+func (*InternalServerError) refundOrderRes()     {}
+
+// Ref: #
+type ListOrdersResponse struct {
+	// Orders of the page, newest first.
+	Orders []OrderDto `json:"orders"`
+	// Cursor of the next page (absent on the last page).
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetOrders returns the value of Orders.
+func (s *ListOrdersResponse) GetOrders() []OrderDto {
+	return s.Orders
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListOrdersResponse) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetOrders sets the value of Orders.
+func (s *ListOrdersResponse) SetOrders(val []OrderDto) {
+	s.Orders = val
+}
 
-// Ref: #/components/schemas/not_found_error
+// SetNextCursor sets the value of NextCursor.
+func (s *ListOrdersResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*ListOrdersResponse) listOrdersRes() {}
+
+// Ref: #
 type NotFoundError struct {
 	// Error code.
 	Code int `json:"code"`
@@ -255,9 +371,195 @@ func (s *NotFoundError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*NotFoundError) cancelOrderRes()    {}
-func (*NotFoundError) getOrderByUUIDRes() {}
-func (*NotFoundError) payOrderRes()       {}
+func (*NotFoundError) cancelOrderRes()     {}
+func (*NotFoundError) getOrderByUUIDRes()  {}
+func (*NotFoundError) getOrderHistoryRes() {}
+func (*NotFoundError) payOrderRes()        {}
+func (*NotFoundError) refundOrderRes()     {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
 // NewOptNilPaymentMethod returns new OptNilPaymentMethod with value set to v.
 func NewOptNilPaymentMethod(v PaymentMethod) OptNilPaymentMethod {
@@ -267,47 +569,294 @@ func NewOptNilPaymentMethod(v PaymentMethod) OptNilPaymentMethod {
 	}
 }
 
-// OptNilPaymentMethod is optional nullable PaymentMethod.
-type OptNilPaymentMethod struct {
-	Value PaymentMethod
+// OptNilPaymentMethod is optional nullable PaymentMethod.
+type OptNilPaymentMethod struct {
+	Value PaymentMethod
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilPaymentMethod was set.
+func (o OptNilPaymentMethod) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilPaymentMethod) Reset() {
+	var v PaymentMethod
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilPaymentMethod) SetTo(v PaymentMethod) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilPaymentMethod) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilPaymentMethod) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v PaymentMethod
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilPaymentMethod) Get() (v PaymentMethod, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilPaymentMethod) Or(d PaymentMethod) PaymentMethod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUUID returns new OptNilUUID with value set to v.
+func NewOptNilUUID(v uuid.UUID) OptNilUUID {
+	return OptNilUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUUID is optional nullable uuid.UUID.
+type OptNilUUID struct {
+	Value uuid.UUID
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUUID was set.
+func (o OptNilUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUUID) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUUID) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v uuid.UUID
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrderStatus returns new OptOrderStatus with value set to v.
+func NewOptOrderStatus(v OrderStatus) OptOrderStatus {
+	return OptOrderStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrderStatus is optional OrderStatus.
+type OptOrderStatus struct {
+	Value OrderStatus
+	Set   bool
+}
+
+// IsSet returns true if OptOrderStatus was set.
+func (o OptOrderStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrderStatus) Reset() {
+	var v OrderStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrderStatus) SetTo(v OrderStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrderStatus) Get() (v OrderStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrderStatus) Or(d OrderStatus) OrderStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPartCategory returns new OptPartCategory with value set to v.
+func NewOptPartCategory(v PartCategory) OptPartCategory {
+	return OptPartCategory{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPartCategory is optional PartCategory.
+type OptPartCategory struct {
+	Value PartCategory
+	Set   bool
+}
+
+// IsSet returns true if OptPartCategory was set.
+func (o OptPartCategory) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPartCategory) Reset() {
+	var v PartCategory
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPartCategory) SetTo(v PartCategory) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPartCategory) Get() (v PartCategory, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPartCategory) Or(d PartCategory) PartCategory {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRefundOrderRequest returns new OptRefundOrderRequest with value set to v.
+func NewOptRefundOrderRequest(v RefundOrderRequest) OptRefundOrderRequest {
+	return OptRefundOrderRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRefundOrderRequest is optional RefundOrderRequest.
+type OptRefundOrderRequest struct {
+	Value RefundOrderRequest
+	Set   bool
+}
+
+// IsSet returns true if OptRefundOrderRequest was set.
+func (o OptRefundOrderRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRefundOrderRequest) Reset() {
+	var v RefundOrderRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRefundOrderRequest) SetTo(v RefundOrderRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRefundOrderRequest) Get() (v RefundOrderRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRefundOrderRequest) Or(d RefundOrderRequest) RefundOrderRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilPaymentMethod was set.
-func (o OptNilPaymentMethod) IsSet() bool { return o.Set }
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilPaymentMethod) Reset() {
-	var v PaymentMethod
+func (o *OptString) Reset() {
+	var v string
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilPaymentMethod) SetTo(v PaymentMethod) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilPaymentMethod) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilPaymentMethod) SetToNull() {
+func (o *OptString) SetTo(v string) {
 	o.Set = true
-	o.Null = true
-	var v PaymentMethod
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilPaymentMethod) Get() (v PaymentMethod, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptString) Get() (v string, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -315,62 +864,45 @@ func (o OptNilPaymentMethod) Get() (v PaymentMethod, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilPaymentMethod) Or(d PaymentMethod) PaymentMethod {
+func (o OptString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilUUID returns new OptNilUUID with value set to v.
-func NewOptNilUUID(v uuid.UUID) OptNilUUID {
-	return OptNilUUID{
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilUUID is optional nullable uuid.UUID.
-type OptNilUUID struct {
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
 	Value uuid.UUID
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilUUID was set.
-func (o OptNilUUID) IsSet() bool { return o.Set }
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilUUID) Reset() {
+func (o *OptUUID) Reset() {
 	var v uuid.UUID
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilUUID) SetTo(v uuid.UUID) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilUUID) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilUUID) SetToNull() {
+func (o *OptUUID) SetTo(v uuid.UUID) {
 	o.Set = true
-	o.Null = true
-	var v uuid.UUID
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -378,14 +910,14 @@ func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// Ref: #/components/schemas/order_dto
+// Ref: #
 type OrderDto struct {
 	// Unique identifier of the order.
 	OrderUUID uuid.UUID `json:"order_uuid"`
@@ -393,6 +925,8 @@ type OrderDto struct {
 	UserUUID uuid.UUID `json:"user_uuid"`
 	// List of part UUIDs included in the order.
 	PartUuids []uuid.UUID `json:"part_uuids"`
+	// Order line items with quantities and part snapshots.
+	Items []OrderLine `json:"items"`
 	// Total price of the order.
 	TotalPrice float64 `json:"total_price"`
 	// Unique identifier of the payment transaction (present only if order is paid).
@@ -400,6 +934,8 @@ type OrderDto struct {
 	// Payment method used (present only if order is paid).
 	PaymentMethod OptNilPaymentMethod `json:"payment_method"`
 	Status        OrderStatus         `json:"status"`
+	// Time the order was created.
+	CreatedAt OptDateTime `json:"created_at"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -417,6 +953,11 @@ func (s *OrderDto) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
+// GetItems returns the value of Items.
+func (s *OrderDto) GetItems() []OrderLine {
+	return s.Items
+}
+
 // GetTotalPrice returns the value of TotalPrice.
 func (s *OrderDto) GetTotalPrice() float64 {
 	return s.TotalPrice
@@ -437,6 +978,11 @@ func (s *OrderDto) GetStatus() OrderStatus {
 	return s.Status
 }
 
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrderDto) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *OrderDto) SetOrderUUID(val uuid.UUID) {
 	s.OrderUUID = val
@@ -452,6 +998,11 @@ func (s *OrderDto) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
 }
 
+// SetItems sets the value of Items.
+func (s *OrderDto) SetItems(val []OrderLine) {
+	s.Items = val
+}
+
 // SetTotalPrice sets the value of TotalPrice.
 func (s *OrderDto) SetTotalPrice(val float64) {
 	s.TotalPrice = val
@@ -472,18 +1023,118 @@ func (s *OrderDto) SetStatus(val OrderStatus) {
 	s.Status = val
 }
 
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrderDto) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
 func (*OrderDto) getOrderByUUIDRes() {}
 
+// Ref: #
+type OrderItem struct {
+	// UUID of the ordered part.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Number of units of the part.
+	Quantity int `json:"quantity"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItem) GetQuantity() int {
+	return s.Quantity
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItem) SetQuantity(val int) {
+	s.Quantity = val
+}
+
+// Order line with a snapshot of the part taken when the order was created.
+// Snapshot fields are absent for orders created before snapshots were stored.
+// Ref: #
+type OrderLine struct {
+	// UUID of the ordered part.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Number of units of the part.
+	Quantity int `json:"quantity"`
+	// Price of one unit at order creation time.
+	UnitPrice OptFloat64 `json:"unit_price"`
+	// Part name at order creation time.
+	Name     OptString       `json:"name"`
+	Category OptPartCategory `json:"category"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderLine) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderLine) GetQuantity() int {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *OrderLine) GetUnitPrice() OptFloat64 {
+	return s.UnitPrice
+}
+
+// GetName returns the value of Name.
+func (s *OrderLine) GetName() OptString {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *OrderLine) GetCategory() OptPartCategory {
+	return s.Category
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderLine) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderLine) SetQuantity(val int) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *OrderLine) SetUnitPrice(val OptFloat64) {
+	s.UnitPrice = val
+}
+
+// SetName sets the value of Name.
+func (s *OrderLine) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *OrderLine) SetCategory(val OptPartCategory) {
+	s.Category = val
+}
+
 // Current status of the order.
-// Ref: #/components/schemas/order_status
+// Ref: #
 type OrderStatus string
 
 const (
-	OrderStatusUNKNOWN        OrderStatus = "UNKNOWN"
-	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPAID           OrderStatus = "PAID"
-	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
-	OrderStatusASSEMBLED      OrderStatus = "ASSEMBLED"
+	OrderStatusUNKNOWN           OrderStatus = "UNKNOWN"
+	OrderStatusPENDINGPAYMENT    OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPAID              OrderStatus = "PAID"
+	OrderStatusCANCELLED         OrderStatus = "CANCELLED"
+	OrderStatusASSEMBLED         OrderStatus = "ASSEMBLED"
+	OrderStatusPARTIALLYREFUNDED OrderStatus = "PARTIALLY_REFUNDED"
+	OrderStatusREFUNDED          OrderStatus = "REFUNDED"
 )
 
 // AllValues returns all OrderStatus values.
@@ -494,6 +1145,8 @@ func (OrderStatus) AllValues() []OrderStatus {
 		OrderStatusPAID,
 		OrderStatusCANCELLED,
 		OrderStatusASSEMBLED,
+		OrderStatusPARTIALLYREFUNDED,
+		OrderStatusREFUNDED,
 	}
 }
 
@@ -510,6 +1163,10 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case OrderStatusASSEMBLED:
 		return []byte(s), nil
+	case OrderStatusPARTIALLYREFUNDED:
+		return []byte(s), nil
+	case OrderStatusREFUNDED:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -533,12 +1190,164 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	case OrderStatusASSEMBLED:
 		*s = OrderStatusASSEMBLED
 		return nil
+	case OrderStatusPARTIALLYREFUNDED:
+		*s = OrderStatusPARTIALLYREFUNDED
+		return nil
+	case OrderStatusREFUNDED:
+		*s = OrderStatusREFUNDED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #
+type OrderStatusHistoryResponse struct {
+	// Status changes of the order, oldest first.
+	Transitions []OrderStatusTransition `json:"transitions"`
+}
+
+// GetTransitions returns the value of Transitions.
+func (s *OrderStatusHistoryResponse) GetTransitions() []OrderStatusTransition {
+	return s.Transitions
+}
+
+// SetTransitions sets the value of Transitions.
+func (s *OrderStatusHistoryResponse) SetTransitions(val []OrderStatusTransition) {
+	s.Transitions = val
+}
+
+func (*OrderStatusHistoryResponse) getOrderHistoryRes() {}
+
+// Status change of an order.
+// from_status is absent for the status the order was created with.
+// Ref: #
+type OrderStatusTransition struct {
+	FromStatus OptOrderStatus `json:"from_status"`
+	ToStatus   OrderStatus    `json:"to_status"`
+	// UUID of the user who made the change, or the name of the service.
+	Actor string `json:"actor"`
+	// Why the status changed.
+	Reason string `json:"reason"`
+	// When the status changed.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetFromStatus returns the value of FromStatus.
+func (s *OrderStatusTransition) GetFromStatus() OptOrderStatus {
+	return s.FromStatus
+}
+
+// GetToStatus returns the value of ToStatus.
+func (s *OrderStatusTransition) GetToStatus() OrderStatus {
+	return s.ToStatus
+}
+
+// GetActor returns the value of Actor.
+func (s *OrderStatusTransition) GetActor() string {
+	return s.Actor
+}
+
+// GetReason returns the value of Reason.
+func (s *OrderStatusTransition) GetReason() string {
+	return s.Reason
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrderStatusTransition) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetFromStatus sets the value of FromStatus.
+func (s *OrderStatusTransition) SetFromStatus(val OptOrderStatus) {
+	s.FromStatus = val
+}
+
+// SetToStatus sets the value of ToStatus.
+func (s *OrderStatusTransition) SetToStatus(val OrderStatus) {
+	s.ToStatus = val
+}
+
+// SetActor sets the value of Actor.
+func (s *OrderStatusTransition) SetActor(val string) {
+	s.Actor = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OrderStatusTransition) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrderStatusTransition) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Category of a spacecraft part.
+// Ref: #
+type PartCategory string
+
+const (
+	PartCategoryUNKNOWN  PartCategory = "UNKNOWN"
+	PartCategoryENGINE   PartCategory = "ENGINE"
+	PartCategoryFUEL     PartCategory = "FUEL"
+	PartCategoryPORTHOLE PartCategory = "PORTHOLE"
+	PartCategoryWING     PartCategory = "WING"
+)
+
+// AllValues returns all PartCategory values.
+func (PartCategory) AllValues() []PartCategory {
+	return []PartCategory{
+		PartCategoryUNKNOWN,
+		PartCategoryENGINE,
+		PartCategoryFUEL,
+		PartCategoryPORTHOLE,
+		PartCategoryWING,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PartCategory) MarshalText() ([]byte, error) {
+	switch s {
+	case PartCategoryUNKNOWN:
+		return []byte(s), nil
+	case PartCategoryENGINE:
+		return []byte(s), nil
+	case PartCategoryFUEL:
+		return []byte(s), nil
+	case PartCategoryPORTHOLE:
+		return []byte(s), nil
+	case PartCategoryWING:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PartCategory) UnmarshalText(data []byte) error {
+	switch PartCategory(data) {
+	case PartCategoryUNKNOWN:
+		*s = PartCategoryUNKNOWN
+		return nil
+	case PartCategoryENGINE:
+		*s = PartCategoryENGINE
+		return nil
+	case PartCategoryFUEL:
+		*s = PartCategoryFUEL
+		return nil
+	case PartCategoryPORTHOLE:
+		*s = PartCategoryPORTHOLE
+		return nil
+	case PartCategoryWING:
+		*s = PartCategoryWING
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/pay_order_request
+// Ref: #
 type PayOrderRequest struct {
 	PaymentMethod PaymentMethod `json:"payment_method"`
 }
@@ -553,7 +1362,7 @@ func (s *PayOrderRequest) SetPaymentMethod(val PaymentMethod) {
 	s.PaymentMethod = val
 }
 
-// Ref: #/components/schemas/pay_order_response
+// Ref: #
 type PayOrderResponse struct {
 	// Unique identifier of the payment transaction.
 	TransactionUUID uuid.UUID `json:"transaction_uuid"`
@@ -572,7 +1381,7 @@ func (s *PayOrderResponse) SetTransactionUUID(val uuid.UUID) {
 func (*PayOrderResponse) payOrderRes() {}
 
 // Payment method used for the order.
-// Ref: #/components/schemas/payment_method
+// Ref: #
 type PaymentMethod string
 
 const (
@@ -634,3 +1443,178 @@ func (s *PaymentMethod) UnmarshalText(data []byte) error {
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
+
+// Ref: #
+type PaymentRequiredError struct {
+	// Error code.
+	Code int `json:"code"`
+	// Error message.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *PaymentRequiredError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *PaymentRequiredError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *PaymentRequiredError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *PaymentRequiredError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*PaymentRequiredError) payOrderRes() {}
+
+// Refund of a paid order. Without an amount everything that has not been
+// refunded yet is returned.
+// Ref: #
+type RefundOrderRequest struct {
+	// Amount to refund, a decimal with at most two fractional digits.
+	Amount OptString `json:"amount"`
+	// Why the order is refunded, recorded in the order history.
+	Reason OptString `json:"reason"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *RefundOrderRequest) GetAmount() OptString {
+	return s.Amount
+}
+
+// GetReason returns the value of Reason.
+func (s *RefundOrderRequest) GetReason() OptString {
+	return s.Reason
+}
+
+// SetAmount sets the value of Amount.
+func (s *RefundOrderRequest) SetAmount(val OptString) {
+	s.Amount = val
+}
+
+// SetReason sets the value of Reason.
+func (s *RefundOrderRequest) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// Ref: #
+type RefundOrderResponse struct {
+	// Unique identifier of the refund.
+	RefundUUID uuid.UUID `json:"refund_uuid"`
+	// Amount returned by this refund.
+	Amount string `json:"amount"`
+	// Currency of the refund, ISO 4217 code.
+	Currency    string      `json:"currency"`
+	OrderStatus OrderStatus `json:"order_status"`
+}
+
+// GetRefundUUID returns the value of RefundUUID.
+func (s *RefundOrderResponse) GetRefundUUID() uuid.UUID {
+	return s.RefundUUID
+}
+
+// GetAmount returns the value of Amount.
+func (s *RefundOrderResponse) GetAmount() string {
+	return s.Amount
+}
+
+// GetCurrency returns the value of Currency.
+func (s *RefundOrderResponse) GetCurrency() string {
+	return s.Currency
+}
+
+// GetOrderStatus returns the value of OrderStatus.
+func (s *RefundOrderResponse) GetOrderStatus() OrderStatus {
+	return s.OrderStatus
+}
+
+// SetRefundUUID sets the value of RefundUUID.
+func (s *RefundOrderResponse) SetRefundUUID(val uuid.UUID) {
+	s.RefundUUID = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *RefundOrderResponse) SetAmount(val string) {
+	s.Amount = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *RefundOrderResponse) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// SetOrderStatus sets the value of OrderStatus.
+func (s *RefundOrderResponse) SetOrderStatus(val OrderStatus) {
+	s.OrderStatus = val
+}
+
+func (*RefundOrderResponse) refundOrderRes() {}
+
+// Ref: #
+type ServiceUnavailableError struct {
+	// Error code.
+	Code int `json:"code"`
+	// Error message.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *ServiceUnavailableError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ServiceUnavailableError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *ServiceUnavailableError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ServiceUnavailableError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ServiceUnavailableError) payOrderRes() {}
+
+// Ref: #
+type ValidationError struct {
+	// Error code.
+	Code int `json:"code"`
+	// Error message.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *ValidationError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ValidationError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *ValidationError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ValidationError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ValidationError) createOrderRes() {}
+func (*ValidationError) payOrderRes()    {}
+func (*ValidationError) refundOrderRes() {}
@@ -10,7 +10,7 @@ import (
 type Handler interface {
 	// CancelOrder implements cancelOrder operation.
 	//
-	// Cancels an existing order (only if not paid).
+	// Cancels an existing order (only if not paid, paid orders are refunded instead).
 	//
 	// POST /api/v1/orders/{order_uuid}/cancel
 	CancelOrder(ctx context.Context, params CancelOrderParams) (CancelOrderRes, error)
@@ -19,19 +19,41 @@ type Handler interface {
 	// Creates a new order with the specified parts.
 	//
 	// POST /api/v1/orders
-	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
+	CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (CreateOrderRes, error)
 	// GetOrderByUUID implements getOrderByUUID operation.
 	//
 	// Retrieves an order by its UUID.
 	//
 	// GET /api/v1/orders/{order_uuid}
 	GetOrderByUUID(ctx context.Context, params GetOrderByUUIDParams) (GetOrderByUUIDRes, error)
+	// GetOrderHistory implements getOrderHistory operation.
+	//
+	// Retrieves every status change of an order, oldest first.
+	//
+	// GET /api/v1/orders/{order_uuid}/history
+	GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (GetOrderHistoryRes, error)
+	// ListOrders implements listOrders operation.
+	//
+	// Lists orders of the session user, newest first, using keyset pagination.
+	// Admins may list orders of another user or of all users.
+	//
+	// GET /api/v1/orders
+	ListOrders(ctx context.Context, params ListOrdersParams) (ListOrdersRes, error)
 	// PayOrder implements payOrder operation.
 	//
 	// Processes payment for an existing order.
 	//
 	// POST /api/v1/orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
+	// RefundOrder implements refundOrder operation.
+	//
+	// Cancels a paid order that has not been assembled yet, returns its parts to
+	// stock and refunds its payment.
+	// A partial refund moves the order to PARTIALLY_REFUNDED, the rest can be
+	// refunded later; refunding everything moves it to REFUNDED.
+	//
+	// POST /api/v1/orders/{order_uuid}/refund
+	RefundOrder(ctx context.Context, req OptRefundOrderRequest, params RefundOrderParams) (RefundOrderRes, error)
 	// NewError creates *GenericErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
@@ -15,7 +15,7 @@ var _ Handler = UnimplementedHandler{}
 
 // CancelOrder implements cancelOrder operation.
 //
-// Cancels an existing order (only if not paid).
+// Cancels an existing order (only if not paid, paid orders are refunded instead).
 //
 // POST /api/v1/orders/{order_uuid}/cancel
 func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderParams) (r CancelOrderRes, _ error) {
@@ -27,7 +27,7 @@ func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderP
 // Creates a new order with the specified parts.
 //
 // POST /api/v1/orders
-func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest) (r CreateOrderRes, _ error) {
+func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (r CreateOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -40,6 +40,25 @@ func (UnimplementedHandler) GetOrderByUUID(ctx context.Context, params GetOrderB
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderHistory implements getOrderHistory operation.
+//
+// Retrieves every status change of an order, oldest first.
+//
+// GET /api/v1/orders/{order_uuid}/history
+func (UnimplementedHandler) GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (r GetOrderHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListOrders implements listOrders operation.
+//
+// Lists orders of the session user, newest first, using keyset pagination.
+// Admins may list orders of another user or of all users.
+//
+// GET /api/v1/orders
+func (UnimplementedHandler) ListOrders(ctx context.Context, params ListOrdersParams) (r ListOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements payOrder operation.
 //
 // Processes payment for an existing order.
@@ -49,6 +68,18 @@ func (UnimplementedHandler) PayOrder(ctx context.Context, req *PayOrderRequest,
 	return r, ht.ErrNotImplemented
 }
 
+// RefundOrder implements refundOrder operation.
+//
+// Cancels a paid order that has not been assembled yet, returns its parts to
+// stock and refunds its payment.
+// A partial refund moves the order to PARTIALLY_REFUNDED, the rest can be
+// refunded later; refunding everything moves it to REFUNDED.
+//
+// POST /api/v1/orders/{order_uuid}/refund
+func (UnimplementedHandler) RefundOrder(ctx context.Context, req OptRefundOrderRequest, params RefundOrderParams) (r RefundOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *GenericErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
@@ -29,6 +29,8 @@ type OrderPaid struct {
 	UserUuid        string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                      // Идентификатор пользователя
 	PaymentMethod   string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`       // Способ оплаты (строкой, значение из PaymentMethod)
 	TransactionUuid string                 `protobuf:"bytes,5,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // Идентификатор транзакции, сгенерированный в результате оплаты
+	Amount          string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Списанная сумма (десятичная строка, например "1250.50")
+	Currency        string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`                                      // Валюта платежа (код ISO 4217, например "RUB")
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -98,6 +100,20 @@ func (x *OrderPaid) GetTransactionUuid() string {
 	return ""
 }
 
+func (x *OrderPaid) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *OrderPaid) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 // Корабль собран
 type ShipAssembled struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -167,11 +183,315 @@ func (x *ShipAssembled) GetBuildTimeSec() int64 {
 	return 0
 }
 
+// Заказ создан
+type OrderCreated struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"` // Уникальный идентификатор события (для идемпотентности)
+	OrderUuid string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"` // Идентификатор созданного заказа
+	UserUuid  string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`    // Идентификатор пользователя
+	// Deprecated: Marked as deprecated in events/v1/assembly.proto.
+	PartUuids []string `protobuf:"bytes,4,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"` // Идентификаторы деталей заказа (схема v1, заменено items)
+	// Deprecated: Marked as deprecated in events/v1/assembly.proto.
+	TotalPrice    float64             `protobuf:"fixed64,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`  // Итоговая стоимость заказа (схема v1, заменено total_amount)
+	Items         []*OrderCreatedItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`                                // Позиции заказа
+	TotalAmount   string              `protobuf:"bytes,7,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // Итоговая стоимость заказа (десятичная строка, например "1250.50")
+	Currency      string              `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                          // Валюта заказа (код ISO 4217, например "RUB")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCreated) Reset() {
+	*x = OrderCreated{}
+	mi := &file_events_v1_assembly_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCreated) ProtoMessage() {}
+
+func (x *OrderCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_assembly_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCreated.ProtoReflect.Descriptor instead.
+func (*OrderCreated) Descriptor() ([]byte, []int) {
+	return file_events_v1_assembly_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderCreated) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in events/v1/assembly.proto.
+func (x *OrderCreated) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in events/v1/assembly.proto.
+func (x *OrderCreated) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *OrderCreated) GetItems() []*OrderCreatedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderCreated) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Позиция созданного заказа
+type OrderCreatedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`    // Идентификатор детали
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                   // Количество деталей
+	UnitPrice     string                 `protobuf:"bytes,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // Цена за единицу (десятичная строка, например "250.00")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCreatedItem) Reset() {
+	*x = OrderCreatedItem{}
+	mi := &file_events_v1_assembly_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCreatedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCreatedItem) ProtoMessage() {}
+
+func (x *OrderCreatedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_assembly_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCreatedItem.ProtoReflect.Descriptor instead.
+func (*OrderCreatedItem) Descriptor() ([]byte, []int) {
+	return file_events_v1_assembly_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderCreatedItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *OrderCreatedItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderCreatedItem) GetUnitPrice() string {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return ""
+}
+
+// Заказ отменён
+type OrderCancelled struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"` // Уникальный идентификатор события (для идемпотентности)
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"` // Идентификатор отменённого заказа
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`    // Идентификатор пользователя
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                        // Причина отмены
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCancelled) Reset() {
+	*x = OrderCancelled{}
+	mi := &file_events_v1_assembly_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCancelled) ProtoMessage() {}
+
+func (x *OrderCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_assembly_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCancelled.ProtoReflect.Descriptor instead.
+func (*OrderCancelled) Descriptor() ([]byte, []int) {
+	return file_events_v1_assembly_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderCancelled) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Сборка корабля не удалась
+type AssemblyFailed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"` // Уникальный идентификатор события (для идемпотентности)
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"` // Идентификатор заказа
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`    // Идентификатор пользователя
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                        // Причина неудачи
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssemblyFailed) Reset() {
+	*x = AssemblyFailed{}
+	mi := &file_events_v1_assembly_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssemblyFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssemblyFailed) ProtoMessage() {}
+
+func (x *AssemblyFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_assembly_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssemblyFailed.ProtoReflect.Descriptor instead.
+func (*AssemblyFailed) Descriptor() ([]byte, []int) {
+	return file_events_v1_assembly_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AssemblyFailed) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *AssemblyFailed) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *AssemblyFailed) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *AssemblyFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_events_v1_assembly_proto protoreflect.FileDescriptor
 
 const file_events_v1_assembly_proto_rawDesc = "" +
 	"\n" +
-	"\x18events/v1/assembly.proto\x12\tevents.v1\"\xb8\x01\n" +
+	"\x18events/v1/assembly.proto\x12\tevents.v1\"\xec\x01\n" +
 	"\tOrderPaid\x12\x1d\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
@@ -179,14 +499,48 @@ const file_events_v1_assembly_proto_rawDesc = "" +
 	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
 	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12%\n" +
 	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12)\n" +
-	"\x10transaction_uuid\x18\x05 \x01(\tR\x0ftransactionUuid\"\x90\x01\n" +
+	"\x10transaction_uuid\x18\x05 \x01(\tR\x0ftransactionUuid\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\"\x90\x01\n" +
 	"\rShipAssembled\x12\x1d\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
 	"\n" +
 	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
 	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12$\n" +
-	"\x0ebuild_time_sec\x18\x04 \x01(\x03R\fbuildTimeSecBIZGgithub.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1;events_v1b\x06proto3"
+	"\x0ebuild_time_sec\x18\x04 \x01(\x03R\fbuildTimeSec\"\xa3\x02\n" +
+	"\fOrderCreated\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12!\n" +
+	"\n" +
+	"part_uuids\x18\x04 \x03(\tB\x02\x18\x01R\tpartUuids\x12#\n" +
+	"\vtotal_price\x18\x05 \x01(\x01B\x02\x18\x01R\n" +
+	"totalPrice\x121\n" +
+	"\x05items\x18\x06 \x03(\v2\x1b.events.v1.OrderCreatedItemR\x05items\x12!\n" +
+	"\ftotal_amount\x18\a \x01(\tR\vtotalAmount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\"j\n" +
+	"\x10OrderCreatedItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\tR\tunitPrice\"\x83\x01\n" +
+	"\x0eOrderCancelled\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x83\x01\n" +
+	"\x0eAssemblyFailed\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reasonBIZGgithub.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1;events_v1b\x06proto3"
 
 var (
 	file_events_v1_assembly_proto_rawDescOnce sync.Once
@@ -200,17 +554,22 @@ func file_events_v1_assembly_proto_rawDescGZIP() []byte {
 	return file_events_v1_assembly_proto_rawDescData
 }
 
-var file_events_v1_assembly_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_v1_assembly_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_events_v1_assembly_proto_goTypes = []any{
-	(*OrderPaid)(nil),     // 0: events.v1.OrderPaid
-	(*ShipAssembled)(nil), // 1: events.v1.ShipAssembled
+	(*OrderPaid)(nil),        // 0: events.v1.OrderPaid
+	(*ShipAssembled)(nil),    // 1: events.v1.ShipAssembled
+	(*OrderCreated)(nil),     // 2: events.v1.OrderCreated
+	(*OrderCreatedItem)(nil), // 3: events.v1.OrderCreatedItem
+	(*OrderCancelled)(nil),   // 4: events.v1.OrderCancelled
+	(*AssemblyFailed)(nil),   // 5: events.v1.AssemblyFailed
 }
 var file_events_v1_assembly_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: events.v1.OrderCreated.items:type_name -> events.v1.OrderCreatedItem
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_events_v1_assembly_proto_init() }
@@ -224,7 +583,7 @@ func file_events_v1_assembly_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_assembly_proto_rawDesc), len(file_events_v1_assembly_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: events/v1/payment.proto
+
+package events_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Ожидавший подтверждения платёж проведён или отклонён провайдером
+type PaymentSettled struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid       string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`                   // Уникальный идентификатор события (для идемпотентности)
+	OrderUuid       string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                   // Идентификатор оплачиваемого заказа
+	TransactionUuid string                 `protobuf:"bytes,3,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // Идентификатор транзакции платежа
+	AttemptKey      string                 `protobuf:"bytes,4,opt,name=attempt_key,json=attemptKey,proto3" json:"attempt_key,omitempty"`                // Ключ попытки оплаты заказа
+	Status          string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                          // Итоговый статус платежа (SUCCEEDED или DECLINED)
+	PaymentMethod   string                 `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`       // Способ оплаты (строкой, значение из PaymentMethod)
+	Amount          string                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Сумма платежа (десятичная строка, например "1250.50")
+	Currency        string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                                      // Валюта платежа (код ISO 4217, например "RUB")
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PaymentSettled) Reset() {
+	*x = PaymentSettled{}
+	mi := &file_events_v1_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentSettled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentSettled) ProtoMessage() {}
+
+func (x *PaymentSettled) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentSettled.ProtoReflect.Descriptor instead.
+func (*PaymentSettled) Descriptor() ([]byte, []int) {
+	return file_events_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PaymentSettled) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PaymentSettled) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *PaymentSettled) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *PaymentSettled) GetAttemptKey() string {
+	if x != nil {
+		return x.AttemptKey
+	}
+	return ""
+}
+
+func (x *PaymentSettled) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentSettled) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *PaymentSettled) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PaymentSettled) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+var File_events_v1_payment_proto protoreflect.FileDescriptor
+
+const file_events_v1_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x17events/v1/payment.proto\x12\tevents.v1\"\x8d\x02\n" +
+	"\x0ePaymentSettled\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x03 \x01(\tR\x0ftransactionUuid\x12\x1f\n" +
+	"\vattempt_key\x18\x04 \x01(\tR\n" +
+	"attemptKey\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12%\n" +
+	"\x0epayment_method\x18\x06 \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\a \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrencyBIZGgithub.com/dexguitar/spacecraftory/shared/pkg/proto/events/v1;events_v1b\x06proto3"
+
+var (
+	file_events_v1_payment_proto_rawDescOnce sync.Once
+	file_events_v1_payment_proto_rawDescData []byte
+)
+
+func file_events_v1_payment_proto_rawDescGZIP() []byte {
+	file_events_v1_payment_proto_rawDescOnce.Do(func() {
+		file_events_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_payment_proto_rawDesc), len(file_events_v1_payment_proto_rawDesc)))
+	})
+	return file_events_v1_payment_proto_rawDescData
+}
+
+var file_events_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_events_v1_payment_proto_goTypes = []any{
+	(*PaymentSettled)(nil), // 0: events.v1.PaymentSettled
+}
+var file_events_v1_payment_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_payment_proto_init() }
+func file_events_v1_payment_proto_init() {
+	if File_events_v1_payment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_payment_proto_rawDesc), len(file_events_v1_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_payment_proto_goTypes,
+		DependencyIndexes: file_events_v1_payment_proto_depIdxs,
+		MessageInfos:      file_events_v1_payment_proto_msgTypes,
+	}.Build()
+	File_events_v1_payment_proto = out.File
+	file_events_v1_payment_proto_goTypes = nil
+	file_events_v1_payment_proto_depIdxs = nil
+}
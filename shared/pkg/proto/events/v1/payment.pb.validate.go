@@ -0,0 +1,152 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: events/v1/payment.proto
+
+package events_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on PaymentSettled with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PaymentSettled) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaymentSettled with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PaymentSettledMultiError,
+// or nil if none found.
+func (m *PaymentSettled) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaymentSettled) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for AttemptKey
+
+	// no validation rules for Status
+
+	// no validation rules for PaymentMethod
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return PaymentSettledMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaymentSettledMultiError is an error wrapping multiple validation errors
+// returned by PaymentSettled.ValidateAll() if the designated constraints
+// aren't met.
+type PaymentSettledMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentSettledMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentSettledMultiError) AllErrors() []error { return m }
+
+// PaymentSettledValidationError is the validation error returned by
+// PaymentSettled.Validate if the designated constraints aren't met.
+type PaymentSettledValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentSettledValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentSettledValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentSettledValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentSettledValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentSettledValidationError) ErrorName() string { return "PaymentSettledValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentSettledValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaymentSettled.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentSettledValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentSettledValidationError{}
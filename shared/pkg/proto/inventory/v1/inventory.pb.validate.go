@@ -1262,3 +1262,848 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListPartsResponseValidationError{}
+
+// Validate checks the field values on ReservationItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReservationItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservationItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservationItemMultiError, or nil if none found.
+func (m *ReservationItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservationItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPartUuid()) != 36 {
+		err := ReservationItemValidationError{
+			field:  "PartUuid",
+			reason: "value length must be 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := ReservationItemValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReservationItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReservationItemMultiError is an error wrapping multiple validation errors
+// returned by ReservationItem.ValidateAll() if the designated constraints
+// aren't met.
+type ReservationItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservationItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservationItemMultiError) AllErrors() []error { return m }
+
+// ReservationItemValidationError is the validation error returned by
+// ReservationItem.Validate if the designated constraints aren't met.
+type ReservationItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservationItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservationItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservationItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservationItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservationItemValidationError) ErrorName() string { return "ReservationItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReservationItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservationItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservationItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservationItemValidationError{}
+
+// Validate checks the field values on ReservePartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReservePartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservePartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservePartsRequestMultiError, or nil if none found.
+func (m *ReservePartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservePartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrderUuid()) != 36 {
+		err := ReservePartsRequestValidationError{
+			field:  "OrderUuid",
+			reason: "value length must be 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(m.GetItems()) < 1 {
+		err := ReservePartsRequestValidationError{
+			field:  "Items",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReservePartsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReservePartsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReservePartsRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReservePartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReservePartsRequestMultiError is an error wrapping multiple validation
+// errors returned by ReservePartsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReservePartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservePartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservePartsRequestMultiError) AllErrors() []error { return m }
+
+// ReservePartsRequestValidationError is the validation error returned by
+// ReservePartsRequest.Validate if the designated constraints aren't met.
+type ReservePartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservePartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservePartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservePartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservePartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservePartsRequestValidationError) ErrorName() string {
+	return "ReservePartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReservePartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservePartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservePartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservePartsRequestValidationError{}
+
+// Validate checks the field values on ReservePartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReservePartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservePartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservePartsResponseMultiError, or nil if none found.
+func (m *ReservePartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservePartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReservePartsResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReservePartsResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReservePartsResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReservePartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReservePartsResponseMultiError is an error wrapping multiple validation
+// errors returned by ReservePartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReservePartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservePartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservePartsResponseMultiError) AllErrors() []error { return m }
+
+// ReservePartsResponseValidationError is the validation error returned by
+// ReservePartsResponse.Validate if the designated constraints aren't met.
+type ReservePartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservePartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservePartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservePartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservePartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservePartsResponseValidationError) ErrorName() string {
+	return "ReservePartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReservePartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservePartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservePartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservePartsResponseValidationError{}
+
+// Validate checks the field values on ReleaseReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseReservationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseReservationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseReservationRequestMultiError, or nil if none found.
+func (m *ReleaseReservationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseReservationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrderUuid()) != 36 {
+		err := ReleaseReservationRequestValidationError{
+			field:  "OrderUuid",
+			reason: "value length must be 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return ReleaseReservationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseReservationRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseReservationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ReleaseReservationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseReservationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseReservationRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseReservationRequestValidationError is the validation error returned by
+// ReleaseReservationRequest.Validate if the designated constraints aren't met.
+type ReleaseReservationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseReservationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseReservationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseReservationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseReservationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseReservationRequestValidationError) ErrorName() string {
+	return "ReleaseReservationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseReservationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseReservationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseReservationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseReservationRequestValidationError{}
+
+// Validate checks the field values on ReleaseReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseReservationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseReservationResponseMultiError, or nil if none found.
+func (m *ReleaseReservationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseReservationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReleaseReservationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseReservationResponseMultiError is an error wrapping multiple
+// validation errors returned by ReleaseReservationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ReleaseReservationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseReservationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseReservationResponseMultiError) AllErrors() []error { return m }
+
+// ReleaseReservationResponseValidationError is the validation error returned
+// by ReleaseReservationResponse.Validate if the designated constraints aren't met.
+type ReleaseReservationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseReservationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseReservationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseReservationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseReservationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseReservationResponseValidationError) ErrorName() string {
+	return "ReleaseReservationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseReservationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseReservationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseReservationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseReservationResponseValidationError{}
+
+// Validate checks the field values on CommitReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitReservationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitReservationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitReservationRequestMultiError, or nil if none found.
+func (m *CommitReservationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitReservationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrderUuid()) != 36 {
+		err := CommitReservationRequestValidationError{
+			field:  "OrderUuid",
+			reason: "value length must be 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return CommitReservationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitReservationRequestMultiError is an error wrapping multiple validation
+// errors returned by CommitReservationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CommitReservationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitReservationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitReservationRequestMultiError) AllErrors() []error { return m }
+
+// CommitReservationRequestValidationError is the validation error returned by
+// CommitReservationRequest.Validate if the designated constraints aren't met.
+type CommitReservationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitReservationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitReservationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitReservationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitReservationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitReservationRequestValidationError) ErrorName() string {
+	return "CommitReservationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitReservationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitReservationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitReservationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitReservationRequestValidationError{}
+
+// Validate checks the field values on CommitReservationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitReservationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitReservationResponseMultiError, or nil if none found.
+func (m *CommitReservationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitReservationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CommitReservationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitReservationResponseMultiError is an error wrapping multiple validation
+// errors returned by CommitReservationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CommitReservationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitReservationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitReservationResponseMultiError) AllErrors() []error { return m }
+
+// CommitReservationResponseValidationError is the validation error returned by
+// CommitReservationResponse.Validate if the designated constraints aren't met.
+type CommitReservationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitReservationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitReservationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitReservationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitReservationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitReservationResponseValidationError) ErrorName() string {
+	return "CommitReservationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitReservationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitReservationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitReservationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitReservationResponseValidationError{}
@@ -79,12 +79,73 @@ func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
 	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
 }
 
+// PaymentStatus represents the status of a payment transaction.
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED        PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_SUCCEEDED          PaymentStatus = 1
+	PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_REFUNDED           PaymentStatus = 3
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_UNSPECIFIED",
+		1: "PAYMENT_STATUS_SUCCEEDED",
+		2: "PAYMENT_STATUS_PARTIALLY_REFUNDED",
+		3: "PAYMENT_STATUS_REFUNDED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_UNSPECIFIED":        0,
+		"PAYMENT_STATUS_SUCCEEDED":          1,
+		"PAYMENT_STATUS_PARTIALLY_REFUNDED": 2,
+		"PAYMENT_STATUS_REFUNDED":           3,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[1]
+}
+
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
 // PayOrderRequest is the request message for paying an order.
 type PayOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
 	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
 	PaymentMethod PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// amount is a decimal string with at most two fractional digits, e.g. "1250.50".
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// currency is an ISO 4217 alphabetic code, e.g. "RUB".
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// attempt_key identifies the payment attempt of the order. A repeated call with the
+	// same order_uuid and attempt_key returns the original transaction instead of charging
+	// again, or fails with ALREADY_EXISTS if its details differ. An empty key is a key of its own.
+	AttemptKey    string `protobuf:"bytes,6,opt,name=attempt_key,json=attemptKey,proto3" json:"attempt_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -140,6 +201,27 @@ func (x *PayOrderRequest) GetPaymentMethod() PaymentMethod {
 	return PaymentMethod_PAYMENT_METHOD_UNKNOWN_UNSPECIFIED
 }
 
+func (x *PayOrderRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetAttemptKey() string {
+	if x != nil {
+		return x.AttemptKey
+	}
+	return ""
+}
+
 // PayOrderResponse is the response message containing the generated transaction UUID.
 type PayOrderResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -185,27 +267,203 @@ func (x *PayOrderResponse) GetTransactionUuid() string {
 	return ""
 }
 
+// RefundPaymentRequest is the request message for refunding a payment in full or in part.
+type RefundPaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// amount is a decimal string with at most two fractional digits, e.g. "1250.50".
+	// An empty amount refunds everything that has not been refunded yet.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// refund_key identifies the refund within the payment. Repeating a key returns the
+	// refund stored for it instead of refunding again, a key cannot be reused for another amount.
+	RefundKey     string `protobuf:"bytes,4,opt,name=refund_key,json=refundKey,proto3" json:"refund_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RefundPaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetRefundKey() string {
+	if x != nil {
+		return x.RefundKey
+	}
+	return ""
+}
+
+// RefundPaymentResponse is the response message describing the refund and the payment after it.
+type RefundPaymentResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RefundUuid string                 `protobuf:"bytes,1,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`
+	// amount is the amount returned by this refund.
+	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// refunded_total is the amount refunded from the payment so far, this refund included.
+	RefundedTotal string        `protobuf:"bytes,4,opt,name=refunded_total,json=refundedTotal,proto3" json:"refunded_total,omitempty"`
+	PaymentStatus PaymentStatus `protobuf:"varint,5,opt,name=payment_status,json=paymentStatus,proto3,enum=payment.v1.PaymentStatus" json:"payment_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefundPaymentResponse) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RefundPaymentResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RefundPaymentResponse) GetRefundedTotal() string {
+	if x != nil {
+		return x.RefundedTotal
+	}
+	return ""
+}
+
+func (x *RefundPaymentResponse) GetPaymentStatus() PaymentStatus {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
 var File_payment_v1_payment_proto protoreflect.FileDescriptor
 
 const file_payment_v1_payment_proto_rawDesc = "" +
 	"\n" +
 	"\x18payment/v1/payment.proto\x12\n" +
-	"payment.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xad\x01\n" +
+	"payment.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xc3\x02\n" +
 	"\x0fPayOrderRequest\x12'\n" +
 	"\n" +
 	"order_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\torderUuid\x12%\n" +
 	"\tuser_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\buserUuid\x12J\n" +
-	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodB\b\xfaB\x05\x82\x01\x02\x10\x01R\rpaymentMethod\"=\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodB\b\xfaB\x05\x82\x01\x02\x10\x01R\rpaymentMethod\x12;\n" +
+	"\x06amount\x18\x04 \x01(\tB#\xfaB r\x1e2\x1c^[0-9]{1,18}(\\.[0-9]{1,2})?$R\x06amount\x12-\n" +
+	"\bcurrency\x18\x05 \x01(\tB\x11\xfaB\x0er\f2\n" +
+	"^[A-Z]{3}$R\bcurrency\x12(\n" +
+	"\vattempt_key\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x18@R\n" +
+	"attemptKey\"=\n" +
 	"\x10PayOrderResponse\x12)\n" +
-	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid*\xab\x01\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"\xd7\x01\n" +
+	"\x14RefundPaymentRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\x0ftransactionUuid\x12>\n" +
+	"\x06amount\x18\x02 \x01(\tB&\xfaB#r!2\x1f^([0-9]{1,18}(\\.[0-9]{1,2})?)?$R\x06amount\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x06reason\x12(\n" +
+	"\n" +
+	"refund_key\x18\x04 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\trefundKey\"\xd5\x01\n" +
+	"\x15RefundPaymentResponse\x12\x1f\n" +
+	"\vrefund_uuid\x18\x01 \x01(\tR\n" +
+	"refundUuid\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12%\n" +
+	"\x0erefunded_total\x18\x04 \x01(\tR\rrefundedTotal\x12@\n" +
+	"\x0epayment_status\x18\x05 \x01(\x0e2\x19.payment.v1.PaymentStatusR\rpaymentStatus*\xab\x01\n" +
 	"\rPaymentMethod\x12&\n" +
 	"\"PAYMENT_METHOD_UNKNOWN_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
 	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
-	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042t\n" +
+	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x04*\x91\x01\n" +
+	"\rPaymentStatus\x12\x1e\n" +
+	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PAYMENT_STATUS_SUCCEEDED\x10\x01\x12%\n" +
+	"!PAYMENT_STATUS_PARTIALLY_REFUNDED\x10\x02\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_REFUNDED\x10\x032\x83\x02\n" +
 	"\x0ePaymentService\x12b\n" +
-	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/paymentsBKZIgithub.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1;payment_v1b\x06proto3"
+	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/payments\x12\x8c\x01\n" +
+	"\rRefundPayment\x12 .payment.v1.RefundPaymentRequest\x1a!.payment.v1.RefundPaymentResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/payments/{transaction_uuid}/refundsBKZIgithub.com/dexguitar/spacecraftory/shared/pkg/proto/payment/v1;payment_v1b\x06proto3"
 
 var (
 	file_payment_v1_payment_proto_rawDescOnce sync.Once
@@ -219,22 +477,28 @@ func file_payment_v1_payment_proto_rawDescGZIP() []byte {
 	return file_payment_v1_payment_proto_rawDescData
 }
 
-var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_payment_v1_payment_proto_goTypes = []any{
-	(PaymentMethod)(0),       // 0: payment.v1.PaymentMethod
-	(*PayOrderRequest)(nil),  // 1: payment.v1.PayOrderRequest
-	(*PayOrderResponse)(nil), // 2: payment.v1.PayOrderResponse
+	(PaymentMethod)(0),            // 0: payment.v1.PaymentMethod
+	(PaymentStatus)(0),            // 1: payment.v1.PaymentStatus
+	(*PayOrderRequest)(nil),       // 2: payment.v1.PayOrderRequest
+	(*PayOrderResponse)(nil),      // 3: payment.v1.PayOrderResponse
+	(*RefundPaymentRequest)(nil),  // 4: payment.v1.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil), // 5: payment.v1.RefundPaymentResponse
 }
 var file_payment_v1_payment_proto_depIdxs = []int32{
 	0, // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
-	1, // 1: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
-	2, // 2: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: payment.v1.RefundPaymentResponse.payment_status:type_name -> payment.v1.PaymentStatus
+	2, // 2: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
+	4, // 3: payment.v1.PaymentService.RefundPayment:input_type -> payment.v1.RefundPaymentRequest
+	3, // 4: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
+	5, // 5: payment.v1.PaymentService.RefundPayment:output_type -> payment.v1.RefundPaymentResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_payment_v1_payment_proto_init() }
@@ -247,8 +511,8 @@ func file_payment_v1_payment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
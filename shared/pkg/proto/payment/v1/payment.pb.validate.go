@@ -92,6 +92,39 @@ func (m *PayOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if !_PayOrderRequest_Amount_Pattern.MatchString(m.GetAmount()) {
+		err := PayOrderRequestValidationError{
+			field:  "Amount",
+			reason: "value does not match regex pattern \"^[0-9]{1,18}(\\\\.[0-9]{1,2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PayOrderRequest_Currency_Pattern.MatchString(m.GetCurrency()) {
+		err := PayOrderRequestValidationError{
+			field:  "Currency",
+			reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAttemptKey()) > 64 {
+		err := PayOrderRequestValidationError{
+			field:  "AttemptKey",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return PayOrderRequestMultiError(errors)
 	}
@@ -170,6 +203,10 @@ var _ interface {
 	ErrorName() string
 } = PayOrderRequestValidationError{}
 
+var _PayOrderRequest_Amount_Pattern = regexp.MustCompile("^[0-9]{1,18}(\\.[0-9]{1,2})?$")
+
+var _PayOrderRequest_Currency_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
 // Validate checks the field values on PayOrderResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -271,3 +308,264 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PayOrderResponseValidationError{}
+
+// Validate checks the field values on RefundPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefundPaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundPaymentRequestMultiError, or nil if none found.
+func (m *RefundPaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundPaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTransactionUuid()) != 36 {
+		err := RefundPaymentRequestValidationError{
+			field:  "TransactionUuid",
+			reason: "value length must be 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_RefundPaymentRequest_Amount_Pattern.MatchString(m.GetAmount()) {
+		err := RefundPaymentRequestValidationError{
+			field:  "Amount",
+			reason: "value does not match regex pattern \"^([0-9]{1,18}(\\\\.[0-9]{1,2})?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := RefundPaymentRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetRefundKey()); l < 1 || l > 64 {
+		err := RefundPaymentRequestValidationError{
+			field:  "RefundKey",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefundPaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundPaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by RefundPaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefundPaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundPaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundPaymentRequestMultiError) AllErrors() []error { return m }
+
+// RefundPaymentRequestValidationError is the validation error returned by
+// RefundPaymentRequest.Validate if the designated constraints aren't met.
+type RefundPaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundPaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundPaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundPaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundPaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundPaymentRequestValidationError) ErrorName() string {
+	return "RefundPaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundPaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundPaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundPaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundPaymentRequestValidationError{}
+
+var _RefundPaymentRequest_Amount_Pattern = regexp.MustCompile("^([0-9]{1,18}(\\.[0-9]{1,2})?)?$")
+
+// Validate checks the field values on RefundPaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefundPaymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundPaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundPaymentResponseMultiError, or nil if none found.
+func (m *RefundPaymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundPaymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefundUuid
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	// no validation rules for RefundedTotal
+
+	// no validation rules for PaymentStatus
+
+	if len(errors) > 0 {
+		return RefundPaymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundPaymentResponseMultiError is an error wrapping multiple validation
+// errors returned by RefundPaymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefundPaymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundPaymentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundPaymentResponseMultiError) AllErrors() []error { return m }
+
+// RefundPaymentResponseValidationError is the validation error returned by
+// RefundPaymentResponse.Validate if the designated constraints aren't met.
+type RefundPaymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundPaymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundPaymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundPaymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundPaymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundPaymentResponseValidationError) ErrorName() string {
+	return "RefundPaymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundPaymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundPaymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundPaymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundPaymentResponseValidationError{}
@@ -256,14 +256,633 @@ func (x *GetUserResponse) GetUser() *v1.User {
 	return nil
 }
 
+// UpdateUserRequest is the request to update the login and/or email of a user.
+// The caller passes its session in the session-uuid metadata and must be the user or an admin.
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Login         *string                `protobuf:"bytes,2,opt,name=login,proto3,oneof" json:"login,omitempty"`
+	Email         *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateUserRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetLogin() string {
+	if x != nil && x.Login != nil {
+		return *x.Login
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+// UpdateUserResponse is the response containing the updated user.
+type UpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *v1.User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateUserResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ChangePasswordRequest is the request to change the password of a user.
+// The caller passes its session in the session-uuid metadata and must be the user or an admin.
+// Every session of the user except session_uuid is revoked, session_uuid has to be a session of the user.
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	SessionUuid   string                 `protobuf:"bytes,4,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChangePasswordRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetSessionUuid() string {
+	if x != nil {
+		return x.SessionUuid
+	}
+	return ""
+}
+
+// ChangePasswordResponse is the response containing the number of revoked sessions.
+type ChangePasswordResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RevokedSessions int64                  `protobuf:"varint,1,opt,name=revoked_sessions,json=revokedSessions,proto3" json:"revoked_sessions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChangePasswordResponse) GetRevokedSessions() int64 {
+	if x != nil {
+		return x.RevokedSessions
+	}
+	return 0
+}
+
+// AddNotificationMethodRequest is the request to add a notification method to a user.
+// The caller passes its session in the session-uuid metadata and must be the user or an admin.
+type AddNotificationMethodRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid           string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	NotificationMethod *v1.NotificationMethod `protobuf:"bytes,2,opt,name=notification_method,json=notificationMethod,proto3" json:"notification_method,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AddNotificationMethodRequest) Reset() {
+	*x = AddNotificationMethodRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNotificationMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNotificationMethodRequest) ProtoMessage() {}
+
+func (x *AddNotificationMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNotificationMethodRequest.ProtoReflect.Descriptor instead.
+func (*AddNotificationMethodRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddNotificationMethodRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *AddNotificationMethodRequest) GetNotificationMethod() *v1.NotificationMethod {
+	if x != nil {
+		return x.NotificationMethod
+	}
+	return nil
+}
+
+// AddNotificationMethodResponse is the response containing the updated user.
+type AddNotificationMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *v1.User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNotificationMethodResponse) Reset() {
+	*x = AddNotificationMethodResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNotificationMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNotificationMethodResponse) ProtoMessage() {}
+
+func (x *AddNotificationMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNotificationMethodResponse.ProtoReflect.Descriptor instead.
+func (*AddNotificationMethodResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddNotificationMethodResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// RemoveNotificationMethodRequest is the request to remove a notification method from a user.
+// The caller passes its session in the session-uuid metadata and must be the user or an admin.
+type RemoveNotificationMethodRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid           string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	NotificationMethod *v1.NotificationMethod `protobuf:"bytes,2,opt,name=notification_method,json=notificationMethod,proto3" json:"notification_method,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RemoveNotificationMethodRequest) Reset() {
+	*x = RemoveNotificationMethodRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveNotificationMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNotificationMethodRequest) ProtoMessage() {}
+
+func (x *RemoveNotificationMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNotificationMethodRequest.ProtoReflect.Descriptor instead.
+func (*RemoveNotificationMethodRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveNotificationMethodRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *RemoveNotificationMethodRequest) GetNotificationMethod() *v1.NotificationMethod {
+	if x != nil {
+		return x.NotificationMethod
+	}
+	return nil
+}
+
+// RemoveNotificationMethodResponse is the response containing the updated user.
+type RemoveNotificationMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *v1.User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveNotificationMethodResponse) Reset() {
+	*x = RemoveNotificationMethodResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveNotificationMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNotificationMethodResponse) ProtoMessage() {}
+
+func (x *RemoveNotificationMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNotificationMethodResponse.ProtoReflect.Descriptor instead.
+func (*RemoveNotificationMethodResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveNotificationMethodResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// GrantRoleRequest is the request to grant a role to a user.
+// The caller passes its session in the session-uuid metadata and must be an admin.
+type GrantRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantRoleRequest) Reset() {
+	*x = GrantRoleRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRoleRequest) ProtoMessage() {}
+
+func (x *GrantRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRoleRequest.ProtoReflect.Descriptor instead.
+func (*GrantRoleRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GrantRoleRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *GrantRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// GrantRoleResponse is the response containing the updated user.
+type GrantRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *v1.User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantRoleResponse) Reset() {
+	*x = GrantRoleResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRoleResponse) ProtoMessage() {}
+
+func (x *GrantRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRoleResponse.ProtoReflect.Descriptor instead.
+func (*GrantRoleResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GrantRoleResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// RevokeRoleRequest is the request to take a role away from a user.
+// The caller passes its session in the session-uuid metadata and must be an admin.
+type RevokeRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRoleRequest) Reset() {
+	*x = RevokeRoleRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleRequest) ProtoMessage() {}
+
+func (x *RevokeRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRoleRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevokeRoleRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *RevokeRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// RevokeRoleResponse is the response containing the updated user.
+type RevokeRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *v1.User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRoleResponse) Reset() {
+	*x = RevokeRoleResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleResponse) ProtoMessage() {}
+
+func (x *RevokeRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleResponse.ProtoReflect.Descriptor instead.
+func (*RevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RevokeRoleResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x12user/v1/user.proto\x12\auser.v1\x1a\x17validate/validate.proto\x1a\x14common/v1/user.proto\"[\n" +
+	"\x12user/v1/user.proto\x12\auser.v1\x1a\x17validate/validate.proto\x1a\x14common/v1/user.proto\"f\n" +
 	"\x14UserRegistrationInfo\x12'\n" +
-	"\x04info\x18\x01 \x01(\v2\x13.common.v1.UserInfoR\x04info\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"D\n" +
+	"\x04info\x18\x01 \x01(\v2\x13.common.v1.UserInfoR\x04info\x12%\n" +
+	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\b\x18HR\bpassword\"D\n" +
 	"\x0fRegisterRequest\x121\n" +
 	"\x04info\x18\x01 \x01(\v2\x1d.user.v1.UserRegistrationInfoR\x04info\"/\n" +
 	"\x10RegisterResponse\x12\x1b\n" +
@@ -271,10 +890,55 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x0eGetUserRequest\x12%\n" +
 	"\tuser_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\buserUuid\"6\n" +
 	"\x0fGetUserResponse\x12#\n" +
-	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserR\x04user2\x90\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserR\x04user\"\x99\x01\n" +
+	"\x11UpdateUserRequest\x12%\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\buserUuid\x12%\n" +
+	"\x05login\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01H\x00R\x05login\x88\x01\x01\x12\"\n" +
+	"\x05email\x18\x03 \x01(\tB\a\xfaB\x04r\x02`\x01H\x01R\x05email\x88\x01\x01B\b\n" +
+	"\x06_loginB\b\n" +
+	"\x06_email\"9\n" +
+	"\x12UpdateUserResponse\x12#\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserR\x04user\"\xcb\x01\n" +
+	"\x15ChangePasswordRequest\x12%\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\buserUuid\x12-\n" +
+	"\fold_password\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\voldPassword\x12,\n" +
+	"\fnew_password\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\b\x18HR\vnewPassword\x12.\n" +
+	"\fsession_uuid\x18\x04 \x01(\tB\v\xfaB\br\x06\x98\x01$\xd0\x01\x01R\vsessionUuid\"C\n" +
+	"\x16ChangePasswordResponse\x12)\n" +
+	"\x10revoked_sessions\x18\x01 \x01(\x03R\x0frevokedSessions\"\x9f\x01\n" +
+	"\x1cAddNotificationMethodRequest\x12%\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\buserUuid\x12X\n" +
+	"\x13notification_method\x18\x02 \x01(\v2\x1d.common.v1.NotificationMethodB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x12notificationMethod\"D\n" +
+	"\x1dAddNotificationMethodResponse\x12#\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserR\x04user\"\xa2\x01\n" +
+	"\x1fRemoveNotificationMethodRequest\x12%\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\buserUuid\x12X\n" +
+	"\x13notification_method\x18\x02 \x01(\v2\x1d.common.v1.NotificationMethodB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x12notificationMethod\"G\n" +
+	" RemoveNotificationMethodResponse\x12#\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserR\x04user\"X\n" +
+	"\x10GrantRoleRequest\x12%\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\buserUuid\x12\x1d\n" +
+	"\x04role\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04role\"8\n" +
+	"\x11GrantRoleResponse\x12#\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserR\x04user\"Y\n" +
+	"\x11RevokeRoleRequest\x12%\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\buserUuid\x12\x1d\n" +
+	"\x04role\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04role\"9\n" +
+	"\x12RevokeRoleResponse\x12#\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserR\x04user2\x9a\x05\n" +
 	"\vUserService\x12A\n" +
 	"\bRegister\x12\x18.user.v1.RegisterRequest\x1a\x19.user.v1.RegisterResponse\"\x00\x12>\n" +
-	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\"\x00BEZCgithub.com/dexguitar/spacecraftory/shared/pkg/proto/user/v1;user_v1b\x06proto3"
+	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\"\x00\x12G\n" +
+	"\n" +
+	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\x1b.user.v1.UpdateUserResponse\"\x00\x12S\n" +
+	"\x0eChangePassword\x12\x1e.user.v1.ChangePasswordRequest\x1a\x1f.user.v1.ChangePasswordResponse\"\x00\x12h\n" +
+	"\x15AddNotificationMethod\x12%.user.v1.AddNotificationMethodRequest\x1a&.user.v1.AddNotificationMethodResponse\"\x00\x12q\n" +
+	"\x18RemoveNotificationMethod\x12(.user.v1.RemoveNotificationMethodRequest\x1a).user.v1.RemoveNotificationMethodResponse\"\x00\x12D\n" +
+	"\tGrantRole\x12\x19.user.v1.GrantRoleRequest\x1a\x1a.user.v1.GrantRoleResponse\"\x00\x12G\n" +
+	"\n" +
+	"RevokeRole\x12\x1a.user.v1.RevokeRoleRequest\x1a\x1b.user.v1.RevokeRoleResponse\"\x00BEZCgithub.com/dexguitar/spacecraftory/shared/pkg/proto/user/v1;user_v1b\x06proto3"
 
 var (
 	file_user_v1_user_proto_rawDescOnce sync.Once
@@ -288,29 +952,61 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_user_v1_user_proto_goTypes = []any{
-	(*UserRegistrationInfo)(nil), // 0: user.v1.UserRegistrationInfo
-	(*RegisterRequest)(nil),      // 1: user.v1.RegisterRequest
-	(*RegisterResponse)(nil),     // 2: user.v1.RegisterResponse
-	(*GetUserRequest)(nil),       // 3: user.v1.GetUserRequest
-	(*GetUserResponse)(nil),      // 4: user.v1.GetUserResponse
-	(*v1.UserInfo)(nil),          // 5: common.v1.UserInfo
-	(*v1.User)(nil),              // 6: common.v1.User
+	(*UserRegistrationInfo)(nil),             // 0: user.v1.UserRegistrationInfo
+	(*RegisterRequest)(nil),                  // 1: user.v1.RegisterRequest
+	(*RegisterResponse)(nil),                 // 2: user.v1.RegisterResponse
+	(*GetUserRequest)(nil),                   // 3: user.v1.GetUserRequest
+	(*GetUserResponse)(nil),                  // 4: user.v1.GetUserResponse
+	(*UpdateUserRequest)(nil),                // 5: user.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),               // 6: user.v1.UpdateUserResponse
+	(*ChangePasswordRequest)(nil),            // 7: user.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),           // 8: user.v1.ChangePasswordResponse
+	(*AddNotificationMethodRequest)(nil),     // 9: user.v1.AddNotificationMethodRequest
+	(*AddNotificationMethodResponse)(nil),    // 10: user.v1.AddNotificationMethodResponse
+	(*RemoveNotificationMethodRequest)(nil),  // 11: user.v1.RemoveNotificationMethodRequest
+	(*RemoveNotificationMethodResponse)(nil), // 12: user.v1.RemoveNotificationMethodResponse
+	(*GrantRoleRequest)(nil),                 // 13: user.v1.GrantRoleRequest
+	(*GrantRoleResponse)(nil),                // 14: user.v1.GrantRoleResponse
+	(*RevokeRoleRequest)(nil),                // 15: user.v1.RevokeRoleRequest
+	(*RevokeRoleResponse)(nil),               // 16: user.v1.RevokeRoleResponse
+	(*v1.UserInfo)(nil),                      // 17: common.v1.UserInfo
+	(*v1.User)(nil),                          // 18: common.v1.User
+	(*v1.NotificationMethod)(nil),            // 19: common.v1.NotificationMethod
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	5, // 0: user.v1.UserRegistrationInfo.info:type_name -> common.v1.UserInfo
-	0, // 1: user.v1.RegisterRequest.info:type_name -> user.v1.UserRegistrationInfo
-	6, // 2: user.v1.GetUserResponse.user:type_name -> common.v1.User
-	1, // 3: user.v1.UserService.Register:input_type -> user.v1.RegisterRequest
-	3, // 4: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	2, // 5: user.v1.UserService.Register:output_type -> user.v1.RegisterResponse
-	4, // 6: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	17, // 0: user.v1.UserRegistrationInfo.info:type_name -> common.v1.UserInfo
+	0,  // 1: user.v1.RegisterRequest.info:type_name -> user.v1.UserRegistrationInfo
+	18, // 2: user.v1.GetUserResponse.user:type_name -> common.v1.User
+	18, // 3: user.v1.UpdateUserResponse.user:type_name -> common.v1.User
+	19, // 4: user.v1.AddNotificationMethodRequest.notification_method:type_name -> common.v1.NotificationMethod
+	18, // 5: user.v1.AddNotificationMethodResponse.user:type_name -> common.v1.User
+	19, // 6: user.v1.RemoveNotificationMethodRequest.notification_method:type_name -> common.v1.NotificationMethod
+	18, // 7: user.v1.RemoveNotificationMethodResponse.user:type_name -> common.v1.User
+	18, // 8: user.v1.GrantRoleResponse.user:type_name -> common.v1.User
+	18, // 9: user.v1.RevokeRoleResponse.user:type_name -> common.v1.User
+	1,  // 10: user.v1.UserService.Register:input_type -> user.v1.RegisterRequest
+	3,  // 11: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	5,  // 12: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
+	7,  // 13: user.v1.UserService.ChangePassword:input_type -> user.v1.ChangePasswordRequest
+	9,  // 14: user.v1.UserService.AddNotificationMethod:input_type -> user.v1.AddNotificationMethodRequest
+	11, // 15: user.v1.UserService.RemoveNotificationMethod:input_type -> user.v1.RemoveNotificationMethodRequest
+	13, // 16: user.v1.UserService.GrantRole:input_type -> user.v1.GrantRoleRequest
+	15, // 17: user.v1.UserService.RevokeRole:input_type -> user.v1.RevokeRoleRequest
+	2,  // 18: user.v1.UserService.Register:output_type -> user.v1.RegisterResponse
+	4,  // 19: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	6,  // 20: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
+	8,  // 21: user.v1.UserService.ChangePassword:output_type -> user.v1.ChangePasswordResponse
+	10, // 22: user.v1.UserService.AddNotificationMethod:output_type -> user.v1.AddNotificationMethodResponse
+	12, // 23: user.v1.UserService.RemoveNotificationMethod:output_type -> user.v1.RemoveNotificationMethodResponse
+	14, // 24: user.v1.UserService.GrantRole:output_type -> user.v1.GrantRoleResponse
+	16, // 25: user.v1.UserService.RevokeRole:output_type -> user.v1.RevokeRoleResponse
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -318,13 +1014,14 @@ func file_user_v1_user_proto_init() {
 	if File_user_v1_user_proto != nil {
 		return
 	}
+	file_user_v1_user_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},